@@ -82,7 +82,8 @@ func NewEthAccountVerificationDecorator(ak evmtypes.AccountKeeper, bankKeeper ev
 	}
 }
 
-// AnteHandle validates checks that the sender balance is greater than the total transaction cost.
+// AnteHandle validates checks that the sender balance is greater than the total transaction cost, or
+// than the transaction value if the fees are paid by a fee payer.
 // The account will be set to store if it doesn't exis, i.e cannot be found on store.
 // This AnteHandler decorator will fail if:
 // - any of the msgs is not a MsgEthereumTx
@@ -93,6 +94,11 @@ func (avd EthAccountVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx
 		return next(ctx, tx, simulate)
 	}
 
+	feePayer, err := EthTxFeePayer(tx)
+	if err != nil {
+		return ctx, err
+	}
+
 	for i, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
@@ -123,10 +129,17 @@ func (avd EthAccountVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx
 				"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
 		}
 
-		if err := evmkeeper.CheckSenderBalance(sdk.NewIntFromBigInt(acct.Balance), txData); err != nil {
-			return ctx, sdkerrors.Wrap(err, "failed to check sender balance")
+		if feePayer == nil {
+			if err := evmkeeper.CheckSenderBalance(sdk.NewIntFromBigInt(acct.Balance), txData); err != nil {
+				return ctx, sdkerrors.Wrap(err, "failed to check sender balance")
+			}
+		} else if value := txData.GetValue(); value != nil && acct.Balance.Cmp(value) < 0 {
+			// the fees are paid by the fee payer, the sender only needs to cover the value
+			return ctx, sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFunds,
+				"sender balance < tx value (%s < %s)", acct.Balance, value,
+			)
 		}
-
 	}
 	return next(ctx, tx, simulate)
 }
@@ -134,17 +147,20 @@ func (avd EthAccountVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx
 // EthGasConsumeDecorator validates enough intrinsic gas for the transaction and
 // gas consumption.
 type EthGasConsumeDecorator struct {
-	evmKeeper    EVMKeeper
-	maxGasWanted uint64
+	evmKeeper      EVMKeeper
+	feegrantKeeper authante.FeegrantKeeper
+	maxGasWanted   uint64
 }
 
 // NewEthGasConsumeDecorator creates a new EthGasConsumeDecorator
 func NewEthGasConsumeDecorator(
 	evmKeeper EVMKeeper,
+	feegrantKeeper authante.FeegrantKeeper,
 	maxGasWanted uint64,
 ) EthGasConsumeDecorator {
 	return EthGasConsumeDecorator{
 		evmKeeper,
+		feegrantKeeper,
 		maxGasWanted,
 	}
 }
@@ -152,6 +168,11 @@ func NewEthGasConsumeDecorator(
 // AnteHandle validates that the Ethereum tx message has enough to cover intrinsic gas
 // (during CheckTx only) and that the sender has enough balance to pay for the gas cost.
 //
+// If the tx extension option names a fee payer, the gas cost is paid by the fee payer instead,
+// within the limits of the fee allowance it granted to the sender. The allowance is charged the full
+// gas cost (gas_limit * gas_price): the leftover gas is refunded to the fee payer after the execution,
+// but it isn't credited back to the allowance.
+//
 // Intrinsic gas for a transaction is the amount of gas that the transaction uses before the
 // transaction is executed. The gas is a constant value plus any cost inccured by additional bytes
 // of data supplied with the transaction.
@@ -161,6 +182,7 @@ func NewEthGasConsumeDecorator(
 // - sender account cannot be found
 // - transaction's gas limit is lower than the intrinsic gas
 // - user doesn't have enough balance to deduct the transaction fees (gas_limit * gas_price)
+// - the fee payer didn't grant an allowance covering the transaction fees to the sender
 // - transaction or block gas meter runs out of gas
 // - sets the gas meter limit
 func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
@@ -176,6 +198,14 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	gasWanted := uint64(0)
 	var events sdk.Events
 
+	feePayer, err := EthTxFeePayer(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if feePayer != nil && egcd.feegrantKeeper == nil {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
@@ -198,10 +228,17 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			gasWanted += txData.GetGas()
 		}
 
-		fees, err := egcd.evmKeeper.DeductTxCostsFromUserBalance(
+		sender := msgEthTx.GetFrom()
+		payer := sender
+		if feePayer != nil {
+			payer = feePayer
+		}
+
+		fees, err := egcd.evmKeeper.DeductTxCostsFromFeePayer(
 			ctx,
 			*msgEthTx,
 			txData,
+			payer,
 			evmDenom,
 			homestead,
 			istanbul,
@@ -211,7 +248,26 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			return ctx, sdkerrors.Wrapf(err, "failed to deduct transaction costs from user balance")
 		}
 
-		events = append(events, sdk.NewEvent(sdk.EventTypeTx, sdk.NewAttribute(sdk.AttributeKeyFee, fees.String())))
+		if feePayer == nil {
+			events = append(events, sdk.NewEvent(sdk.EventTypeTx, sdk.NewAttribute(sdk.AttributeKeyFee, fees.String())))
+			continue
+		}
+
+		// the allowance is charged the full gas cost, the refund doesn't restore it
+		if !feePayer.Equals(sender) {
+			if err := egcd.feegrantKeeper.UseGrantedFees(ctx, feePayer, sender, fees, []sdk.Msg{msg}); err != nil {
+				return ctx, sdkerrors.Wrapf(err, "%s does not allow to pay fees for %s", feePayer, sender)
+			}
+		}
+
+		// the leftover gas is refunded to the fee payer
+		egcd.evmKeeper.SetTxFeePayerTransient(ctx, common.HexToHash(msgEthTx.Hash), feePayer)
+
+		events = append(events, sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fees.String()),
+			sdk.NewAttribute(evmtypes.AttributeKeyFeePayer, feePayer.String()),
+		))
 	}
 
 	// TODO: change to typed events
@@ -409,6 +465,10 @@ func (vbd EthValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "for eth tx length of ExtensionOptions should be 1")
 		}
 
+		if _, err := EthTxFeePayer(tx); err != nil {
+			return ctx, err
+		}
+
 		txFee := sdk.Coins{}
 		txGasLimit := uint64(0)
 
@@ -440,6 +500,7 @@ func (vbd EthValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should be empty")
 		}
 
+		// the fee payer of the eth txs is set by the extension option
 		if authInfo.Fee.Payer != "" || authInfo.Fee.Granter != "" {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "for eth tx AuthInfo Fee payer and granter should be empty")
 		}
@@ -532,3 +593,34 @@ func (mfd EthMempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 
 	return next(ctx, tx, simulate)
 }
+
+// EthTxFeePayer returns the fee payer named by the ethereum tx extension option, nil if the fees are paid
+// by the senders of the ethereum txs.
+func EthTxFeePayer(tx sdk.Tx) (sdk.AccAddress, error) {
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return nil, nil
+	}
+
+	for _, opt := range extTx.GetExtensionOptions() {
+		if opt.GetTypeUrl() != "/ethermint.evm.v1.ExtensionOptionsEthereumTx" {
+			continue
+		}
+
+		var option evmtypes.ExtensionOptionsEthereumTx
+		if err := option.Unmarshal(opt.Value); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "failed to unmarshal the eth tx extension option")
+		}
+		if option.FeePayer == "" {
+			return nil, nil
+		}
+
+		feePayer, err := sdk.AccAddressFromBech32(option.FeePayer)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fee payer %s: %s", option.FeePayer, err)
+		}
+		return feePayer, nil
+	}
+
+	return nil, nil
+}
@@ -3,7 +3,10 @@ package ante_test
 import (
 	"math/big"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 
 	"github.com/tharsis/ethermint/app/ante"
 	"github.com/tharsis/ethermint/server/config"
@@ -11,6 +14,7 @@ import (
 	"github.com/tharsis/ethermint/x/evm/statedb"
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -67,6 +71,15 @@ func (suite AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 	tx := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 1, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil)
 	tx.From = addr.Hex()
 
+	// the fees of the sponsored tx are paid by the fee payer
+	sponsoredAddr := tests.GenerateAddress()
+	sponsoredMsg := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 1, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil)
+	sponsoredMsg.From = sponsoredAddr.Hex()
+	sponsoredTx, err := sponsoredMsg.BuildTxWithFeePayer(
+		suite.clientCtx.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom, sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().NoError(err)
+
 	var vmdb *statedb.StateDB
 
 	testCases := []struct {
@@ -126,6 +139,22 @@ func (suite AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 			true,
 			true,
 		},
+		{
+			"sponsored, not enough balance to cover tx value",
+			sponsoredTx,
+			func() {},
+			true,
+			false,
+		},
+		{
+			"sponsored, balance covers tx value",
+			sponsoredTx,
+			func() {
+				vmdb.AddBalance(sponsoredAddr, big.NewInt(10))
+			},
+			true,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -202,7 +231,7 @@ func (suite AnteTestSuite) TestEthNonceVerificationDecorator() {
 }
 
 func (suite AnteTestSuite) TestEthGasConsumeDecorator() {
-	dec := ante.NewEthGasConsumeDecorator(suite.app.EvmKeeper, config.DefaultMaxTxGasWanted)
+	dec := ante.NewEthGasConsumeDecorator(suite.app.EvmKeeper, suite.app.FeeGrantKeeper, config.DefaultMaxTxGasWanted)
 
 	addr := tests.GenerateAddress()
 
@@ -304,6 +333,160 @@ func (suite AnteTestSuite) TestEthGasConsumeDecorator() {
 	}
 }
 
+func (suite AnteTestSuite) TestEthGasConsumeDecoratorFeeGrant() {
+	dec := ante.NewEthGasConsumeDecorator(suite.app.EvmKeeper, suite.app.FeeGrantKeeper, config.DefaultMaxTxGasWanted)
+
+	chainID := suite.app.EvmKeeper.ChainID()
+	to := tests.GenerateAddress()
+	gasLimit := uint64(100000)
+	gasPrice := big.NewInt(10)
+	baseFee := suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx)
+	ethTxURL := sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})
+
+	testCases := []struct {
+		name       string
+		dynamicFee bool
+		allowance  func(fees sdk.Coins) feegrant.FeeAllowanceI
+		expPass    bool
+	}{
+		{"no fee allowance", false, nil, false},
+		{
+			"spend limit lower than the fees",
+			false,
+			func(fees sdk.Coins) feegrant.FeeAllowanceI {
+				return &feegrant.BasicAllowance{SpendLimit: fees.Sub(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdk.OneInt()))}
+			},
+			false,
+		},
+		{
+			"msg not allowed",
+			false,
+			func(fees sdk.Coins) feegrant.FeeAllowanceI {
+				allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{}, []string{"/cosmos.bank.v1beta1.MsgSend"})
+				suite.Require().NoError(err)
+				return allowance
+			},
+			false,
+		},
+		{
+			"legacy tx",
+			false,
+			func(fees sdk.Coins) feegrant.FeeAllowanceI {
+				return &feegrant.BasicAllowance{SpendLimit: fees.Add(fees...)}
+			},
+			true,
+		},
+		{
+			"dynamic fee tx",
+			true,
+			func(fees sdk.Coins) feegrant.FeeAllowanceI {
+				return &feegrant.BasicAllowance{SpendLimit: fees.Add(fees...)}
+			},
+			true,
+		},
+		{
+			"msg allowed",
+			false,
+			func(fees sdk.Coins) feegrant.FeeAllowanceI {
+				allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{}, []string{ethTxURL})
+				suite.Require().NoError(err)
+				return allowance
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			sender := tests.GenerateAddress()
+			granter := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+			var msg *evmtypes.MsgEthereumTx
+			if tc.dynamicFee {
+				gasFeeCap := new(big.Int).Add(baseFee, big.NewInt(10))
+				msg = evmtypes.NewTx(chainID, 1, &to, big.NewInt(10), gasLimit, nil, gasFeeCap, big.NewInt(10), nil, &ethtypes.AccessList{})
+			} else {
+				msg = evmtypes.NewTx(chainID, 1, &to, big.NewInt(10), gasLimit, gasPrice, nil, nil, nil, nil)
+			}
+			msg.From = sender.Hex()
+
+			txData, err := evmtypes.UnpackTxData(msg.Data)
+			suite.Require().NoError(err)
+			feeAmt := txData.Fee()
+			if tc.dynamicFee {
+				feeAmt = txData.EffectiveFee(baseFee)
+			}
+			fees := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdk.NewIntFromBigInt(feeAmt)))
+
+			granterBalance := new(big.Int).Mul(feeAmt, big.NewInt(10))
+			vmdb := suite.StateDB()
+			vmdb.AddBalance(common.BytesToAddress(granter), granterBalance)
+			suite.Require().NoError(vmdb.Commit())
+
+			if tc.allowance != nil {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, sender.Bytes(), tc.allowance(fees))
+				suite.Require().NoError(err)
+			}
+
+			tx, err := msg.BuildTxWithFeePayer(suite.clientCtx.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom, granter)
+			suite.Require().NoError(err)
+
+			ctx, _ := suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+			_, err = dec.AnteHandle(ctx, tx, false, nextFn)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the fees are paid by the granter
+			suite.Require().Equal(new(big.Int).Sub(granterBalance, feeAmt), suite.app.EvmKeeper.GetBalance(ctx, common.BytesToAddress(granter)))
+			suite.Require().Equal(int64(0), suite.app.EvmKeeper.GetBalance(ctx, sender).Int64())
+			suite.Require().Equal(granter, suite.app.EvmKeeper.GetTxFeePayerTransient(ctx, common.HexToHash(msg.Hash)))
+
+			allowance, err := suite.app.FeeGrantKeeper.GetAllowance(ctx, granter, sender.Bytes())
+			suite.Require().NoError(err)
+			if basic, ok := allowance.(*feegrant.BasicAllowance); ok {
+				suite.Require().Equal(fees, basic.SpendLimit)
+			}
+		})
+	}
+}
+
+func (suite AnteTestSuite) TestEthTxFeePayer() {
+	feePayer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name        string
+		feePayer    string
+		expFeePayer sdk.AccAddress
+		expPass     bool
+	}{
+		{"no fee payer", "", nil, true},
+		{"fee payer", feePayer.String(), feePayer, true},
+		{"invalid fee payer", "invalid", nil, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			option, err := codectypes.NewAnyWithValue(&evmtypes.ExtensionOptionsEthereumTx{FeePayer: tc.feePayer})
+			suite.Require().NoError(err)
+
+			builder, ok := suite.clientCtx.TxConfig.NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+			suite.Require().True(ok)
+			builder.SetExtensionOptions(option)
+
+			res, err := ante.EthTxFeePayer(builder.GetTx())
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expFeePayer, res)
+		})
+	}
+}
+
 func (suite AnteTestSuite) TestCanTransferDecorator() {
 	dec := ante.NewCanTransferDecorator(suite.app.EvmKeeper)
 
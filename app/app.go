@@ -108,6 +108,7 @@ import (
 	ethermint "github.com/tharsis/ethermint/types"
 	"github.com/tharsis/ethermint/x/evm"
 
+	evmreceipts "github.com/tharsis/ethermint/x/evm/client/receipts"
 	// evmrest "github.com/tharsis/ethermint/x/evm/client/rest"
 	evmkeeper "github.com/tharsis/ethermint/x/evm/keeper"
 	"github.com/tharsis/ethermint/x/feemarket"
@@ -126,6 +127,7 @@ import (
 	bondtypes "github.com/tharsis/ethermint/x/bond/types"
 	"github.com/tharsis/ethermint/x/nameservice"
 	nameservicekeeper "github.com/tharsis/ethermint/x/nameservice/keeper"
+	nameserviceprecompile "github.com/tharsis/ethermint/x/nameservice/precompile"
 	nameservicetypes "github.com/tharsis/ethermint/x/nameservice/types"
 )
 
@@ -425,31 +427,41 @@ func NewEthermintApp(
 		appCodec, keys[feemarkettypes.StoreKey], app.GetSubspace(feemarkettypes.ModuleName),
 	)
 
+	app.EvmKeeper = evmkeeper.NewKeeper(
+		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], app.GetSubspace(evmtypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
+		tracer,
+	)
+
 	// Create Vulcanize chiba-clonk keepers
 	app.AuctionKeeper = auctionkeeper.NewKeeper(
-		app.AccountKeeper, app.BankKeeper, keys[auctiontypes.StoreKey],
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, keys[auctiontypes.StoreKey],
 		appCodec, app.GetSubspace(auctiontypes.ModuleName),
 	)
 
-	app.NameServiceRecordKeeper = nameservicekeeper.NewRecordKeeper(app.AuctionKeeper, keys[nameservicetypes.StoreKey], appCodec)
+	app.NameServiceRecordKeeper = nameservicekeeper.NewRecordKeeper(app.AuctionKeeper, keys[nameservicetypes.StoreKey], appCodec, app.GetSubspace(nameservicetypes.ModuleName))
+
+	// The registry changes are mirrored into EVM logs, the mirror must follow the record keeper to see the
+	// authorities of the auction winners.
+	registryLogs := nameserviceprecompile.NewLogMirror(app.EvmKeeper, app.NameServiceRecordKeeper)
 
-	app.AuctionKeeper.SetUsageKeepers([]auctiontypes.AuctionUsageKeeper{app.NameServiceRecordKeeper})
+	app.AuctionKeeper.SetUsageKeepers([]auctiontypes.AuctionUsageKeeper{app.NameServiceRecordKeeper, registryLogs})
 
 	app.BondKeeper = bondkeeper.NewKeeper(
 		appCodec, app.AccountKeeper, app.BankKeeper,
 		[]bondtypes.BondUsageKeeper{app.NameServiceRecordKeeper}, keys[bondtypes.StoreKey], app.GetSubspace(bondtypes.ModuleName),
 	)
+	app.BondKeeper.SetHooks(registryLogs)
 
 	app.NameServiceKeeper = nameservicekeeper.NewKeeper(
 		appCodec, app.AccountKeeper, app.BankKeeper,
 		app.NameServiceRecordKeeper, app.BondKeeper, app.AuctionKeeper,
 		keys[nameservicetypes.StoreKey], app.GetSubspace(nameservicetypes.ModuleName),
 	)
+	app.NameServiceKeeper.SetHooks(registryLogs)
 
-	app.EvmKeeper = evmkeeper.NewKeeper(
-		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], app.GetSubspace(evmtypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
-		tracer,
+	app.EvmKeeper.SetPrecompiles(
+		nameserviceprecompile.NewRegistry(app.NameServiceKeeper, app.BondKeeper),
 	)
 
 	// Create IBC Keeper
@@ -583,6 +595,10 @@ func NewEthermintApp(
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
+		// the registry logs of the chiba-clonk modules must be in the EVM block bloom
+		auctiontypes.ModuleName,
+		bondtypes.ModuleName,
+		nameservicetypes.ModuleName,
 		evmtypes.ModuleName,
 		feemarkettypes.ModuleName,
 		// no-op modules
@@ -601,10 +617,6 @@ func NewEthermintApp(
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
-		// chiba-clonk modules
-		auctiontypes.ModuleName,
-		bondtypes.ModuleName,
-		nameservicetypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -647,6 +659,7 @@ func NewEthermintApp(
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
+	app.setUpgradeHandlers()
 
 	// add test gRPC service for testing gRPC queries in isolation
 	// testdata.RegisterTestServiceServer(app.GRPCQueryRouter(), testdata.TestServiceImpl{})
@@ -846,6 +859,7 @@ func (app *EthermintApp) RegisterTendermintService(clientCtx client.Context) {
 		app.interfaceRegistry,
 		app.Query,
 	)
+	evmreceipts.RegisterService(clientCtx, app.BaseApp.GRPCQueryRouter())
 }
 
 // RegisterSwaggerAPI registers swagger route with API Server
@@ -0,0 +1,88 @@
+package app
+
+import (
+	"context"
+	"strconv"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// RegisterGRPCServer registers the gRPC query services with the gRPC server.
+// BaseApp only sets up an sdk.Context for unary queries, server-streaming query handlers
+// are wrapped so that they get one as well.
+func (app *EthermintApp) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(streamingQueryServer{Server: server, app: app})
+}
+
+// streamingQueryServer wraps the stream handlers of the services registered with the gRPC server.
+type streamingQueryServer struct {
+	gogogrpc.Server
+	app *EthermintApp
+}
+
+func (s streamingQueryServer) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	desc := *sd
+	desc.Streams = make([]grpc.StreamDesc, len(sd.Streams))
+
+	for i, stream := range sd.Streams {
+		streamHandler := stream.Handler
+		stream.Handler = func(srv interface{}, serverStream grpc.ServerStream) error {
+			ctx, err := s.app.createStreamQueryContext(serverStream.Context())
+			if err != nil {
+				return err
+			}
+
+			return streamHandler(srv, contextServerStream{ServerStream: serverStream, ctx: ctx})
+		}
+
+		desc.Streams[i] = stream
+	}
+
+	s.Server.RegisterService(&desc, handler)
+}
+
+// contextServerStream overrides the context of a server stream.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// createStreamQueryContext attaches an sdk.Context at the requested (or latest) height to the stream context.
+func (app *EthermintApp) createStreamQueryContext(grpcCtx context.Context) (context.Context, error) {
+	height := app.LastBlockHeight()
+
+	if md, ok := metadata.FromIncomingContext(grpcCtx); ok {
+		if heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader); len(heightHeaders) == 1 {
+			headerHeight, err := strconv.ParseInt(heightHeaders[0], 10, 64)
+			if err != nil || headerHeight < 0 {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid height header %q", heightHeaders[0])
+			}
+
+			if headerHeight > 0 {
+				height = headerHeight
+			}
+		}
+	}
+
+	if height > app.LastBlockHeight() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "cannot query with height in the future; please provide a valid height")
+	}
+
+	sdkCtx, err := app.NewContextAt(false, tmproto.Header{Height: height}, height)
+	if err != nil {
+		return nil, app.stateNotAvailableError(height)
+	}
+
+	return context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx), nil
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+
+	nameservicetypes "github.com/tharsis/ethermint/x/nameservice/types"
+)
+
+// serviceRecorder records the services registered with it.
+type serviceRecorder struct {
+	descs    map[string]*grpc.ServiceDesc
+	handlers map[string]interface{}
+}
+
+func (r *serviceRecorder) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	r.descs[sd.ServiceName] = sd
+	r.handlers[sd.ServiceName] = handler
+}
+
+// recvStream is a server stream that receives a single request and records the sent messages.
+type recvStream struct {
+	grpc.ServerStream
+	req  *nameservicetypes.QueryGetBlockChangeSetsRequest
+	sent []interface{}
+}
+
+func (s *recvStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *recvStream) RecvMsg(m interface{}) error {
+	*m.(*nameservicetypes.QueryGetBlockChangeSetsRequest) = *s.req
+	return nil
+}
+
+func (s *recvStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestStreamingQueryContext(t *testing.T) {
+	app := Setup(t, false, func(_ *EthermintApp, genesis simapp.GenesisState) simapp.GenesisState {
+		return genesis
+	})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	app.NameServiceKeeper.PutRecord(ctx, nameservicetypes.Record{Id: "record"})
+	app.Commit()
+
+	recorder := &serviceRecorder{descs: map[string]*grpc.ServiceDesc{}, handlers: map[string]interface{}{}}
+	app.RegisterGRPCServer(recorder)
+
+	serviceName := "vulcanize.nameservice.v1beta1.Query"
+	desc := recorder.descs[serviceName]
+	require.NotNil(t, desc)
+	require.Len(t, desc.Streams, 1)
+
+	stream := &recvStream{req: &nameservicetypes.QueryGetBlockChangeSetsRequest{FromHeight: 1}}
+	err := desc.Streams[0].Handler(recorder.handlers[serviceName], stream)
+	require.NoError(t, err)
+	require.Len(t, stream.sent, 1)
+	require.Equal(t, []string{"record"}, stream.sent[0].(*nameservicetypes.BlockChangeSet).Records)
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// Query overrides the BaseApp ABCI query, so that queries at a height the node
+// has no state for, e.g. a pruned one, fail with a clear error.
+func (app *EthermintApp) Query(req abci.RequestQuery) abci.ResponseQuery {
+	res := app.BaseApp.Query(req)
+	if res.IsOK() || req.Height <= 0 || req.Height > app.LastBlockHeight() {
+		return res
+	}
+
+	if !app.hasStateAt(req.Height) {
+		return sdkerrors.QueryResult(app.stateNotAvailableError(req.Height), false)
+	}
+
+	return res
+}
+
+// hasStateAt returns whether the state at the height is available.
+func (app *EthermintApp) hasStateAt(height int64) bool {
+	_, err := app.NewContextAt(false, tmproto.Header{Height: height}, height)
+	return err == nil
+}
+
+func (app *EthermintApp) stateNotAvailableError(height int64) error {
+	return sdkerrors.Wrapf(
+		sdkerrors.ErrInvalidHeight,
+		"state at height %d is not available on this node, it was pruned or predates the node's snapshot (latest height: %d); query a later height or an archive node",
+		height, app.LastBlockHeight(),
+	)
+}
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/tharsis/ethermint/encoding"
+	nameservicetypes "github.com/tharsis/ethermint/x/nameservice/types"
+)
+
+func TestQueryPrunedHeight(t *testing.T) {
+	app := NewTestAppWithCustomOptions(t, false, SetupOptions{
+		Logger:             log.NewNopLogger(),
+		DB:                 memdb.NewDB(),
+		InvCheckPeriod:     5,
+		HomePath:           DefaultNodeHome,
+		SkipUpgradeHeights: map[int64]bool{},
+		EncConfig:          encoding.MakeConfig(ModuleBasics),
+		AppOpts:            EmptyAppOptions{},
+		BaseAppOptions:     []baseapp.AppOption{baseapp.SetPruning(pruningtypes.NewCustomPruningOptions(2, 10))},
+	})
+
+	for i := 0; i < 20; i++ {
+		header := tmproto.Header{ChainID: "ethermint_9000-1", Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+	}
+
+	data, err := (&nameservicetypes.QueryParamsRequest{}).Marshal()
+	require.NoError(t, err)
+
+	req := abci.RequestQuery{Path: "/vulcanize.nameservice.v1beta1.Query/Params", Data: data}
+
+	req.Height = app.LastBlockHeight()
+	res := app.Query(req)
+	require.True(t, res.IsOK(), res.Log)
+
+	req.Height = 2
+	res = app.Query(req)
+	require.Equal(t, sdkerrors.ErrInvalidHeight.ABCICode(), res.Code)
+	require.Contains(t, res.Log, "state at height 2 is not available")
+
+	req.Height = app.LastBlockHeight() + 1
+	res = app.Query(req)
+	require.Equal(t, sdkerrors.ErrInvalidHeight.ABCICode(), res.Code)
+	require.Contains(t, res.Log, "in the future")
+}
@@ -0,0 +1,34 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	nameserviceprecompile "github.com/tharsis/ethermint/x/nameservice/precompile"
+)
+
+// RegistryPrecompileUpgradeName is the chain upgrade activating the registry precompile, it runs the module
+// migrations first.
+const RegistryPrecompileUpgradeName = "registry-precompile"
+
+// setUpgradeHandlers sets the handlers of the chain upgrades.
+func (app *EthermintApp) setUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		RegistryPrecompileUpgradeName,
+		func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			versionMap, err := app.mm.RunMigrations(ctx, app.configurator, fromVM)
+			if err != nil {
+				return nil, err
+			}
+
+			params := app.EvmKeeper.GetParams(ctx)
+			if !params.IsActivePrecompile(nameserviceprecompile.RegistryAddress) {
+				params.ActivePrecompiles = append(params.ActivePrecompiles, nameserviceprecompile.RegistryAddress.Hex())
+				app.EvmKeeper.SetParams(ctx, params)
+			}
+
+			return versionMap, nil
+		},
+	)
+}
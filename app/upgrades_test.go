@@ -0,0 +1,23 @@
+package app
+
+import (
+	"testing"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	nameserviceprecompile "github.com/tharsis/ethermint/x/nameservice/precompile"
+)
+
+func TestRegistryPrecompileUpgrade(t *testing.T) {
+	app := Setup(t, false, nil)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+
+	// the registry precompile is only active after the upgrade
+	require.False(t, app.EvmKeeper.GetParams(ctx).IsActivePrecompile(nameserviceprecompile.RegistryAddress))
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: RegistryPrecompileUpgradeName, Height: 2})
+	params := app.EvmKeeper.GetParams(ctx)
+	require.Equal(t, []string{nameserviceprecompile.RegistryAddress.Hex()}, params.ActivePrecompiles)
+}
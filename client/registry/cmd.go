@@ -0,0 +1,265 @@
+package registry
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/light"
+	lightdb "github.com/tendermint/tendermint/light/store/db"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// Mirror CLI flags.
+const (
+	FlagMirrorDir      = "mirror-dir"
+	FlagTrustedHeight  = "trusted-height"
+	FlagTrustedHash    = "trusted-hash"
+	FlagTrustingPeriod = "trusting-period"
+	FlagWitnesses      = "witnesses"
+	FlagSyncInterval   = "sync-interval"
+)
+
+// MirrorDBName is the name of the mirror database in the mirror directory.
+const MirrorDBName = "registry-mirror"
+
+var (
+	// Prefixes of the mirrored registry and the light client store in the mirror database.
+	registryDBPrefix    = []byte("registry/")
+	lightClientDBPrefix = []byte("light/")
+)
+
+// Cmd creates the registry command, with commands to mirror the registry and query the mirror.
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "registry",
+		Short: "Verified local mirror of the nameservice registry",
+		RunE:  client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		MirrorCmd(),
+		ResolveCmd(),
+		GetRecordCmd(),
+	)
+
+	return cmd
+}
+
+// MirrorCmd syncs the local mirror from a node, verifying all values against light client headers.
+func MirrorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mirror",
+		Short: "Maintain a local verified copy of the registry",
+		Long: `Follow the block changesets of a node and copy the changed records, names and authorities
+with their state proofs, verified against headers checked by a light client.
+
+The first run needs a trusted header (--trusted-height and --trusted-hash), later runs resume
+from the trusted headers kept in the mirror directory.`,
+		Example: "mirror --node tcp://localhost:26657 --chain-id chibaclonk_9000-1 --trusted-height 100 --trusted-hash 6B68DB...",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.ChainID == "" {
+				return fmt.Errorf("--%s is required", flags.FlagChainID)
+			}
+
+			db, err := openMirrorDB(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			logger := log.MustNewDefaultLogger(log.LogFormatPlain, log.LogLevelInfo, false)
+
+			lightClient, err := newLightClient(ctx, cmd, clientCtx, dbm.NewPrefixDB(db, lightClientDBPrefix), logger)
+			if err != nil {
+				return err
+			}
+
+			interval, err := cmd.Flags().GetDuration(FlagSyncInterval)
+			if err != nil {
+				return err
+			}
+
+			mirror := NewMirror(dbm.NewPrefixDB(db, registryDBPrefix), clientCtx.Codec, clientCtx.Client, lightClient, logger)
+			return mirror.Run(ctx, interval)
+		},
+	}
+
+	cmd.Flags().String(FlagMirrorDir, "", "Directory of the mirror database (default <home>/data)")
+	cmd.Flags().Int64(FlagTrustedHeight, 0, "Height of the trusted header")
+	cmd.Flags().String(FlagTrustedHash, "", "Hash of the trusted header (hex)")
+	cmd.Flags().Duration(FlagTrustingPeriod, 168*time.Hour, "Trusting period, should be well below the unbonding period")
+	cmd.Flags().StringSlice(FlagWitnesses, nil, "RPC addresses of the nodes that cross-check the headers (default the --node)")
+	cmd.Flags().Duration(FlagSyncInterval, 5*time.Second, "Interval between syncs")
+
+	return cmd
+}
+
+// ResolveCmd resolves a CRN from the local mirror.
+func ResolveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "resolve [crn]",
+		Short:   "Resolve a CRN to a record using the local mirror",
+		Example: "resolve crn://wireline.io/app/test",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			mirror, closeDB, err := openMirror(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			record, err := mirror.ResolveCrn(args[0])
+			if err != nil {
+				return err
+			}
+
+			if record == nil {
+				return fmt.Errorf("%s doesn't resolve at height %d", args[0], mirror.Height())
+			}
+
+			return clientCtx.PrintProto(record)
+		},
+	}
+
+	cmd.Flags().String(FlagMirrorDir, "", "Directory of the mirror database (default <home>/data)")
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// GetRecordCmd gets a record from the local mirror.
+func GetRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "get [record-id]",
+		Short:   "Get a record from the local mirror",
+		Example: "get bafyreih7un2ntk235wshncebus5emlozdhdixrrv675my5umb6fgdergae",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			mirror, closeDB, err := openMirror(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			record := mirror.GetRecord(args[0])
+			if record == nil {
+				return fmt.Errorf("record %s not found at height %d", args[0], mirror.Height())
+			}
+
+			return clientCtx.PrintProto(record)
+		},
+	}
+
+	cmd.Flags().String(FlagMirrorDir, "", "Directory of the mirror database (default <home>/data)")
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+func openMirrorDB(cmd *cobra.Command, clientCtx client.Context) (dbm.DB, error) {
+	dir, err := cmd.Flags().GetString(FlagMirrorDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if dir == "" {
+		dir = filepath.Join(clientCtx.HomeDir, "data")
+	}
+
+	return dbm.NewDB(MirrorDBName, dbm.GoLevelDBBackend, dir)
+}
+
+// openMirror opens the local mirror to serve queries.
+func openMirror(cmd *cobra.Command, clientCtx client.Context) (*Mirror, func(), error) {
+	db, err := openMirrorDB(cmd, clientCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mirror := NewMirror(dbm.NewPrefixDB(db, registryDBPrefix), clientCtx.Codec, nil, nil, log.NewNopLogger())
+	if mirror.Height() == 0 {
+		db.Close()
+		return nil, nil, fmt.Errorf("registry mirror is empty, run the mirror command first")
+	}
+
+	return mirror, func() { db.Close() }, nil
+}
+
+// newLightClient creates a light client that starts from the trusted header given by the flags,
+// or from the headers already in its store.
+func newLightClient(ctx context.Context, cmd *cobra.Command, clientCtx client.Context, db dbm.DB, logger log.Logger) (*light.Client, error) {
+	trustingPeriod, err := cmd.Flags().GetDuration(FlagTrustingPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	witnesses, err := cmd.Flags().GetStringSlice(FlagWitnesses)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(witnesses) == 0 {
+		witnesses = []string{clientCtx.NodeURI}
+	}
+
+	trustedHeight, err := cmd.Flags().GetInt64(FlagTrustedHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	store := lightdb.New(db)
+	options := []light.Option{light.Logger(logger)}
+
+	if trustedHeight == 0 {
+		if height, err := store.LastLightBlockHeight(); err != nil || height <= 0 {
+			return nil, fmt.Errorf("no trusted headers in the mirror, --%s and --%s are required", FlagTrustedHeight, FlagTrustedHash)
+		}
+
+		return light.NewHTTPClientFromTrustedStore(clientCtx.ChainID, trustingPeriod, clientCtx.NodeURI, witnesses, store, options...)
+	}
+
+	trustedHash, err := cmd.Flags().GetString(FlagTrustedHash)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := hex.DecodeString(strings.TrimPrefix(trustedHash, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid trusted hash: %w", err)
+	}
+
+	trustOptions := light.TrustOptions{Period: trustingPeriod, Height: trustedHeight, Hash: hash}
+	return light.NewHTTPClient(ctx, clientCtx.ChainID, trustOptions, clientCtx.NodeURI, witnesses, store, options...)
+}
@@ -0,0 +1,386 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tharsis/ethermint/x/nameservice/helpers"
+	nameservicekeeper "github.com/tharsis/ethermint/x/nameservice/keeper"
+	nameservicetypes "github.com/tharsis/ethermint/x/nameservice/types"
+)
+
+// storeSubspaceQueryPath is the path of the ABCI query listing the nameservice store.
+const storeSubspaceQueryPath = "/store/" + nameservicetypes.StoreKey + "/subspace"
+
+var (
+	// keyHeight is the key of the mirrored height, keyTime of the time of the header that verified it.
+	keyHeight = []byte("mirror/height")
+	keyTime   = []byte("mirror/time")
+
+	// mirroredPrefixes are the nameservice indexes that are kept in the mirror.
+	mirroredPrefixes = [][]byte{
+		nameservicekeeper.PrefixCIDToRecordIndex,
+		nameservicekeeper.PrefixNameAuthorityRecordIndex,
+		nameservicekeeper.PrefixCRNToNameRecordIndex,
+		nameservicekeeper.PrefixCIDToNamesIndex,
+	}
+
+	// paramsAmino decodes the values of the params store.
+	paramsAmino = codec.NewLegacyAmino()
+)
+
+// Mirror maintains a local copy of the registry (records, names and authorities). Every value in the copy,
+// as well as the block changesets and the nameservice params, is verified with an ABCI query proof against
+// the app hash of a header verified by the light client.
+//
+// The keys to fetch are discovered from the block changesets, read one height at a time so that a block
+// without a changeset is proven to have none. When the mirror is empty or the changesets it needs have been
+// pruned (or aren't stored, in events mode), the keys come from a listing of the nameservice store instead.
+// The store commits to hashed keys, so the listing can't be proven complete: every listed value is verified,
+// but a node can leave keys out of a snapshot.
+type Mirror struct {
+	db          dbm.DB
+	cdc         codec.BinaryCodec
+	node        Node
+	lightClient LightClient
+	verifier    *verifier
+	logger      log.Logger
+}
+
+// NewMirror creates a mirror stored in db, synced from the node. The node and light client can be
+// nil to only serve the mirrored registry.
+func NewMirror(db dbm.DB, cdc codec.BinaryCodec, node Node, lightClient LightClient, logger log.Logger) *Mirror {
+	return &Mirror{
+		db:          db,
+		cdc:         cdc,
+		node:        node,
+		lightClient: lightClient,
+		verifier:    newVerifier(node),
+		logger:      logger,
+	}
+}
+
+func (m *Mirror) store() sdk.KVStore {
+	return dbadapter.Store{DB: m.db}
+}
+
+// Height returns the height of the mirrored state, 0 if nothing has been mirrored yet.
+func (m *Mirror) Height() int64 {
+	bz := m.store().Get(keyHeight)
+	if bz == nil {
+		return 0
+	}
+
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+func (m *Mirror) syncedTime() time.Time {
+	bz := m.store().Get(keyTime)
+	if bz == nil {
+		return time.Time{}
+	}
+
+	t, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
+
+// Run syncs the mirror every interval until the context is done.
+func (m *Mirror) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		height, err := m.Sync(ctx)
+		if err != nil {
+			m.logger.Error("failed to sync registry mirror", "error", err)
+		} else {
+			m.logger.Debug("synced registry mirror", "height", height)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync brings the mirror up to the latest height that can be verified and returns it. The state at
+// height H is committed to by the app hash in header H+1, so that is one block behind the node.
+func (m *Mirror) Sync(ctx context.Context) (int64, error) {
+	if m.node == nil || m.lightClient == nil {
+		return 0, fmt.Errorf("mirror has no node to sync from")
+	}
+
+	status, err := m.node.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	height := status.SyncInfo.LatestBlockHeight - 1
+	syncedHeight := m.Height()
+	if height <= syncedHeight || height < 2 {
+		return syncedHeight, nil
+	}
+
+	header, err := m.lightClient.VerifyLightBlockAtHeight(ctx, height+1, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	state := verifiedState{verifier: m.verifier, height: height, appHash: header.AppHash}
+
+	params, err := m.queryParams(ctx, state)
+	if err != nil {
+		return 0, err
+	}
+
+	// Writes are cached, so that the mirror is only updated once the whole sync succeeded.
+	store := cachekv.NewStore(m.store())
+	if m.changeSetsAvailable(params, syncedHeight, height, header.Time) {
+		err = m.applyChangeSets(ctx, store, state, syncedHeight+1)
+	} else {
+		err = m.snapshot(ctx, store, state)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	store.Set(keyHeight, sdk.Uint64ToBigEndian(uint64(height)))
+	store.Set(keyTime, sdk.FormatTimeBytes(header.Time))
+	store.Write()
+
+	return height, nil
+}
+
+// changeSetsAvailable returns true if the changesets since the synced height haven't been pruned.
+func (m *Mirror) changeSetsAvailable(params nameservicetypes.Params, syncedHeight, height int64, headerTime time.Time) bool {
+	if syncedHeight == 0 || params.ChangesetMode == nameservicetypes.ChangeSetModeEvents {
+		return false
+	}
+
+	if params.ChangesetRetentionBlocks > 0 && height-syncedHeight >= int64(params.ChangesetRetentionBlocks) {
+		return false
+	}
+
+	return params.ChangesetRetentionPeriod == 0 || headerTime.Sub(m.syncedTime()) < params.ChangesetRetentionPeriod
+}
+
+// applyChangeSets refetches the values changed in the blocks from fromHeight up to the verified height.
+func (m *Mirror) applyChangeSets(ctx context.Context, store sdk.KVStore, state verifiedState, fromHeight int64) error {
+	for height := fromHeight; height <= state.height; height++ {
+		bz, err := state.get(ctx, nameservicekeeper.GetBlockChangeSetIndexKey(height))
+		if err != nil {
+			return err
+		}
+
+		// Nothing changed in the block.
+		if bz == nil {
+			continue
+		}
+
+		var changeSet nameservicetypes.BlockChangeSet
+		if err := m.cdc.Unmarshal(bz, &changeSet); err != nil {
+			return err
+		}
+
+		if err := m.applyChangeSet(ctx, store, state, &changeSet); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Mirror) applyChangeSet(ctx context.Context, store sdk.KVStore, state verifiedState, changeSet *nameservicetypes.BlockChangeSet) error {
+	var keys [][]byte
+
+	for _, id := range changeSet.Records {
+		keys = append(keys, nameservicekeeper.GetRecordIndexKey(id))
+	}
+
+	for _, name := range changeSet.Authorities {
+		keys = append(keys, nameservicekeeper.GetNameAuthorityIndexKey(name))
+	}
+
+	for _, crn := range changeSet.Names {
+		// The reverse index of the record the name pointed to changes as well.
+		if nameRecord := nameservicekeeper.GetNameRecord(store, m.cdc, crn); nameRecord != nil && nameRecord.Latest != nil {
+			keys = append(keys, nameservicekeeper.GetCIDToNamesIndexKey(nameRecord.Latest.Id))
+		}
+
+		keys = append(keys, nameservicekeeper.GetNameRecordIndexKey(crn))
+	}
+
+	for _, key := range keys {
+		if err := m.mirrorKey(ctx, store, state, key); err != nil {
+			return err
+		}
+	}
+
+	// Fetch the reverse index of the records the updated names point to now.
+	for _, crn := range changeSet.Names {
+		if nameRecord := nameservicekeeper.GetNameRecord(store, m.cdc, crn); nameRecord != nil && nameRecord.Latest != nil && nameRecord.Latest.Id != "" {
+			if err := m.mirrorKey(ctx, store, state, nameservicekeeper.GetCIDToNamesIndexKey(nameRecord.Latest.Id)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// snapshot replaces the mirrored registry with the verified state.
+func (m *Mirror) snapshot(ctx context.Context, store sdk.KVStore, state verifiedState) error {
+	m.logger.Info("taking registry snapshot", "height", state.height)
+
+	for _, prefix := range mirroredPrefixes {
+		itr := sdk.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; itr.Valid(); itr.Next() {
+			keys = append(keys, itr.Key())
+		}
+		itr.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+
+		res, err := m.verifier.query(ctx, storeSubspaceQueryPath, prefix, state.height, false)
+		if err != nil {
+			return err
+		}
+
+		var pairs kv.Pairs
+		if err := pairs.Unmarshal(res.Value); err != nil {
+			return err
+		}
+
+		for _, pair := range pairs.Pairs {
+			if err := m.mirrorKey(ctx, store, state, pair.Key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// mirrorKey copies the verified value of a nameservice store key, deleting it if it's absent.
+func (m *Mirror) mirrorKey(ctx context.Context, store sdk.KVStore, state verifiedState, key []byte) error {
+	value, err := state.get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if value == nil {
+		store.Delete(key)
+	} else {
+		store.Set(key, value)
+	}
+
+	return nil
+}
+
+// queryParams reads the nameservice params from the params store.
+func (m *Mirror) queryParams(ctx context.Context, state verifiedState) (nameservicetypes.Params, error) {
+	var params nameservicetypes.Params
+	for _, pair := range params.ParamSetPairs() {
+		key := append([]byte(nameservicetypes.ModuleName+"/"), pair.Key...)
+
+		bz, err := state.getFromStore(ctx, paramstypes.StoreKey, key)
+		if err != nil {
+			return nameservicetypes.Params{}, err
+		}
+
+		if bz == nil {
+			return nameservicetypes.Params{}, fmt.Errorf("nameservice param %s not set", pair.Key)
+		}
+
+		if err := paramsAmino.UnmarshalJSON(bz, pair.Value); err != nil {
+			return nameservicetypes.Params{}, err
+		}
+	}
+
+	return params, nil
+}
+
+// GetRecord returns the mirrored record, nil if it doesn't exist.
+func (m *Mirror) GetRecord(id string) *nameservicetypes.Record {
+	store := m.store()
+	bz := store.Get(nameservicekeeper.GetRecordIndexKey(id))
+	if bz == nil {
+		return nil
+	}
+
+	var record nameservicetypes.Record
+	m.cdc.MustUnmarshal(bz, &record)
+
+	if names := store.Get(nameservicekeeper.GetCIDToNamesIndexKey(id)); names != nil {
+		record.Names, _ = helpers.BytesArrToStringArr(names)
+	}
+
+	return &record
+}
+
+// GetNameAuthority returns the mirrored name authority, nil if it doesn't exist.
+func (m *Mirror) GetNameAuthority(name string) *nameservicetypes.NameAuthority {
+	bz := m.store().Get(nameservicekeeper.GetNameAuthorityIndexKey(name))
+	if bz == nil {
+		return nil
+	}
+
+	var authority nameservicetypes.NameAuthority
+	m.cdc.MustUnmarshal(bz, &authority)
+
+	return &authority
+}
+
+// GetNameRecord returns the mirrored name record of a CRN, nil if the name doesn't exist or its
+// authority isn't active, same as the nameservice LookupCrn query.
+func (m *Mirror) GetNameRecord(crn string) (*nameservicetypes.NameRecord, error) {
+	parsedCRN, err := url.Parse(crn)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid CRN.")
+	}
+
+	authority := m.GetNameAuthority(parsedCRN.Host)
+	if authority == nil || authority.Status != nameservicetypes.AuthorityActive {
+		return nil, nil
+	}
+
+	nameRecord := nameservicekeeper.GetNameRecord(m.store(), m.cdc, crn)
+
+	// Names are stale if the authority was registered after them.
+	if nameRecord == nil || nameRecord.Latest == nil || authority.Height > nameRecord.Latest.Height {
+		return nil, nil
+	}
+
+	return nameRecord, nil
+}
+
+// ResolveCrn resolves a CRN to the mirrored record, nil if it doesn't resolve.
+func (m *Mirror) ResolveCrn(crn string) (*nameservicetypes.Record, error) {
+	nameRecord, err := m.GetNameRecord(crn)
+	if err != nil || nameRecord == nil || nameRecord.Latest.Id == "" {
+		return nil, err
+	}
+
+	return m.GetRecord(nameRecord.Latest.Id), nil
+}
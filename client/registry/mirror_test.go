@@ -0,0 +1,206 @@
+package registry_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tharsis/ethermint/app"
+	"github.com/tharsis/ethermint/client/registry"
+	nameservicekeeper "github.com/tharsis/ethermint/x/nameservice/keeper"
+	nameservicetypes "github.com/tharsis/ethermint/x/nameservice/types"
+)
+
+// testChain serves queries from an app, and the app hashes it committed as verified headers.
+type testChain struct {
+	app       *app.EthermintApp
+	appHashes map[int64][]byte
+	// tamper, if set, modifies query responses.
+	tamper func(res *abci.ResponseQuery)
+}
+
+func newTestChain(t *testing.T) *testChain {
+	testApp := app.Setup(t, false, func(_ *app.EthermintApp, genesis simapp.GenesisState) simapp.GenesisState {
+		return genesis
+	})
+	testApp.Commit()
+
+	return &testChain{app: testApp, appHashes: map[int64][]byte{}}
+}
+
+func (c *testChain) commitBlock(fn func(ctx sdk.Context)) {
+	header := tmproto.Header{
+		ChainID: "ethermint_9000-1",
+		Height:  c.app.LastBlockHeight() + 1,
+		Time:    time.Unix(1650000000+c.app.LastBlockHeight()*5, 0).UTC(),
+	}
+
+	c.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	fn(c.app.BaseApp.NewContext(false, header))
+	c.app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	c.app.Commit()
+
+	// The app hash of the block is in the next header.
+	c.appHashes[header.Height+1] = c.app.LastCommitID().Hash
+}
+
+func (c *testChain) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: c.app.LastBlockHeight() + 1}}, nil
+}
+
+func (c *testChain) ABCIQueryWithOptions(ctx context.Context, path string, data tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	res := c.app.Query(abci.RequestQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	if c.tamper != nil {
+		c.tamper(&res)
+	}
+
+	return &coretypes.ResultABCIQuery{Response: res}, nil
+}
+
+func (c *testChain) VerifyLightBlockAtHeight(ctx context.Context, height int64, now time.Time) (*tmtypes.LightBlock, error) {
+	appHash, ok := c.appHashes[height]
+	if !ok {
+		return nil, fmt.Errorf("no header at height %d", height)
+	}
+
+	return &tmtypes.LightBlock{SignedHeader: &tmtypes.SignedHeader{Header: &tmtypes.Header{Height: height, AppHash: appHash}}}, nil
+}
+
+func TestMirrorSync(t *testing.T) {
+	chain := newTestChain(t)
+	cdc := chain.app.AppCodec()
+
+	chain.commitBlock(func(ctx sdk.Context) {
+		keeper := chain.app.NameServiceKeeper
+		keeper.PutRecord(ctx, nameservicetypes.Record{Id: "record-1"})
+		keeper.SetNameAuthority(ctx, "example.com", &nameservicetypes.NameAuthority{Status: nameservicetypes.AuthorityActive, Height: 1})
+		keeper.SetNameRecord(ctx, "crn://example.com/app", "record-1")
+	})
+
+	mirror := registry.NewMirror(dbm.NewMemDB(), cdc, chain, chain, log.NewNopLogger())
+
+	// The first sync takes a snapshot.
+	height, err := mirror.Sync(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, chain.app.LastBlockHeight(), height)
+
+	record, err := mirror.ResolveCrn("crn://example.com/app")
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	require.Equal(t, "record-1", record.Id)
+	require.Equal(t, []string{"crn://example.com/app"}, record.Names)
+
+	// Later syncs follow the changesets.
+	chain.commitBlock(func(ctx sdk.Context) {
+		keeper := chain.app.NameServiceKeeper
+		keeper.PutRecord(ctx, nameservicetypes.Record{Id: "record-2"})
+		keeper.SetNameRecord(ctx, "crn://example.com/app", "record-2")
+	})
+
+	height, err = mirror.Sync(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, chain.app.LastBlockHeight(), height)
+	require.Equal(t, height, mirror.Height())
+
+	record, err = mirror.ResolveCrn("crn://example.com/app")
+	require.NoError(t, err)
+	require.Equal(t, "record-2", record.Id)
+	require.Equal(t, []string{"crn://example.com/app"}, record.Names)
+	require.Empty(t, mirror.GetRecord("record-1").Names)
+
+	record, err = mirror.ResolveCrn("crn://example.com/missing")
+	require.NoError(t, err)
+	require.Nil(t, record)
+}
+
+func TestMirrorRejectsInvalidProofs(t *testing.T) {
+	chain := newTestChain(t)
+
+	chain.commitBlock(func(ctx sdk.Context) {
+		chain.app.NameServiceKeeper.PutRecord(ctx, nameservicetypes.Record{Id: "record-1", BondId: "bond"})
+	})
+
+	chain.tamper = func(res *abci.ResponseQuery) {
+		if res.ProofOps != nil && res.Value != nil {
+			var record nameservicetypes.Record
+			if err := chain.app.AppCodec().Unmarshal(res.Value, &record); err == nil && record.Id == "record-1" {
+				record.BondId = "forged"
+				res.Value = chain.app.AppCodec().MustMarshal(&record)
+			}
+		}
+	}
+
+	mirror := registry.NewMirror(dbm.NewMemDB(), chain.app.AppCodec(), chain, chain, log.NewNopLogger())
+
+	_, err := mirror.Sync(context.Background())
+	require.ErrorContains(t, err, "verify value proof")
+	require.Equal(t, int64(0), mirror.Height())
+	require.Nil(t, mirror.GetRecord("record-1"))
+}
+
+func TestMirrorRejectsInvalidChangeSets(t *testing.T) {
+	testCases := []struct {
+		name   string
+		tamper func(res *abci.ResponseQuery)
+		errMsg string
+	}{
+		{
+			"tampered changeset",
+			func(res *abci.ResponseQuery) {
+				res.Value = []byte{}
+			},
+			"verify value proof",
+		},
+		{
+			"omitted changeset",
+			func(res *abci.ResponseQuery) {
+				res.Value = nil
+			},
+			"verify absence proof",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chain := newTestChain(t)
+
+			chain.commitBlock(func(ctx sdk.Context) {
+				chain.app.NameServiceKeeper.PutRecord(ctx, nameservicetypes.Record{Id: "record-1"})
+			})
+
+			mirror := registry.NewMirror(dbm.NewMemDB(), chain.app.AppCodec(), chain, chain, log.NewNopLogger())
+
+			syncedHeight, err := mirror.Sync(context.Background())
+			require.NoError(t, err)
+
+			chain.commitBlock(func(ctx sdk.Context) {
+				chain.app.NameServiceKeeper.PutRecord(ctx, nameservicetypes.Record{Id: "record-2"})
+			})
+
+			chain.tamper = func(res *abci.ResponseQuery) {
+				if bytes.HasPrefix(res.Key, nameservicekeeper.PrefixBlockChangesetIndex) && res.Value != nil {
+					tc.tamper(res)
+				}
+			}
+
+			_, err = mirror.Sync(context.Background())
+			require.ErrorContains(t, err, tc.errMsg)
+			require.Equal(t, syncedHeight, mirror.Height())
+			require.Nil(t, mirror.GetRecord("record-2"))
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	nameservicetypes "github.com/tharsis/ethermint/x/nameservice/types"
+)
+
+// Node is the subset of the Tendermint RPC client the mirror syncs from.
+type Node interface {
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+	ABCIQueryWithOptions(ctx context.Context, path string, data tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error)
+}
+
+// LightClient verifies block headers, it's implemented by the Tendermint light client.
+type LightClient interface {
+	VerifyLightBlockAtHeight(ctx context.Context, height int64, now time.Time) (*tmtypes.LightBlock, error)
+}
+
+// verifier queries the node and verifies the returned store proofs.
+type verifier struct {
+	node Node
+	prt  *merkle.ProofRuntime
+}
+
+func newVerifier(node Node) *verifier {
+	return &verifier{
+		node: node,
+		prt:  rootmulti.DefaultProofRuntime(),
+	}
+}
+
+// query runs an ABCI query at the given height.
+func (v *verifier) query(ctx context.Context, path string, data []byte, height int64, prove bool) (abci.ResponseQuery, error) {
+	res, err := v.node.ABCIQueryWithOptions(ctx, path, data, rpcclient.ABCIQueryOptions{Height: height, Prove: prove})
+	if err != nil {
+		return abci.ResponseQuery{}, err
+	}
+
+	if !res.Response.IsOK() {
+		return abci.ResponseQuery{}, fmt.Errorf("query %s failed with code %d: %s", path, res.Response.Code, res.Response.Log)
+	}
+
+	if res.Response.Height != height {
+		return abci.ResponseQuery{}, fmt.Errorf("query %s returned height %d, expected %d", path, res.Response.Height, height)
+	}
+
+	return res.Response, nil
+}
+
+// storeKeyPath returns the merkle key path of a key in the named store.
+func storeKeyPath(storeName string, key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+}
+
+// verifiedState reads store values at a height, verified against the app hash committing to it.
+type verifiedState struct {
+	verifier *verifier
+	height   int64
+	appHash  []byte
+}
+
+// get returns the value of a nameservice store key, nil if it's proven absent.
+func (s verifiedState) get(ctx context.Context, key []byte) ([]byte, error) {
+	return s.getFromStore(ctx, nameservicetypes.StoreKey, key)
+}
+
+// getFromStore returns the value of a key in the named store, nil if it's proven absent.
+func (s verifiedState) getFromStore(ctx context.Context, storeName string, key []byte) ([]byte, error) {
+	res, err := s.verifier.query(ctx, "/store/"+storeName+"/key", key, s.height, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(res.Key, key) {
+		return nil, fmt.Errorf("query returned key %X, expected %X", res.Key, key)
+	}
+
+	if res.ProofOps == nil || len(res.ProofOps.Ops) == 0 {
+		return nil, fmt.Errorf("no proof for key %X", key)
+	}
+
+	if res.Value == nil {
+		if err := s.verifier.prt.VerifyAbsence(res.ProofOps, s.appHash, storeKeyPath(storeName, key)); err != nil {
+			return nil, fmt.Errorf("verify absence proof of key %X: %w", key, err)
+		}
+
+		return nil, nil
+	}
+
+	if err := s.verifier.prt.VerifyValue(res.ProofOps, s.appHash, storeKeyPath(storeName, key), res.Value); err != nil {
+		return nil, fmt.Errorf("verify value proof of key %X: %w", key, err)
+	}
+
+	return res.Value, nil
+}
@@ -0,0 +1,120 @@
+package gql
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// APIKeyHeader is the HTTP header with the API key of a GQL request.
+const APIKeyHeader = "X-API-Key"
+
+var errUnauthenticated = errors.New("missing or invalid API key or token")
+
+type authContextKey struct{}
+
+// authenticator checks the API key or JWT of GQL requests, if any are configured.
+// The admin token is accepted as well.
+type authenticator struct {
+	apiKeys    []string
+	jwtSecret  []byte
+	adminToken string
+}
+
+func newAuthenticator(apiKeys []string, jwtSecret string, adminToken string) authenticator {
+	return authenticator{
+		apiKeys:    apiKeys,
+		jwtSecret:  []byte(jwtSecret),
+		adminToken: adminToken,
+	}
+}
+
+// enabled returns whether requests must be authenticated.
+func (a authenticator) enabled() bool {
+	return len(a.apiKeys) > 0 || len(a.jwtSecret) > 0
+}
+
+// authenticate checks an API key or bearer token.
+func (a authenticator) authenticate(apiKey string, token string) bool {
+	if apiKey != "" {
+		for _, key := range a.apiKeys {
+			if subtle.ConstantTimeCompare([]byte(apiKey), []byte(key)) == 1 {
+				return true
+			}
+		}
+	}
+
+	if token == "" {
+		return false
+	}
+
+	if a.adminToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(a.adminToken)) == 1 {
+		return true
+	}
+
+	return len(a.jwtSecret) > 0 && a.verifyJWT(token) == nil
+}
+
+// verifyJWT checks the signature and the time claims of an HMAC signed JWT.
+func (a authenticator) verifyJWT(token string) error {
+	_, err := jwt.Parse(token, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+
+		return a.jwtSecret, nil
+	})
+
+	return err
+}
+
+// handler rejects the unauthenticated requests. Websocket clients can't set headers from browsers,
+// they can authenticate in the connection init payload instead.
+func (a authenticator) handler(next http.Handler) http.Handler {
+	if !a.enabled() {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a.authenticate(r.Header.Get(APIKeyHeader), getBearerToken(r.Header.Get("Authorization"))) {
+			r = r.WithContext(context.WithValue(r.Context(), authContextKey{}, true))
+		} else if !isWebsocketUpgrade(r) {
+			http.Error(w, errUnauthenticated.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// websocketInit authenticates websocket connections with the "apiKey" or "Authorization"
+// of the connection init payload, unless the upgrade request was authenticated.
+func (a authenticator) websocketInit(ctx context.Context, payload transport.InitPayload) (context.Context, error) {
+	if !a.enabled() {
+		return ctx, nil
+	}
+
+	if authenticated, _ := ctx.Value(authContextKey{}).(bool); authenticated {
+		return ctx, nil
+	}
+
+	if !a.authenticate(payload.GetString("apiKey"), getBearerToken(payload.Authorization())) {
+		return nil, errUnauthenticated
+	}
+
+	return ctx, nil
+}
+
+func getBearerToken(authorization string) string {
+	return strings.TrimPrefix(authorization, "Bearer ")
+}
+
+func isWebsocketUpgrade(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
@@ -0,0 +1,122 @@
+package gql
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gqlclient "github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func signTestJWT(t *testing.T, secret string, expiresAt time.Time) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}).SignedString([]byte(secret))
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestAuthenticatorHandler(t *testing.T) {
+	auth := newAuthenticator([]string{"api-key"}, "jwt-secret", "admin-token")
+	validJWT := signTestJWT(t, "jwt-secret", time.Now().Add(time.Hour))
+
+	testCases := []struct {
+		name      string
+		apiKey    string
+		token     string
+		websocket bool
+		expStatus int
+	}{
+		{"no credentials", "", "", false, http.StatusUnauthorized},
+		{"api key", "api-key", "", false, http.StatusOK},
+		{"invalid api key", "wrong", "", false, http.StatusUnauthorized},
+		{"jwt", "", validJWT, false, http.StatusOK},
+		{"expired jwt", "", signTestJWT(t, "jwt-secret", time.Now().Add(-time.Hour)), false, http.StatusUnauthorized},
+		{"jwt signed with another secret", "", signTestJWT(t, "other-secret", time.Now().Add(time.Hour)), false, http.StatusUnauthorized},
+		{"malformed jwt", "", "not.a.jwt", false, http.StatusUnauthorized},
+		{"admin token", "", "admin-token", false, http.StatusOK},
+		// Websocket clients authenticate in the connection init payload.
+		{"websocket without credentials", "", "", true, http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := auth.handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+			req := httptest.NewRequest(http.MethodPost, "/api", nil)
+			if tc.apiKey != "" {
+				req.Header.Set(APIKeyHeader, tc.apiKey)
+			}
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			if tc.websocket {
+				req.Header.Set("Upgrade", "websocket")
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, tc.expStatus, rec.Code)
+		})
+	}
+
+	// Nothing is checked without API keys or a JWT secret.
+	rec := httptest.NewRecorder()
+	newAuthenticator(nil, "", "admin-token").handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestAuthenticatorWebsocketInit(t *testing.T) {
+	auth := newAuthenticator([]string{"api-key"}, "jwt-secret", "")
+	authenticatedCtx := context.WithValue(context.Background(), authContextKey{}, true)
+
+	testCases := []struct {
+		name    string
+		ctx     context.Context
+		payload transport.InitPayload
+		expErr  bool
+	}{
+		{"no credentials", context.Background(), transport.InitPayload{}, true},
+		{"api key", context.Background(), transport.InitPayload{"apiKey": "api-key"}, false},
+		{"invalid api key", context.Background(), transport.InitPayload{"apiKey": "wrong"}, true},
+		{"jwt", context.Background(), transport.InitPayload{"Authorization": "Bearer " + signTestJWT(t, "jwt-secret", time.Now().Add(time.Hour))}, false},
+		{"expired jwt", context.Background(), transport.InitPayload{"Authorization": "Bearer " + signTestJWT(t, "jwt-secret", time.Now().Add(-time.Hour))}, true},
+		{"authenticated upgrade request", authenticatedCtx, transport.InitPayload{}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := auth.websocketInit(tc.ctx, tc.payload)
+			if tc.expErr {
+				require.ErrorIs(t, err, errUnauthenticated)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebsocketAuth(t *testing.T) {
+	auth := newAuthenticator([]string{"api-key"}, "", "")
+	c := gqlclient.New(auth.handler(newGQLServer(&Resolver{}, auth, 0, 0)))
+
+	var res struct {
+		Typename string `json:"__typename"`
+	}
+
+	sub := c.WebsocketWithPayload(`query { __typename }`, map[string]interface{}{"apiKey": "api-key"})
+	require.NoError(t, sub.Next(&res))
+	require.Equal(t, "Query", res.Typename)
+	require.NoError(t, sub.Close())
+
+	sub = c.WebsocketWithPayload(`query { __typename }`, map[string]interface{}{"apiKey": "wrong"})
+	require.Error(t, sub.Next(&res))
+	require.NoError(t, sub.Close())
+}
@@ -0,0 +1,146 @@
+package gql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
+	"github.com/hashicorp/golang-lru/simplelru"
+	"google.golang.org/grpc"
+)
+
+const (
+	// ResponseCacheSize is the max number of gRPC query responses in the GQL response cache.
+	ResponseCacheSize = 10000
+
+	// ResponseCacheBytes is the max total size of the gRPC query responses in the GQL response cache.
+	ResponseCacheBytes = 64 << 20
+
+	// maxCachedResponseBytes is the size of the largest gRPC query response that is cached,
+	// so that a few large responses (e.g. record listings) don't evict everything else.
+	maxCachedResponseBytes = 1 << 20
+)
+
+var _ gogogrpc.ClientConn = cachedConn{}
+
+// cachedConn is a gRPC client connection that caches the query responses at a block height.
+// The state at a committed height doesn't change, so the responses never go stale;
+// queries of the latest state aren't cached.
+type cachedConn struct {
+	ctx   client.Context
+	cache *responseCache
+}
+
+func newCachedConn(ctx client.Context, maxEntries int, maxBytes int) (cachedConn, error) {
+	cache, err := newResponseCache(maxEntries, maxBytes)
+	if err != nil {
+		return cachedConn{}, err
+	}
+
+	return cachedConn{ctx: ctx, cache: cache}, nil
+}
+
+// Invoke implements the grpc ClientConn.Invoke method.
+func (c cachedConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	height := getHeight(ctx)
+	req, isReqProto := args.(proto.Message)
+	res, isResProto := reply.(proto.Message)
+	if height == "" || !isReqProto || !isResProto {
+		return c.ctx.Invoke(ctx, method, args, reply, opts...)
+	}
+
+	reqBz, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	key := height + "/" + method + "/" + string(reqBz)
+	if cached, ok := c.cache.get(key); ok {
+		if err := proto.Unmarshal(cached, res); err != nil {
+			return err
+		}
+
+		return codectypes.UnpackInterfaces(reply, c.ctx.InterfaceRegistry)
+	}
+
+	if err := c.ctx.Invoke(ctx, method, args, reply, opts...); err != nil {
+		return err
+	}
+
+	resBz, err := proto.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	c.cache.add(key, resBz)
+
+	return nil
+}
+
+// NewStream implements the grpc ClientConn.NewStream method.
+func (c cachedConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return c.ctx.NewStream(ctx, desc, method, opts...)
+}
+
+// responseCache is an LRU cache of query responses, capped by number and total size.
+type responseCache struct {
+	maxBytes int
+
+	mu    sync.Mutex
+	lru   *simplelru.LRU
+	bytes int
+}
+
+func newResponseCache(maxEntries int, maxBytes int) (*responseCache, error) {
+	c := &responseCache{maxBytes: maxBytes}
+
+	cache, err := simplelru.NewLRU(maxEntries, func(key, value interface{}) {
+		c.bytes -= len(key.(string)) + len(value.([]byte))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.lru = cache
+
+	return c, nil
+}
+
+func (c *responseCache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	value, ok := c.lru.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	return value.([]byte), true
+}
+
+// add caches a response, unless it's larger than maxCachedResponseBytes, and evicts the least
+// recently used responses until the cache fits in maxBytes.
+func (c *responseCache) add(key string, value []byte) {
+	size := len(key) + len(value)
+	if size > maxCachedResponseBytes || size > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The response of a query at a height doesn't change.
+	if c.lru.Contains(key) {
+		return
+	}
+
+	c.lru.Add(key, value)
+	c.bytes += size
+
+	for c.bytes > c.maxBytes {
+		c.lru.RemoveOldest()
+	}
+}
@@ -0,0 +1,131 @@
+package gql
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	"google.golang.org/grpc/metadata"
+
+	nstypes "github.com/tharsis/ethermint/x/nameservice/types"
+)
+
+// recordsNode is a Tendermint RPC client serving GetRecordsByIds queries, it counts the queries.
+type recordsNode struct {
+	rpcclient.Client
+
+	queries int
+}
+
+func (n *recordsNode) ABCIQueryWithOptions(ctx context.Context, path string, data tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	n.queries++
+
+	var req nstypes.QueryGetRecordsByIdsRequest
+	if err := req.Unmarshal(data); err != nil {
+		return nil, err
+	}
+
+	res := nstypes.QueryGetRecordsByIdsResponse{Records: make([]nstypes.Record, len(req.Ids))}
+	for i, id := range req.Ids {
+		res.Records[i] = nstypes.Record{Id: id}
+	}
+
+	bz, err := res.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz, Height: opts.Height}}, nil
+}
+
+func TestCachedConn(t *testing.T) {
+	node := &recordsNode{}
+	conn, err := newCachedConn(client.Context{}.WithClient(node), ResponseCacheSize, ResponseCacheBytes)
+	require.NoError(t, err)
+
+	queryClient := nstypes.NewQueryClient(conn)
+	req := &nstypes.QueryGetRecordsByIdsRequest{Ids: []string{"record-1"}}
+	atHeight := func(height string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCBlockHeightHeader, height)
+	}
+
+	// The responses at a height are cached.
+	for i := 0; i < 2; i++ {
+		res, err := queryClient.GetRecordsByIds(atHeight("5"), req)
+		require.NoError(t, err)
+		require.Equal(t, "record-1", res.Records[0].Id)
+	}
+	require.Equal(t, 1, node.queries)
+
+	_, err = queryClient.GetRecordsByIds(atHeight("6"), req)
+	require.NoError(t, err)
+	require.Equal(t, 2, node.queries)
+
+	// The latest state isn't cached.
+	for i := 0; i < 2; i++ {
+		_, err = queryClient.GetRecordsByIds(context.Background(), req)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 4, node.queries)
+}
+
+func TestResponseCache(t *testing.T) {
+	cache, err := newResponseCache(10, 100)
+	require.NoError(t, err)
+
+	value := []byte(strings.Repeat("x", 30))
+	cache.add("a", value)
+	cache.add("b", value)
+	cache.add("c", value)
+	require.Equal(t, 93, cache.bytes)
+
+	// The least recently used responses are evicted to fit the total size.
+	_, ok := cache.get("a")
+	require.True(t, ok)
+
+	cache.add("d", value)
+	require.Equal(t, 93, cache.bytes)
+
+	_, ok = cache.get("b")
+	require.False(t, ok)
+
+	for _, key := range []string{"a", "c", "d"} {
+		cached, ok := cache.get(key)
+		require.True(t, ok)
+		require.Equal(t, value, cached)
+	}
+
+	// Responses larger than the cache aren't cached.
+	cache.add("large", []byte(strings.Repeat("x", 100)))
+	_, ok = cache.get("large")
+	require.False(t, ok)
+	require.Equal(t, 93, cache.bytes)
+
+	// Neither are the responses over the max response size, whatever the cache size.
+	cache, err = newResponseCache(10, 2*maxCachedResponseBytes)
+	require.NoError(t, err)
+
+	cache.add("large", make([]byte, maxCachedResponseBytes))
+	_, ok = cache.get("large")
+	require.False(t, ok)
+	require.Equal(t, 0, cache.bytes)
+
+	// The number of responses is capped as well.
+	cache, err = newResponseCache(2, 100)
+	require.NoError(t, err)
+
+	cache.add("a", []byte("1"))
+	cache.add("b", []byte("2"))
+	cache.add("c", []byte("3"))
+	require.Equal(t, 4, cache.bytes)
+
+	_, ok = cache.get("a")
+	require.False(t, ok)
+}
@@ -0,0 +1,178 @@
+package gql
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/viper"
+)
+
+// DefaultNumBlockTimes is the number of latest block times in the diagnostics by default.
+const DefaultNumBlockTimes = 10
+
+// MaxNumBlockTimes is the max number of latest block times in the diagnostics.
+const MaxNumBlockTimes = 20
+
+var (
+	errDiagnosticsDisabled = errors.New("diagnostics are disabled, start the node with --gql-diagnostics and --gql-admin-token")
+	errUnauthorized        = errors.New("invalid admin token")
+	errNoLogFile           = errors.New("no log file, start the node with --log-file")
+)
+
+type adminContextKey struct{}
+
+// withAdmin marks requests with the admin token as bearer token.
+func withAdmin(adminToken string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := getBearerToken(r.Header.Get("Authorization"))
+		if adminToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) == 1 {
+			r = r.WithContext(context.WithValue(r.Context(), adminContextKey{}, true))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// checkAdmin checks that diagnostics are enabled and the request has the admin token.
+func (r *Resolver) checkAdmin(ctx context.Context) error {
+	if !r.diagnostics {
+		return errDiagnosticsDisabled
+	}
+
+	if isAdmin, _ := ctx.Value(adminContextKey{}).(bool); !isAdmin {
+		return errUnauthorized
+	}
+
+	return nil
+}
+
+func (q queryResolver) GetLogs(ctx context.Context, count *int) ([]*string, error) {
+	if err := q.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if q.logFile == "" {
+		return nil, errNoLogFile
+	}
+
+	numLines := DefaultLogNumLines
+	if count != nil && *count > 0 {
+		numLines = *count
+	}
+
+	if numLines > MaxLogNumLines {
+		numLines = MaxLogNumLines
+	}
+
+	lines, err := tailFile(q.logFile, numLines)
+	if err != nil {
+		return nil, err
+	}
+
+	gqlLines := make([]*string, len(lines))
+	for i := range lines {
+		gqlLines[i] = &lines[i]
+	}
+
+	return gqlLines, nil
+}
+
+// Status returns the resolver of the status fields.
+func (r *Resolver) Status() StatusResolver {
+	return &statusResolver{r}
+}
+
+type statusResolver struct{ *Resolver }
+
+func (s statusResolver) Diagnostics(ctx context.Context, obj *Status, blocks *int) (*Diagnostics, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	numBlocks := DefaultNumBlockTimes
+	if blocks != nil && *blocks >= 0 {
+		numBlocks = *blocks
+	}
+
+	if numBlocks > MaxNumBlockTimes {
+		numBlocks = MaxNumBlockTimes
+	}
+
+	nodeClient, err := s.ctx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	mempool, err := nodeClient.NumUnconfirmedTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTimes, err := getBlockTimes(ctx, s.ctx, numBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	pruning, err := server.GetPruningOptionsFromFlags(viper.GetViper())
+	if err != nil {
+		return nil, err
+	}
+
+	strategy := strings.ToLower(viper.GetString(server.FlagPruning))
+	if strategy == "" {
+		strategy = pruningtypes.PruningOptionDefault
+	}
+
+	versionInfo := version.NewInfo()
+
+	return &Diagnostics{
+		AppVersion:   versionInfo.Version,
+		AppCommit:    versionInfo.GitCommit,
+		MempoolSize:  mempool.Total,
+		MempoolBytes: strconv.FormatInt(mempool.TotalBytes, 10),
+		Pruning: &PruningInfo{
+			Strategy:   strategy,
+			KeepRecent: strconv.FormatUint(pruning.KeepRecent, 10),
+			Interval:   strconv.FormatUint(pruning.Interval, 10),
+		},
+		BlockTimes: blockTimes,
+		Memory:     getMemoryInfo(),
+	}, nil
+}
+
+// tailFile returns the last lines of a file.
+func tailFile(path string, numLines int) ([]string, error) {
+	out, err := exec.Command("tail", "-n", strconv.Itoa(numLines), path).Output()
+	if err != nil {
+		return nil, err
+	}
+
+	output := strings.TrimSuffix(string(out), "\n")
+	if output == "" {
+		return []string{}, nil
+	}
+
+	return strings.Split(output, "\n"), nil
+}
+
+func getMemoryInfo() *MemoryInfo {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+
+	return &MemoryInfo{
+		Alloc:        strconv.FormatUint(stats.Alloc, 10),
+		Sys:          strconv.FormatUint(stats.Sys, 10),
+		HeapInUse:    strconv.FormatUint(stats.HeapInuse, 10),
+		NumGc:        int(stats.NumGC),
+		NumGoroutine: runtime.NumGoroutine(),
+	}
+}
@@ -0,0 +1,121 @@
+package gql
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/stretchr/testify/require"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// blocksNode is a Tendermint RPC client serving a chain of block headers, 5 seconds apart.
+type blocksNode struct {
+	rpcclient.Client
+
+	height int64
+}
+
+func (n blocksNode) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: n.height}}, nil
+}
+
+// BlockchainInfo returns at most 20 block metas, newest first, like Tendermint.
+func (n blocksNode) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*coretypes.ResultBlockchainInfo, error) {
+	if minHeight < maxHeight-19 {
+		minHeight = maxHeight - 19
+	}
+
+	res := &coretypes.ResultBlockchainInfo{LastHeight: n.height}
+	for height := maxHeight; height >= minHeight; height-- {
+		res.BlockMetas = append(res.BlockMetas, &tmtypes.BlockMeta{Header: tmtypes.Header{
+			Height: height,
+			Time:   time.Unix(1650000000+height*5, 0),
+		}})
+	}
+
+	return res, nil
+}
+
+func TestWithAdmin(t *testing.T) {
+	testCases := []struct {
+		name          string
+		adminToken    string
+		authorization string
+		expAdmin      bool
+	}{
+		{"no admin token", "", "Bearer ", false},
+		{"no token", "secret", "", false},
+		{"invalid token", "secret", "Bearer wrong", false},
+		{"admin token", "secret", "Bearer secret", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var isAdmin bool
+			handler := withAdmin(tc.adminToken, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				isAdmin, _ = r.Context().Value(adminContextKey{}).(bool)
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/api", nil)
+			req.Header.Set("Authorization", tc.authorization)
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			require.Equal(t, tc.expAdmin, isAdmin)
+		})
+	}
+}
+
+func TestCheckAdmin(t *testing.T) {
+	adminCtx := context.WithValue(context.Background(), adminContextKey{}, true)
+
+	require.ErrorIs(t, (&Resolver{}).checkAdmin(adminCtx), errDiagnosticsDisabled)
+	require.ErrorIs(t, (&Resolver{diagnostics: true}).checkAdmin(context.Background()), errUnauthorized)
+	require.NoError(t, (&Resolver{diagnostics: true}).checkAdmin(adminCtx))
+}
+
+func TestGetLogs(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "node.log")
+	require.NoError(t, os.WriteFile(logFile, []byte("first\nsecond\nthird\n"), 0o600))
+
+	q := queryResolver{&Resolver{logFile: logFile, diagnostics: true}}
+	adminCtx := context.WithValue(context.Background(), adminContextKey{}, true)
+
+	_, err := q.GetLogs(context.Background(), nil)
+	require.ErrorIs(t, err, errUnauthorized)
+
+	count := 2
+	lines, err := q.GetLogs(adminCtx, &count)
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	require.Equal(t, "second", *lines[0])
+	require.Equal(t, "third", *lines[1])
+}
+
+func TestGetBlockTimes(t *testing.T) {
+	clientCtx := client.Context{}.WithClient(blocksNode{height: 30})
+
+	// The oldest block has a duration, even though it takes more than one BlockchainInfo call.
+	blockTimes, err := getBlockTimes(context.Background(), clientCtx, MaxNumBlockTimes)
+	require.NoError(t, err)
+	require.Len(t, blockTimes, MaxNumBlockTimes)
+	for i, blockTime := range blockTimes {
+		require.Equal(t, strconv.Itoa(30-i), blockTime.Height)
+		require.Equal(t, "5s", blockTime.Duration)
+	}
+
+	// The first block has no previous block.
+	blockTimes, err = getBlockTimes(context.Background(), client.Context{}.WithClient(blocksNode{height: 3}), 5)
+	require.NoError(t, err)
+	require.Len(t, blockTimes, 3)
+	require.Equal(t, "1", blockTimes[2].Height)
+	require.Equal(t, "0s", blockTimes[2].Duration)
+}
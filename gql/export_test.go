@@ -0,0 +1,22 @@
+package gql
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// NewTestHandler returns the GQL handler of a node, without the auth and rate limits. It's used by the
+// tests of the gql_test package, which can import the app.
+func NewTestHandler(ctx client.Context) (http.Handler, error) {
+	conn, err := newCachedConn(ctx, ResponseCacheSize, ResponseCacheBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return newGQLServer(&Resolver{
+		ctx:  ctx,
+		conn: conn,
+		feed: newBlockFeed(ctx),
+	}, authenticator{}, DefaultMaxComplexity, DefaultMaxDepth), nil
+}
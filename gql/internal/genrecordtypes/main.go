@@ -0,0 +1,188 @@
+// genrecordtypes generates the typed record variants of the GQL schema from the record type definitions.
+//
+//	go run ./internal/genrecordtypes [definitions] [schema] [registry]
+//
+// It writes the GraphQL variants and filters to the schema file and the variant registry to the Go file,
+// the GQL server is then regenerated with gqlgen. See `go generate ./gql`.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+)
+
+// RecordType is the definition of a record type.
+type RecordType struct {
+	Type       string      `yaml:"type"`
+	Attributes []Attribute `yaml:"attributes"`
+}
+
+// Attribute is the definition of a record type attribute.
+type Attribute struct {
+	Name   string `yaml:"name"`
+	Type   string `yaml:"type"`
+	Filter bool   `yaml:"filter"`
+}
+
+var (
+	typeNameRegexp      = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+	attributeNameRegexp = regexp.MustCompile(`^[a-z][A-Za-z0-9]*$`)
+
+	attributeTypes = map[string]bool{"String": true, "Boolean": true, "Float": true, "Int": true}
+
+	// reservedTypes are declared in the schema.
+	reservedTypes = map[string]bool{"Record": true, "TypedRecord": true, "GenericRecord": true}
+
+	// commonFields are the TypedRecord fields.
+	commonFields = map[string]bool{
+		"id": true, "names": true, "bondId": true, "createTime": true,
+		"expiryTime": true, "owners": true, "type": true, "record": true,
+	}
+)
+
+var schemaTemplate = template.Must(template.New("schema").Funcs(template.FuncMap{"filterName": filterName}).Parse(
+	`# Code generated by genrecordtypes from record_types.yml, DO NOT EDIT.
+{{range .}}
+type {{.Type}} implements TypedRecord {
+    id:         String!
+    names:      [String!]
+    bondId:     String!
+    createTime: String!
+    expiryTime: String!
+    owners:     [String!]
+    type:       String!
+    record:     Record!
+{{range .Attributes}}
+    {{printf "%-11s" (printf "%s:" .Name)}} {{.Type}}{{end}}
+}
+{{if .Filters}}
+input {{.Type}}Filter {
+{{- range .Filters}}
+    {{printf "%-11s" (printf "%s:" .Name)}} {{.Type}}{{end}}
+}
+{{end}}{{end}}
+# Filter of a record type, exactly one of the fields must be set.
+# The set fields of the type filter are attribute conditions, in a logical AND.
+input RecordTypeFilter {
+{{- range .}}{{if .Filters}}
+    {{printf "%-27s" (printf "%s:" (filterName .Type))}} {{.Type}}Filter{{end}}{{end}}
+}
+`))
+
+var registryTemplate = template.Must(template.New("registry").Parse(
+	`// Code generated by genrecordtypes from record_types.yml, DO NOT EDIT.
+
+package gql
+
+// recordTypes are the typed record variants of the GQL schema, by record type.
+// The fields of a variant are decoded from the record by their JSON names, see getGQLTypedRecord.
+var recordTypes = map[string]func() TypedRecord{
+{{- range .}}
+	"{{.Type}}": func() TypedRecord { return &{{.Type}}{} },{{end}}
+}
+`))
+
+// Filters returns the filtered attributes of the record type.
+func (t RecordType) Filters() []Attribute {
+	var filters []Attribute
+	for _, attribute := range t.Attributes {
+		if attribute.Filter {
+			filters = append(filters, attribute)
+		}
+	}
+
+	return filters
+}
+
+func filterName(recordType string) string {
+	return strings.ToLower(recordType[:1]) + recordType[1:]
+}
+
+func validate(recordTypes []RecordType) error {
+	if len(recordTypes) == 0 {
+		return fmt.Errorf("no record types defined")
+	}
+
+	types := map[string]bool{}
+	hasFilters := false
+	for _, recordType := range recordTypes {
+		if !typeNameRegexp.MatchString(recordType.Type) || reservedTypes[recordType.Type] || types[recordType.Type] {
+			return fmt.Errorf("invalid record type name %q", recordType.Type)
+		}
+		types[recordType.Type] = true
+		hasFilters = hasFilters || len(recordType.Filters()) > 0
+
+		attributes := map[string]bool{}
+		for _, attribute := range recordType.Attributes {
+			if !attributeNameRegexp.MatchString(attribute.Name) || commonFields[attribute.Name] || attributes[attribute.Name] {
+				return fmt.Errorf("invalid attribute name %q of record type %s", attribute.Name, recordType.Type)
+			}
+			attributes[attribute.Name] = true
+
+			if !attributeTypes[attribute.Type] {
+				return fmt.Errorf("invalid type %q of attribute %s of record type %s", attribute.Type, attribute.Name, recordType.Type)
+			}
+		}
+	}
+
+	if !hasFilters {
+		return fmt.Errorf("no record type has filtered attributes")
+	}
+
+	return nil
+}
+
+func generate(definitionsPath string, schemaPath string, registryPath string) error {
+	definitions, err := ioutil.ReadFile(definitionsPath)
+	if err != nil {
+		return err
+	}
+
+	var recordTypes []RecordType
+	if err := yaml.UnmarshalStrict(definitions, &recordTypes); err != nil {
+		return fmt.Errorf("invalid record type definitions: %w", err)
+	}
+
+	if err := validate(recordTypes); err != nil {
+		return err
+	}
+
+	var schema bytes.Buffer
+	if err := schemaTemplate.Execute(&schema, recordTypes); err != nil {
+		return err
+	}
+
+	var registry bytes.Buffer
+	if err := registryTemplate.Execute(&registry, recordTypes); err != nil {
+		return err
+	}
+
+	source, err := format.Source(registry.Bytes())
+	if err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(schemaPath, schema.Bytes(), 0644); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(registryPath, source, 0644)
+}
+
+func main() {
+	args := []string{"record_types.yml", "vulcanize/chiba-clonk/record_types.graphql", "record_types_gen.go"}
+	copy(args, os.Args[1:])
+
+	if err := generate(args[0], args[1], args[2]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	dir := t.TempDir()
+	schemaPath := filepath.Join(dir, "record_types.graphql")
+	registryPath := filepath.Join(dir, "record_types_gen.go")
+
+	require.NoError(t, generate("../../record_types.yml", schemaPath, registryPath))
+
+	for generated, checkedIn := range map[string]string{
+		schemaPath:   "../../vulcanize/chiba-clonk/record_types.graphql",
+		registryPath: "../../record_types_gen.go",
+	} {
+		expected, err := ioutil.ReadFile(generated)
+		require.NoError(t, err)
+		actual, err := ioutil.ReadFile(checkedIn)
+		require.NoError(t, err)
+		require.Equal(t, string(expected), string(actual), "%s is out of date, run go generate ./gql", checkedIn)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	attribute := Attribute{Name: "url", Type: "String", Filter: true}
+
+	testCases := []struct {
+		msg         string
+		recordTypes []RecordType
+		expPass     bool
+	}{
+		{"valid", []RecordType{{Type: "ServiceRecord", Attributes: []Attribute{attribute}}}, true},
+		{"no types", nil, false},
+		{"no filters", []RecordType{{Type: "ServiceRecord", Attributes: []Attribute{{Name: "url", Type: "String"}}}}, false},
+		{"invalid type name", []RecordType{{Type: "serviceRecord", Attributes: []Attribute{attribute}}}, false},
+		{"reserved type name", []RecordType{{Type: "GenericRecord", Attributes: []Attribute{attribute}}}, false},
+		{"duplicate type", []RecordType{{Type: "ServiceRecord", Attributes: []Attribute{attribute}}, {Type: "ServiceRecord"}}, false},
+		{"common field", []RecordType{{Type: "ServiceRecord", Attributes: []Attribute{attribute, {Name: "owners", Type: "String"}}}}, false},
+		{"duplicate attribute", []RecordType{{Type: "ServiceRecord", Attributes: []Attribute{attribute, attribute}}}, false},
+		{"invalid attribute type", []RecordType{{Type: "ServiceRecord", Attributes: []Attribute{attribute, {Name: "tags", Type: "[String]"}}}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			err := validate(tc.recordTypes)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package gql
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	// DefaultMaxComplexity is the max complexity of a GQL operation by default.
+	DefaultMaxComplexity = 1000
+
+	// DefaultMaxDepth is the max depth of a GQL operation by default.
+	DefaultMaxDepth = 10
+
+	// DefaultTimeout is the time limit of a GQL request by default, subscriptions aren't limited.
+	DefaultTimeout = 30 * time.Second
+)
+
+// depthLimit is a GQL handler extension that rejects operations nested deeper than a limit,
+// e.g. deep record references. Introspection fields aren't counted.
+type depthLimit struct {
+	maxDepth int
+}
+
+var (
+	_ graphql.HandlerExtension        = depthLimit{}
+	_ graphql.OperationContextMutator = depthLimit{}
+)
+
+func (d depthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (d depthLimit) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (d depthLimit) MutateOperationContext(_ context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	if rc.Operation == nil {
+		return nil
+	}
+
+	if depth := getSelectionDepth(rc.Operation.SelectionSet); depth > d.maxDepth {
+		return gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.maxDepth)
+	}
+
+	return nil
+}
+
+// getSelectionDepth returns the depth of the fields in a selection set.
+// Fragment cycles are rejected by the validation, before the depth is checked.
+func getSelectionDepth(selectionSet ast.SelectionSet) int {
+	maxDepth := 0
+	for _, selection := range selectionSet {
+		depth := 0
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+
+			depth = 1 + getSelectionDepth(s.SelectionSet)
+		case *ast.InlineFragment:
+			depth = getSelectionDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				depth = getSelectionDepth(s.Definition.SelectionSet)
+			}
+		}
+
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+	}
+
+	return maxDepth
+}
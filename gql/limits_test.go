@@ -0,0 +1,45 @@
+package gql
+
+import (
+	"testing"
+
+	gqlclient "github.com/99designs/gqlgen/client"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+)
+
+func TestGetSelectionDepth(t *testing.T) {
+	schema := NewExecutableSchema(Config{}).Schema()
+
+	testCases := []struct {
+		name     string
+		query    string
+		expDepth int
+	}{
+		{"fields", `{ getRecordsByIds(ids: ["a"]) { id references { id references { id } } } }`, 4},
+		{"fragment spread", `{ getRecordsByIds(ids: ["a"]) { ...refs } } fragment refs on Record { references { id } }`, 3},
+		{"inline fragment", `{ getRecordsByIds(ids: ["a"]) { ... on Record { id } } }`, 2},
+		{"introspection", `{ __schema { types { name fields { name } } } getStatus { version } }`, 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := gqlparser.LoadQuery(schema, tc.query)
+			require.Nil(t, err)
+			require.Equal(t, tc.expDepth, getSelectionDepth(doc.Operations[0].SelectionSet))
+		})
+	}
+}
+
+func TestDepthLimit(t *testing.T) {
+	c := gqlclient.New(newGQLServer(&Resolver{}, authenticator{}, 0, 3))
+
+	var res struct{}
+	err := c.Post(`{ getRecordsByIds(ids: ["a"]) { references { references { id } } } }`, &res)
+	require.ErrorContains(t, err, "operation has depth 4, which exceeds the limit of 3")
+
+	// Introspection isn't limited.
+	var schema map[string]interface{}
+	require.NoError(t, c.Post(`{ __schema { types { name fields { name type { name ofType { name } } } } } }`, &schema))
+	require.NotEmpty(t, schema["__schema"])
+}
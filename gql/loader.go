@@ -0,0 +1,209 @@
+package gql
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cosmos/cosmos-sdk/client"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	nstypes "github.com/tharsis/ethermint/x/nameservice/types"
+)
+
+const (
+	// loaderWait is how long the record loader waits for more IDs before fetching a batch.
+	loaderWait = 2 * time.Millisecond
+
+	// loaderMaxBatch is the max number of records the record loader fetches in a batch.
+	loaderMaxBatch = 100
+)
+
+type requestStateContextKey struct{}
+
+// requestState is the state shared by the resolvers of a GQL query.
+type requestState struct {
+	heightOnce   sync.Once
+	latestHeight string
+	heightErr    error
+
+	mu      sync.Mutex
+	loaders map[string]*recordLoader
+}
+
+// withRequestState adds a request state to the context of GQL queries.
+// Mutations and subscriptions are left alone, as they follow the latest state.
+func withRequestState(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	operation := graphql.GetOperationContext(ctx).Operation
+	if operation != nil && operation.Operation == ast.Query {
+		ctx = context.WithValue(ctx, requestStateContextKey{}, &requestState{loaders: map[string]*recordLoader{}})
+	}
+
+	return next(ctx)
+}
+
+func getRequestState(ctx context.Context) *requestState {
+	state, _ := ctx.Value(requestStateContextKey{}).(*requestState)
+	return state
+}
+
+// getLatestHeight returns the latest height committed by the app when first called in the request.
+func (s *requestState) getLatestHeight(ctx context.Context, clientCtx client.Context) (string, error) {
+	s.heightOnce.Do(func() {
+		node, err := clientCtx.GetNode()
+		if err != nil {
+			s.heightErr = err
+			return
+		}
+
+		info, err := node.ABCIInfo(ctx)
+		if err != nil {
+			s.heightErr = err
+			return
+		}
+
+		s.latestHeight = strconv.FormatInt(info.Response.LastBlockHeight, 10)
+	})
+
+	return s.latestHeight, s.heightErr
+}
+
+// recordLoader returns the record loader of the request at the height of the context,
+// or a loader for a single call outside of a GQL query.
+func (q queryResolver) recordLoader(ctx context.Context) *recordLoader {
+	state := getRequestState(ctx)
+	if state == nil {
+		return newRecordLoader(ctx, q.conn, 0)
+	}
+
+	height := getHeight(ctx)
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	loader, ok := state.loaders[height]
+	if !ok {
+		loader = newRecordLoader(ctx, q.conn, loaderWait)
+		state.loaders[height] = loader
+	}
+
+	return loader
+}
+
+// recordLoader batches the record lookups of concurrent resolvers into GetRecordsByIds calls,
+// and caches the records for the request.
+type recordLoader struct {
+	ctx  context.Context
+	conn gogogrpc.ClientConn
+	wait time.Duration
+
+	mu      sync.Mutex
+	records map[string]recordResult
+	batch   *recordBatch
+}
+
+// recordResult is the position of a record in a batch.
+type recordResult struct {
+	batch *recordBatch
+	index int
+}
+
+type recordBatch struct {
+	ids     []string
+	done    chan struct{}
+	records []nstypes.Record
+	err     error
+}
+
+func newRecordLoader(ctx context.Context, conn gogogrpc.ClientConn, wait time.Duration) *recordLoader {
+	return &recordLoader{
+		ctx:     ctx,
+		conn:    conn,
+		wait:    wait,
+		records: map[string]recordResult{},
+	}
+}
+
+// LoadAll returns the records with the IDs, empty records for the IDs not found.
+func (l *recordLoader) LoadAll(ids []string) ([]nstypes.Record, error) {
+	results := make([]recordResult, len(ids))
+
+	l.mu.Lock()
+	for i, id := range ids {
+		results[i] = l.enqueue(id)
+	}
+	l.mu.Unlock()
+
+	records := make([]nstypes.Record, len(ids))
+	for i, result := range results {
+		<-result.batch.done
+		if result.batch.err != nil {
+			return nil, result.batch.err
+		}
+
+		records[i] = result.batch.records[result.index]
+	}
+
+	return records, nil
+}
+
+// enqueue adds an ID to the pending batch, unless it's already loaded or pending.
+// It must be called with the lock held.
+func (l *recordLoader) enqueue(id string) recordResult {
+	if result, ok := l.records[id]; ok {
+		return result
+	}
+
+	if l.batch == nil {
+		l.batch = &recordBatch{done: make(chan struct{})}
+		go l.fetchAfterWait(l.batch)
+	}
+
+	result := recordResult{batch: l.batch, index: len(l.batch.ids)}
+	l.batch.ids = append(l.batch.ids, id)
+	l.records[id] = result
+
+	if len(l.batch.ids) >= loaderMaxBatch {
+		go l.fetch(l.batch)
+		l.batch = nil
+	}
+
+	return result
+}
+
+func (l *recordLoader) fetchAfterWait(batch *recordBatch) {
+	time.Sleep(l.wait)
+
+	l.mu.Lock()
+	if l.batch != batch {
+		// The batch is full and already fetched.
+		l.mu.Unlock()
+		return
+	}
+
+	l.batch = nil
+	l.mu.Unlock()
+
+	l.fetch(batch)
+}
+
+func (l *recordLoader) fetch(batch *recordBatch) {
+	defer close(batch.done)
+
+	res, err := nstypes.NewQueryClient(l.conn).GetRecordsByIds(l.ctx, &nstypes.QueryGetRecordsByIdsRequest{Ids: batch.ids})
+	if err != nil {
+		batch.err = err
+		return
+	}
+
+	if len(res.GetRecords()) != len(batch.ids) {
+		batch.err = fmt.Errorf("expected %d records, got %d", len(batch.ids), len(res.GetRecords()))
+		return
+	}
+
+	batch.records = res.GetRecords()
+}
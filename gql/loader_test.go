@@ -0,0 +1,145 @@
+package gql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	nstypes "github.com/tharsis/ethermint/x/nameservice/types"
+)
+
+// fakeConn is a gRPC client connection serving GetRecordsByIds and Whois queries.
+type fakeConn struct {
+	// delay is how long a query takes.
+	delay time.Duration
+	err   error
+
+	mu        sync.Mutex
+	batches   [][]string
+	inFlight  int
+	maxFlight int
+}
+
+func (c *fakeConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	c.mu.Lock()
+	c.inFlight++
+	if c.inFlight > c.maxFlight {
+		c.maxFlight = c.inFlight
+	}
+	c.mu.Unlock()
+
+	time.Sleep(c.delay)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.inFlight--
+
+	if c.err != nil {
+		return c.err
+	}
+
+	switch req := args.(type) {
+	case *nstypes.QueryGetRecordsByIdsRequest:
+		c.batches = append(c.batches, req.Ids)
+
+		res := reply.(*nstypes.QueryGetRecordsByIdsResponse)
+		for _, id := range req.Ids {
+			res.Records = append(res.Records, nstypes.Record{Id: id})
+		}
+	case *nstypes.QueryWhoisRequest:
+		reply.(*nstypes.QueryWhoisResponse).NameAuthority = nstypes.NameAuthority{OwnerAddress: req.Name}
+	default:
+		return fmt.Errorf("unexpected method %s", method)
+	}
+
+	return nil
+}
+
+func (c *fakeConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not supported")
+}
+
+func TestRecordLoader(t *testing.T) {
+	conn := &fakeConn{}
+	loader := newRecordLoader(context.Background(), conn, 20*time.Millisecond)
+
+	// The concurrent loads are batched, without the duplicate IDs.
+	var wg sync.WaitGroup
+	for _, ids := range [][]string{{"a", "b"}, {"b", "c"}, {"c"}} {
+		ids := ids
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			records, err := loader.LoadAll(ids)
+			assert.NoError(t, err)
+			for i, record := range records {
+				assert.Equal(t, ids[i], record.Id)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, conn.batches, 1)
+	require.ElementsMatch(t, []string{"a", "b", "c"}, conn.batches[0])
+
+	// The loaded records are cached for the request.
+	records, err := loader.LoadAll([]string{"c", "a"})
+	require.NoError(t, err)
+	require.Equal(t, "c", records[0].Id)
+	require.Equal(t, "a", records[1].Id)
+	require.Len(t, conn.batches, 1)
+}
+
+func TestRecordLoaderMaxBatch(t *testing.T) {
+	conn := &fakeConn{}
+	loader := newRecordLoader(context.Background(), conn, time.Millisecond)
+
+	ids := make([]string, loaderMaxBatch+1)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("record-%d", i)
+	}
+
+	records, err := loader.LoadAll(ids)
+	require.NoError(t, err)
+	require.Len(t, records, len(ids))
+	require.Equal(t, ids[loaderMaxBatch], records[loaderMaxBatch].Id)
+
+	require.Len(t, conn.batches, 2)
+	require.Len(t, conn.batches[0], loaderMaxBatch)
+	require.Len(t, conn.batches[1], 1)
+}
+
+func TestRecordLoaderError(t *testing.T) {
+	conn := &fakeConn{err: errors.New("query failed")}
+	loader := newRecordLoader(context.Background(), conn, 0)
+
+	_, err := loader.LoadAll([]string{"a"})
+	require.ErrorContains(t, err, "query failed")
+}
+
+func TestLookupAuthoritiesConcurrency(t *testing.T) {
+	conn := &fakeConn{delay: 5 * time.Millisecond}
+	q := queryResolver{&Resolver{conn: conn}}
+
+	names := make([]string, 3*maxConcurrentLookups)
+	for i := range names {
+		names[i] = fmt.Sprintf("name-%d", i)
+	}
+
+	authorities, err := q.LookupAuthorities(context.Background(), names, nil)
+	require.NoError(t, err)
+	require.Len(t, authorities, len(names))
+	for i, authority := range authorities {
+		require.Equal(t, names[i], authority.OwnerAddress)
+	}
+
+	require.LessOrEqual(t, conn.maxFlight, maxConcurrentLookups)
+}
@@ -0,0 +1,50 @@
+package gql
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// The GQL metrics are registered with the default Prometheus registry,
+// served by the node with instrumentation.prometheus enabled.
+var (
+	resolverDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "gql",
+		Name:      "resolver_duration_seconds",
+		Help:      "Latency of the GQL resolvers.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"object", "field"})
+
+	resolverErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gql",
+		Name:      "resolver_errors_total",
+		Help:      "Number of GQL resolver errors.",
+	}, []string{"object", "field"})
+
+	rateLimitedRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "gql",
+		Name:      "rate_limited_requests_total",
+		Help:      "Number of GQL requests rejected by the rate limiter.",
+	})
+)
+
+// observeResolver records the latency and errors of the resolvers, other fields are plain struct fields.
+func observeResolver(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	res, err := next(ctx)
+	resolverDuration.WithLabelValues(fc.Object, fc.Field.Name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		resolverErrors.WithLabelValues(fc.Object, fc.Field.Name).Inc()
+	}
+
+	return res, err
+}
@@ -2,6 +2,16 @@
 
 package gql
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type TypedRecord interface {
+	IsTypedRecord()
+}
+
 type Account struct {
 	Address  string  `json:"address"`
 	PubKey   *string `json:"pubKey"`
@@ -10,6 +20,31 @@ type Account struct {
 	Balance  []*Coin `json:"balance"`
 }
 
+type ApplicationRecord struct {
+	ID          string   `json:"id"`
+	Names       []string `json:"names"`
+	BondID      string   `json:"bondId"`
+	CreateTime  string   `json:"createTime"`
+	ExpiryTime  string   `json:"expiryTime"`
+	Owners      []string `json:"owners"`
+	Type        string   `json:"type"`
+	Record      *Record  `json:"record"`
+	Name        *string  `json:"name"`
+	Version     *string  `json:"version"`
+	AppType     *string  `json:"appType"`
+	Repository  *string  `json:"repository"`
+	Description *string  `json:"description"`
+}
+
+func (ApplicationRecord) IsTypedRecord() {}
+
+type ApplicationRecordFilter struct {
+	Name       *string `json:"name"`
+	Version    *string `json:"version"`
+	AppType    *string `json:"appType"`
+	Repository *string `json:"repository"`
+}
+
 type Auction struct {
 	ID             string        `json:"id"`
 	Status         string        `json:"status"`
@@ -37,6 +72,16 @@ type AuctionBid struct {
 	BidAmount     *Coin  `json:"bidAmount"`
 }
 
+type AuctionEvent struct {
+	Auction       *Auction `json:"auction"`
+	BidderAddress *string  `json:"bidderAddress"`
+}
+
+type AuthorityChange struct {
+	Name      string           `json:"name"`
+	Authority *AuthorityRecord `json:"authority"`
+}
+
 type AuthorityRecord struct {
 	OwnerAddress   string   `json:"ownerAddress"`
 	OwnerPublicKey string   `json:"ownerPublicKey"`
@@ -47,6 +92,18 @@ type AuthorityRecord struct {
 	Auction        *Auction `json:"auction"`
 }
 
+type Block struct {
+	Height string `json:"height"`
+	Hash   string `json:"hash"`
+	Time   string `json:"time"`
+}
+
+type BlockTime struct {
+	Height   string `json:"height"`
+	Time     string `json:"time"`
+	Duration string `json:"duration"`
+}
+
 type Bond struct {
 	ID      string  `json:"id"`
 	Owner   string  `json:"owner"`
@@ -58,6 +115,39 @@ type Coin struct {
 	Quantity string `json:"quantity"`
 }
 
+type Diagnostics struct {
+	AppVersion   string       `json:"appVersion"`
+	AppCommit    string       `json:"appCommit"`
+	MempoolSize  int          `json:"mempoolSize"`
+	MempoolBytes string       `json:"mempoolBytes"`
+	Pruning      *PruningInfo `json:"pruning"`
+	BlockTimes   []*BlockTime `json:"blockTimes"`
+	Memory       *MemoryInfo  `json:"memory"`
+}
+
+type Event struct {
+	Type       string            `json:"type"`
+	Attributes []*EventAttribute `json:"attributes"`
+}
+
+type EventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type GenericRecord struct {
+	ID         string   `json:"id"`
+	Names      []string `json:"names"`
+	BondID     string   `json:"bondId"`
+	CreateTime string   `json:"createTime"`
+	ExpiryTime string   `json:"expiryTime"`
+	Owners     []string `json:"owners"`
+	Type       string   `json:"type"`
+	Record     *Record  `json:"record"`
+}
+
+func (GenericRecord) IsTypedRecord() {}
+
 type KeyValue struct {
 	Key   string `json:"key"`
 	Value *Value `json:"value"`
@@ -68,6 +158,19 @@ type KeyValueInput struct {
 	Value *ValueInput `json:"value"`
 }
 
+type MemoryInfo struct {
+	Alloc        string `json:"alloc"`
+	Sys          string `json:"sys"`
+	HeapInUse    string `json:"heapInUse"`
+	NumGc        int    `json:"numGC"`
+	NumGoroutine int    `json:"numGoroutine"`
+}
+
+type NameChange struct {
+	Name   string      `json:"name"`
+	Record *NameRecord `json:"record"`
+}
+
 type NameRecord struct {
 	Latest  *NameRecordEntry   `json:"latest"`
 	History []*NameRecordEntry `json:"history"`
@@ -95,15 +198,16 @@ type PeerInfo struct {
 	RemoteIP   string    `json:"remote_ip"`
 }
 
-type Record struct {
-	ID         string      `json:"id"`
-	Names      []string    `json:"names"`
-	BondID     string      `json:"bondId"`
-	CreateTime string      `json:"createTime"`
-	ExpiryTime string      `json:"expiryTime"`
-	Owners     []string    `json:"owners"`
-	Attributes []*KeyValue `json:"attributes"`
-	References []*Record   `json:"references"`
+type PruningInfo struct {
+	Strategy   string `json:"strategy"`
+	KeepRecent string `json:"keepRecent"`
+	Interval   string `json:"interval"`
+}
+
+type RecordTypeFilter struct {
+	ServiceRecord             *ServiceRecordFilter             `json:"serviceRecord"`
+	ApplicationRecord         *ApplicationRecordFilter         `json:"applicationRecord"`
+	WebsiteRegistrationRecord *WebsiteRegistrationRecordFilter `json:"websiteRegistrationRecord"`
 }
 
 type Reference struct {
@@ -114,15 +218,45 @@ type ReferenceInput struct {
 	ID string `json:"id"`
 }
 
+type ServiceRecord struct {
+	ID          string   `json:"id"`
+	Names       []string `json:"names"`
+	BondID      string   `json:"bondId"`
+	CreateTime  string   `json:"createTime"`
+	ExpiryTime  string   `json:"expiryTime"`
+	Owners      []string `json:"owners"`
+	Type        string   `json:"type"`
+	Record      *Record  `json:"record"`
+	Name        *string  `json:"name"`
+	Version     *string  `json:"version"`
+	URL         *string  `json:"url"`
+	Description *string  `json:"description"`
+}
+
+func (ServiceRecord) IsTypedRecord() {}
+
+type ServiceRecordFilter struct {
+	Name    *string `json:"name"`
+	Version *string `json:"version"`
+	URL     *string `json:"url"`
+}
+
+type SimulateResult struct {
+	GasUsed string   `json:"gasUsed"`
+	Logs    []*TxLog `json:"logs"`
+	Error   *TxError `json:"error"`
+}
+
 type Status struct {
-	Version    string           `json:"version"`
-	Node       *NodeInfo        `json:"node"`
-	Sync       *SyncInfo        `json:"sync"`
-	Validator  *ValidatorInfo   `json:"validator"`
-	Validators []*ValidatorInfo `json:"validators"`
-	NumPeers   string           `json:"num_peers"`
-	Peers      []*PeerInfo      `json:"peers"`
-	DiskUsage  string           `json:"disk_usage"`
+	Version     string           `json:"version"`
+	Node        *NodeInfo        `json:"node"`
+	Sync        *SyncInfo        `json:"sync"`
+	Validator   *ValidatorInfo   `json:"validator"`
+	Validators  []*ValidatorInfo `json:"validators"`
+	NumPeers    string           `json:"num_peers"`
+	Peers       []*PeerInfo      `json:"peers"`
+	DiskUsage   string           `json:"disk_usage"`
+	Diagnostics *Diagnostics     `json:"diagnostics"`
 }
 
 type SyncInfo struct {
@@ -132,6 +266,27 @@ type SyncInfo struct {
 	CatchingUp        bool   `json:"catching_up"`
 }
 
+type TxError struct {
+	Codespace string `json:"codespace"`
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+}
+
+type TxLog struct {
+	MsgIndex int      `json:"msgIndex"`
+	Log      string   `json:"log"`
+	Events   []*Event `json:"events"`
+}
+
+type TxResult struct {
+	Hash      string   `json:"hash"`
+	Height    string   `json:"height"`
+	GasWanted string   `json:"gasWanted"`
+	GasUsed   string   `json:"gasUsed"`
+	Logs      []*TxLog `json:"logs"`
+	Error     *TxError `json:"error"`
+}
+
 type ValidatorInfo struct {
 	Address          string  `json:"address"`
 	VotingPower      string  `json:"voting_power"`
@@ -158,3 +313,66 @@ type ValueInput struct {
 	Reference *ReferenceInput `json:"reference"`
 	Values    []*ValueInput   `json:"values"`
 }
+
+type WebsiteRegistrationRecord struct {
+	ID            string   `json:"id"`
+	Names         []string `json:"names"`
+	BondID        string   `json:"bondId"`
+	CreateTime    string   `json:"createTime"`
+	ExpiryTime    string   `json:"expiryTime"`
+	Owners        []string `json:"owners"`
+	Type          string   `json:"type"`
+	Record        *Record  `json:"record"`
+	URL           *string  `json:"url"`
+	Repository    *string  `json:"repository"`
+	BuildArtifact *string  `json:"buildArtifact"`
+	TLSCert       *string  `json:"tlsCert"`
+}
+
+func (WebsiteRegistrationRecord) IsTypedRecord() {}
+
+type WebsiteRegistrationRecordFilter struct {
+	URL        *string `json:"url"`
+	Repository *string `json:"repository"`
+}
+
+type BroadcastMode string
+
+const (
+	BroadcastModeSync   BroadcastMode = "SYNC"
+	BroadcastModeCommit BroadcastMode = "COMMIT"
+)
+
+var AllBroadcastMode = []BroadcastMode{
+	BroadcastModeSync,
+	BroadcastModeCommit,
+}
+
+func (e BroadcastMode) IsValid() bool {
+	switch e {
+	case BroadcastModeSync, BroadcastModeCommit:
+		return true
+	}
+	return false
+}
+
+func (e BroadcastMode) String() string {
+	return string(e)
+}
+
+func (e *BroadcastMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BroadcastMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BroadcastMode", str)
+	}
+	return nil
+}
+
+func (e BroadcastMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,181 @@
+package gql
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// simulatePath is the ABCI query path of the app tx simulation.
+const simulatePath = "/app/simulate"
+
+// Mutation is the entry point to mutation execution.
+func (r *Resolver) Mutation() MutationResolver {
+	return &mutationResolver{r}
+}
+
+type mutationResolver struct{ *Resolver }
+
+func (m mutationResolver) Submit(ctx context.Context, tx string, mode *BroadcastMode) (*TxResult, error) {
+	txBytes, err := m.decodeTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	broadcastMode := flags.BroadcastSync
+	if mode != nil && *mode == BroadcastModeCommit {
+		broadcastMode = flags.BroadcastBlock
+	}
+
+	res, err := m.ctx.WithBroadcastMode(broadcastMode).BroadcastTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TxResult{
+		Hash:      res.TxHash,
+		Height:    strconv.FormatInt(res.Height, 10),
+		GasWanted: strconv.FormatInt(res.GasWanted, 10),
+		GasUsed:   strconv.FormatInt(res.GasUsed, 10),
+		Logs:      getGQLTxLogs(res.Logs),
+		Error:     getGQLTxError(res.Codespace, res.Code, res.RawLog),
+	}, nil
+}
+
+func (m mutationResolver) Simulate(ctx context.Context, tx string) (*SimulateResult, error) {
+	txBytes, err := m.decodeTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := m.ctx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := node.ABCIQuery(ctx, simulatePath, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.Response.IsOK() {
+		return &SimulateResult{
+			GasUsed: "0",
+			Error:   getGQLTxError(res.Response.Codespace, res.Response.Code, res.Response.Log),
+		}, nil
+	}
+
+	var simRes sdk.SimulationResponse
+	if err := m.ctx.Codec.UnmarshalJSON(res.Response.Value, &simRes); err != nil {
+		return nil, err
+	}
+
+	var logs []*TxLog
+	if simRes.Result != nil {
+		logs = []*TxLog{{Log: simRes.Result.Log, Events: getGQLEvents(simRes.Result.Events)}}
+	}
+
+	return &SimulateResult{
+		GasUsed: strconv.FormatUint(simRes.GasUsed, 10),
+		Logs:    logs,
+	}, nil
+}
+
+// decodeTx decodes a base64 encoded signed tx, in protobuf or amino JSON encoding,
+// and returns its protobuf encoding.
+func (m mutationResolver) decodeTx(tx string) ([]byte, error) {
+	txBytes, err := base64.StdEncoding.DecodeString(tx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 tx: %w", err)
+	}
+
+	if !bytes.HasPrefix(bytes.TrimSpace(txBytes), []byte("{")) {
+		// Check the tx decodes before broadcasting it.
+		if _, err := m.ctx.TxConfig.TxDecoder()(txBytes); err != nil {
+			return nil, err
+		}
+
+		return txBytes, nil
+	}
+
+	return encodeAminoJSONTx(m.ctx, txBytes)
+}
+
+// encodeAminoJSONTx converts an amino JSON signed tx to protobuf encoding.
+func encodeAminoJSONTx(ctx client.Context, txBytes []byte) ([]byte, error) {
+	stdTx, err := legacytx.StdTxConfig{Cdc: ctx.LegacyAmino}.TxJSONDecoder()(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	sigTx, ok := stdTx.(authsigning.Tx)
+	if !ok {
+		return nil, fmt.Errorf("invalid amino JSON tx type %T", stdTx)
+	}
+
+	builder := ctx.TxConfig.NewTxBuilder()
+	if err := clienttx.CopyTx(sigTx, builder, false); err != nil {
+		return nil, err
+	}
+
+	return ctx.TxConfig.TxEncoder()(builder.GetTx())
+}
+
+func getGQLTxError(codespace string, code uint32, log string) *TxError {
+	if code == abci.CodeTypeOK {
+		return nil
+	}
+
+	return &TxError{
+		Codespace: codespace,
+		Code:      int(code),
+		Message:   log,
+	}
+}
+
+func getGQLTxLogs(logs sdk.ABCIMessageLogs) []*TxLog {
+	gqlLogs := make([]*TxLog, len(logs))
+	for i, log := range logs {
+		events := make([]*Event, len(log.Events))
+		for j, event := range log.Events {
+			attributes := make([]*EventAttribute, len(event.Attributes))
+			for k, attribute := range event.Attributes {
+				attributes[k] = &EventAttribute{Key: attribute.Key, Value: attribute.Value}
+			}
+
+			events[j] = &Event{Type: event.Type, Attributes: attributes}
+		}
+
+		gqlLogs[i] = &TxLog{
+			MsgIndex: int(log.MsgIndex),
+			Log:      log.Log,
+			Events:   events,
+		}
+	}
+
+	return gqlLogs
+}
+
+func getGQLEvents(abciEvents []abci.Event) []*Event {
+	events := make([]*Event, len(abciEvents))
+	for i, event := range abciEvents {
+		attributes := make([]*EventAttribute, len(event.Attributes))
+		for j, attribute := range event.Attributes {
+			attributes[j] = &EventAttribute{Key: attribute.Key, Value: attribute.Value}
+		}
+
+		events[i] = &Event{Type: event.Type, Attributes: attributes}
+	}
+
+	return events
+}
@@ -0,0 +1,229 @@
+package gql_test
+
+import (
+	"encoding/base64"
+	"strconv"
+	"testing"
+
+	gqlclient "github.com/99designs/gqlgen/client"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tharsis/ethermint/gql"
+)
+
+const (
+	submitMutation = `mutation ($tx: String!, $mode: BroadcastMode) {
+		submit(tx: $tx, mode: $mode) { hash height gasUsed logs { msgIndex events { type } } error { codespace code message } }
+	}`
+
+	simulateMutation = `mutation ($tx: String!) {
+		simulate(tx: $tx) { gasUsed logs { msgIndex events { type } } error { codespace code message } }
+	}`
+)
+
+type txLog struct {
+	MsgIndex int
+	Events   []struct{ Type string }
+}
+
+type txError struct {
+	Codespace string
+	Code      int
+	Message   string
+}
+
+type submitResponse struct {
+	Submit struct {
+		Hash    string
+		Height  string
+		GasUsed string
+		Logs    []txLog
+		Error   *txError
+	}
+}
+
+type simulateResponse struct {
+	Simulate struct {
+		GasUsed string
+		Logs    []txLog
+		Error   *txError
+	}
+}
+
+func newTestClient(t *testing.T, node *testNode) *gqlclient.Client {
+	handler, err := gql.NewTestHandler(node.clientCtx())
+	require.NoError(t, err)
+
+	return gqlclient.New(handler)
+}
+
+// testAccount is a funded account signing bank sends.
+type testAccount struct {
+	key           cryptotypes.PrivKey
+	accountNumber uint64
+	sequence      uint64
+}
+
+func newTestAccount(t *testing.T, node *testNode) *testAccount {
+	key := secp256k1.GenPrivKey()
+	address := sdk.AccAddress(key.PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+
+	var accountNumber uint64
+	node.commitBlock(func(ctx sdk.Context) {
+		account := node.app.AccountKeeper.NewAccountWithAddress(ctx, address)
+		node.app.AccountKeeper.SetAccount(ctx, account)
+		accountNumber = account.GetAccountNumber()
+
+		require.NoError(t, node.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		require.NoError(t, node.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, address, coins))
+	})
+
+	return &testAccount{key: key, accountNumber: accountNumber}
+}
+
+// signSend returns a bank send of the account signed in the sign mode, and increments the account sequence.
+func (a *testAccount) signSend(t *testing.T, clientCtx client.Context, signMode signing.SignMode, memo string) authsigning.Tx {
+	txConfig := clientCtx.TxConfig
+	from := sdk.AccAddress(a.key.PubKey().Address())
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(from, from, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))))
+	builder.SetGasLimit(200000)
+	builder.SetMemo(memo)
+
+	sig := signing.SignatureV2{
+		PubKey:   a.key.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: a.sequence,
+	}
+	require.NoError(t, builder.SetSignatures(sig))
+
+	signerData := authsigning.SignerData{
+		Address:       from.String(),
+		ChainID:       "ethermint_9000-1",
+		AccountNumber: a.accountNumber,
+		Sequence:      a.sequence,
+	}
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signMode, signerData, builder.GetTx())
+	require.NoError(t, err)
+
+	sig.Data.(*signing.SingleSignatureData).Signature, err = a.key.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, builder.SetSignatures(sig))
+
+	a.sequence++
+
+	return builder.GetTx()
+}
+
+func encodeTestTx(t *testing.T, clientCtx client.Context, tx sdk.Tx) string {
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(txBytes)
+}
+
+func TestSubmit(t *testing.T) {
+	node := newTestNode(t)
+	clientCtx := node.clientCtx()
+	account := newTestAccount(t, node)
+	c := newTestClient(t, node)
+
+	// The tx is included in a block in commit mode.
+	var res submitResponse
+	tx := encodeTestTx(t, clientCtx, account.signSend(t, clientCtx, signing.SignMode_SIGN_MODE_DIRECT, "commit"))
+	require.NoError(t, c.Post(submitMutation, &res, gqlclient.Var("tx", tx), gqlclient.Var("mode", "COMMIT")))
+	require.Nil(t, res.Submit.Error)
+	require.NotEmpty(t, res.Submit.Hash)
+	require.Equal(t, strconv.FormatInt(node.app.LastBlockHeight(), 10), res.Submit.Height)
+	require.NotEqual(t, "0", res.Submit.GasUsed)
+	require.Len(t, res.Submit.Logs, 1)
+	require.NotEmpty(t, res.Submit.Logs[0].Events)
+
+	// It's only checked in sync mode.
+	res = submitResponse{}
+	tx = encodeTestTx(t, clientCtx, account.signSend(t, clientCtx, signing.SignMode_SIGN_MODE_DIRECT, "sync"))
+	require.NoError(t, c.Post(submitMutation, &res, gqlclient.Var("tx", tx)))
+	require.Nil(t, res.Submit.Error)
+	require.NotEmpty(t, res.Submit.Hash)
+	require.Equal(t, "0", res.Submit.Height)
+
+	// Failed txs are returned with their error. The sync tx isn't committed, so the sequence is ahead.
+	res = submitResponse{}
+	tx = encodeTestTx(t, clientCtx, account.signSend(t, clientCtx, signing.SignMode_SIGN_MODE_DIRECT, "failed"))
+	require.NoError(t, c.Post(submitMutation, &res, gqlclient.Var("tx", tx), gqlclient.Var("mode", "COMMIT")))
+	require.NotNil(t, res.Submit.Error)
+	require.Equal(t, "sdk", res.Submit.Error.Codespace)
+	require.Contains(t, res.Submit.Error.Message, "account sequence mismatch")
+
+	err := c.Post(submitMutation, &res, gqlclient.Var("tx", "not base64"))
+	require.ErrorContains(t, err, "invalid base64 tx")
+
+	err = c.Post(submitMutation, &res, gqlclient.Var("tx", base64.StdEncoding.EncodeToString([]byte("not a tx"))))
+	require.Error(t, err)
+}
+
+func TestSubmitAminoJSON(t *testing.T) {
+	node := newTestNode(t)
+	clientCtx := node.clientCtx()
+	account := newTestAccount(t, node)
+	c := newTestClient(t, node)
+
+	tx := account.signSend(t, clientCtx, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, "amino")
+	sigs, err := tx.GetSignaturesV2()
+	require.NoError(t, err)
+
+	stdTx := legacytx.NewStdTx(
+		tx.GetMsgs(),
+		legacytx.NewStdFee(tx.GetGas(), tx.GetFee()),
+		[]legacytx.StdSignature{legacytx.NewStdSignature(sigs[0].PubKey, sigs[0].Data.(*signing.SingleSignatureData).Signature)},
+		tx.GetMemo(),
+	)
+	stdTxJSON, err := clientCtx.LegacyAmino.MarshalJSON(stdTx)
+	require.NoError(t, err)
+
+	// The signature still verifies once the tx is converted to protobuf.
+	var res submitResponse
+	require.NoError(t, c.Post(submitMutation, &res, gqlclient.Var("tx", base64.StdEncoding.EncodeToString(stdTxJSON))))
+	require.Nil(t, res.Submit.Error)
+	require.NotEmpty(t, res.Submit.Hash)
+}
+
+func TestSimulate(t *testing.T) {
+	node := newTestNode(t)
+	clientCtx := node.clientCtx()
+	account := newTestAccount(t, node)
+	c := newTestClient(t, node)
+
+	tx := encodeTestTx(t, clientCtx, account.signSend(t, clientCtx, signing.SignMode_SIGN_MODE_DIRECT, ""))
+
+	var res simulateResponse
+	require.NoError(t, c.Post(simulateMutation, &res, gqlclient.Var("tx", tx)))
+	require.Nil(t, res.Simulate.Error)
+	require.NotEqual(t, "0", res.Simulate.GasUsed)
+	require.Len(t, res.Simulate.Logs, 1)
+	require.NotEmpty(t, res.Simulate.Logs[0].Events)
+
+	// Nothing is committed, the same tx simulates again.
+	res = simulateResponse{}
+	require.NoError(t, c.Post(simulateMutation, &res, gqlclient.Var("tx", tx)))
+	require.Nil(t, res.Simulate.Error)
+
+	// The simulation errors are returned in the result.
+	unfunded := &testAccount{key: secp256k1.GenPrivKey()}
+	res = simulateResponse{}
+	tx = encodeTestTx(t, clientCtx, unfunded.signSend(t, clientCtx, signing.SignMode_SIGN_MODE_DIRECT, ""))
+	require.NoError(t, c.Post(simulateMutation, &res, gqlclient.Var("tx", tx)))
+	require.NotNil(t, res.Simulate.Error)
+	require.Equal(t, "0", res.Simulate.GasUsed)
+}
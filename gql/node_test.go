@@ -0,0 +1,135 @@
+package gql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/tharsis/ethermint/app"
+	"github.com/tharsis/ethermint/encoding"
+)
+
+// testNode is a Tendermint RPC client serving the queries and txs of a test app.
+// The RPC methods the GQL server doesn't use panic.
+type testNode struct {
+	rpcclient.Client
+
+	app     *app.EthermintApp
+	headers map[int64]tmproto.Header
+	events  chan coretypes.ResultEvent
+}
+
+func newTestNode(t *testing.T) *testNode {
+	testApp := app.Setup(t, false, func(_ *app.EthermintApp, genesis simapp.GenesisState) simapp.GenesisState {
+		return genesis
+	})
+	testApp.Commit()
+
+	return &testNode{
+		app:     testApp,
+		headers: map[int64]tmproto.Header{},
+		events:  make(chan coretypes.ResultEvent, 1),
+	}
+}
+
+// clientCtx returns a client context connected to the node.
+func (n *testNode) clientCtx() client.Context {
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+
+	return client.Context{}.
+		WithCodec(encodingConfig.Codec).
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithLegacyAmino(encodingConfig.Amino).
+		WithClient(n)
+}
+
+// commitBlock runs fn in the context of a new block, delivers the txs and commits the block.
+func (n *testNode) commitBlock(fn func(ctx sdk.Context), txs ...[]byte) (tmproto.Header, []abci.ResponseDeliverTx) {
+	header := tmproto.Header{
+		ChainID: "ethermint_9000-1",
+		Height:  n.app.LastBlockHeight() + 1,
+		Time:    time.Unix(1650000000+n.app.LastBlockHeight()*5, 0).UTC(),
+	}
+
+	n.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	if fn != nil {
+		fn(n.app.BaseApp.NewContext(false, header))
+	}
+
+	results := make([]abci.ResponseDeliverTx, len(txs))
+	for i, tx := range txs {
+		results[i] = n.app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+	}
+
+	n.app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	n.app.Commit()
+	n.headers[header.Height] = header
+
+	return header, results
+}
+
+// publishHeader sends the new block header event of a committed block to the subscriber.
+func (n *testNode) publishHeader(header tmproto.Header) {
+	tmHeader := tmtypes.Header{ChainID: header.ChainID, Height: header.Height, Time: header.Time}
+	n.events <- coretypes.ResultEvent{Data: tmtypes.EventDataNewBlockHeader{Header: tmHeader}}
+}
+
+func (n *testNode) IsRunning() bool {
+	return true
+}
+
+func (n *testNode) Subscribe(ctx context.Context, subscriber, query string, outCapacity ...int) (<-chan coretypes.ResultEvent, error) {
+	return n.events, nil
+}
+
+func (n *testNode) ABCIInfo(ctx context.Context) (*coretypes.ResultABCIInfo, error) {
+	return &coretypes.ResultABCIInfo{Response: n.app.Info(abci.RequestInfo{})}, nil
+}
+
+func (n *testNode) ABCIQuery(ctx context.Context, path string, data tmbytes.HexBytes) (*coretypes.ResultABCIQuery, error) {
+	return n.ABCIQueryWithOptions(ctx, path, data, rpcclient.DefaultABCIQueryOptions)
+}
+
+func (n *testNode) ABCIQueryWithOptions(ctx context.Context, path string, data tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	res := n.app.Query(abci.RequestQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	return &coretypes.ResultABCIQuery{Response: res}, nil
+}
+
+func (n *testNode) BroadcastTxSync(ctx context.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	res := n.app.CheckTx(abci.RequestCheckTx{Tx: tx})
+
+	return &coretypes.ResultBroadcastTx{
+		Code:      res.Code,
+		Data:      res.Data,
+		Log:       res.Log,
+		Codespace: res.Codespace,
+		Hash:      tx.Hash(),
+	}, nil
+}
+
+func (n *testNode) BroadcastTxCommit(ctx context.Context, tx tmtypes.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+	checkTx := n.app.CheckTx(abci.RequestCheckTx{Tx: tx})
+	if !checkTx.IsOK() {
+		return &coretypes.ResultBroadcastTxCommit{CheckTx: checkTx, Hash: tx.Hash()}, nil
+	}
+
+	header, results := n.commitBlock(nil, tx)
+
+	return &coretypes.ResultBroadcastTxCommit{
+		CheckTx:   checkTx,
+		DeliverTx: results[0],
+		Hash:      tx.Hash(),
+		Height:    header.Height,
+	}, nil
+}
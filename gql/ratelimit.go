@@ -0,0 +1,95 @@
+package gql
+
+import (
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// DefaultRateBurst is the number of GQL requests a client can burst above the rate limit by default.
+const DefaultRateBurst = 20
+
+// rateLimiterTTL is how long the rate limiter of an idle client is kept.
+const rateLimiterTTL = 5 * time.Minute
+
+// rateLimiter limits the rate of GQL requests per client IP.
+type rateLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mu        sync.Mutex
+	clients   map[string]*clientLimiter
+	lastSweep time.Time
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// newRateLimiter returns a rate limiter of requests per second, nil if the limit is 0.
+func newRateLimiter(limit float64, burst int) *rateLimiter {
+	if limit <= 0 {
+		return nil
+	}
+
+	return &rateLimiter{
+		limit:     rate.Limit(limit),
+		burst:     burst,
+		clients:   map[string]*clientLimiter{},
+		lastSweep: time.Now(),
+	}
+}
+
+// allow returns whether a request of the client is allowed, and forgets the idle clients.
+func (l *rateLimiter) allow(ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastSweep) > rateLimiterTTL {
+		for clientIP, client := range l.clients {
+			if now.Sub(client.lastSeen) > rateLimiterTTL {
+				delete(l.clients, clientIP)
+			}
+		}
+
+		l.lastSweep = now
+	}
+
+	client, ok := l.clients[ip]
+	if !ok {
+		client = &clientLimiter{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[ip] = client
+	}
+
+	client.lastSeen = now
+
+	return client.limiter.AllowN(now, 1)
+}
+
+// handler rejects the requests of clients above the rate limit.
+func (l *rateLimiter) handler(next http.Handler) http.Handler {
+	if l == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			ip = r.RemoteAddr
+		}
+
+		if !l.allow(ip) {
+			rateLimitedRequests.Inc()
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
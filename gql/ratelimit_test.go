@@ -0,0 +1,65 @@
+package gql
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	require.Nil(t, newRateLimiter(0, DefaultRateBurst))
+
+	limiter := newRateLimiter(0.001, 2)
+	handler := limiter.handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	request := func(remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api", nil)
+		req.RemoteAddr = remoteAddr
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	// The burst is allowed, the requests above it are rejected.
+	require.Equal(t, http.StatusOK, request("10.0.0.1:1000").Code)
+	require.Equal(t, http.StatusOK, request("10.0.0.1:1001").Code)
+
+	rec := request("10.0.0.1:1002")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "1", rec.Header().Get("Retry-After"))
+
+	// The limit is per client IP.
+	require.Equal(t, http.StatusOK, request("10.0.0.2:1000").Code)
+}
+
+func TestRateLimiterForgetsIdleClients(t *testing.T) {
+	limiter := newRateLimiter(0.001, 1)
+
+	require.True(t, limiter.allow("10.0.0.1"))
+	require.False(t, limiter.allow("10.0.0.1"))
+	require.True(t, limiter.allow("10.0.0.2"))
+
+	limiter.clients["10.0.0.1"].lastSeen = time.Now().Add(-2 * rateLimiterTTL)
+	limiter.lastSweep = time.Now().Add(-2 * rateLimiterTTL)
+
+	// The idle client starts over with a full burst, the other one is kept.
+	require.True(t, limiter.allow("10.0.0.1"))
+	require.Len(t, limiter.clients, 2)
+	require.False(t, limiter.allow("10.0.0.2"))
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	var limiter *rateLimiter
+	handler := limiter.handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	for i := 0; i < 10; i++ {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+}
@@ -0,0 +1,47 @@
+package gql
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// Record is a GQL record, its references are resolved on demand.
+type Record struct {
+	ID         string      `json:"id"`
+	Names      []string    `json:"names"`
+	BondID     string      `json:"bondId"`
+	CreateTime string      `json:"createTime"`
+	ExpiryTime string      `json:"expiryTime"`
+	Owners     []string    `json:"owners"`
+	Attributes []*KeyValue `json:"attributes"`
+
+	// referenceIDs are the IDs of the records referenced in the attributes.
+	referenceIDs []string
+
+	// height is the block height the record was queried at, empty for the latest state.
+	height string
+}
+
+// Record returns the resolver of the record fields.
+func (r *Resolver) Record() RecordResolver {
+	return &recordResolver{r}
+}
+
+type recordResolver struct{ *Resolver }
+
+// References resolves the referenced records at the height of the record,
+// batched with the references of the other records in the query.
+func (r recordResolver) References(ctx context.Context, obj *Record) ([]*Record, error) {
+	if len(obj.referenceIDs) == 0 {
+		return nil, nil
+	}
+
+	if obj.height != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, obj.height)
+	}
+
+	return queryResolver{r.Resolver}.getRecords(ctx, obj.referenceIDs)
+}
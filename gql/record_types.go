@@ -0,0 +1,173 @@
+package gql
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// The typed record variants (record_types.graphql, record_types_gen.go) are generated from
+// the record type definitions in record_types.yml, then the GQL server is regenerated.
+//go:generate go run ./internal/genrecordtypes
+//go:generate gqlgen generate
+
+// TypeAttributeName denotes the record type attribute.
+const TypeAttributeName = "type"
+
+var errInvalidTypeFilter = errors.New("exactly one record type filter must be set")
+
+func (q queryResolver) QueryRecordsByType(ctx context.Context, filter RecordTypeFilter, all *bool, height *string) ([]TypedRecord, error) {
+	attributes, err := getTypeFilterAttributes(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := q.QueryRecords(ctx, attributes, all, height)
+	if err != nil {
+		return nil, err
+	}
+
+	typedRecords := make([]TypedRecord, 0, len(records))
+	for _, record := range records {
+		if record != nil {
+			typedRecords = append(typedRecords, getGQLTypedRecord(record))
+		}
+	}
+
+	return typedRecords, nil
+}
+
+// getTypeFilterAttributes returns the attribute conditions of a record type filter,
+// including the type attribute.
+func getTypeFilterAttributes(filter RecordTypeFilter) ([]*KeyValueInput, error) {
+	var typeFilter reflect.Value
+	var typeField reflect.StructField
+
+	filterValue := reflect.ValueOf(filter)
+	for i := 0; i < filterValue.NumField(); i++ {
+		if filterValue.Field(i).IsNil() {
+			continue
+		}
+
+		if typeFilter.IsValid() {
+			return nil, errInvalidTypeFilter
+		}
+
+		typeFilter = filterValue.Field(i).Elem()
+		typeField = filterValue.Type().Field(i)
+	}
+
+	if !typeFilter.IsValid() {
+		return nil, errInvalidTypeFilter
+	}
+
+	// The type filter fields are named after the record types, e.g. serviceRecord.
+	fieldName := getJSONName(typeField)
+	recordType := strings.ToUpper(fieldName[:1]) + fieldName[1:]
+	attributes := []*KeyValueInput{{Key: TypeAttributeName, Value: &ValueInput{String: &recordType}}}
+
+	for i := 0; i < typeFilter.NumField(); i++ {
+		field := typeFilter.Field(i)
+		if field.IsNil() {
+			continue
+		}
+
+		value := &ValueInput{}
+		switch v := field.Interface().(type) {
+		case *string:
+			value.String = v
+		case *bool:
+			value.Boolean = v
+		case *float64:
+			value.Float = v
+		case *int:
+			value.Int = v
+		default:
+			continue
+		}
+
+		attributes = append(attributes, &KeyValueInput{Key: getJSONName(typeFilter.Type().Field(i)), Value: value})
+	}
+
+	return attributes, nil
+}
+
+// getGQLTypedRecord returns the typed variant of a record, by its type attribute.
+func getGQLTypedRecord(record *Record) TypedRecord {
+	attributes := map[string]*Value{}
+	for _, attribute := range record.Attributes {
+		attributes[attribute.Key] = attribute.Value
+	}
+
+	var recordType string
+	if value, ok := attributes[TypeAttributeName]; ok && value.String != nil {
+		recordType = *value.String
+	}
+
+	common := map[string]interface{}{
+		"id":         record.ID,
+		"names":      record.Names,
+		"bondId":     record.BondID,
+		"createTime": record.CreateTime,
+		"expiryTime": record.ExpiryTime,
+		"owners":     record.Owners,
+		"type":       recordType,
+		"record":     record,
+	}
+
+	var typedRecord TypedRecord = &GenericRecord{}
+	if newTypedRecord, ok := recordTypes[recordType]; ok {
+		typedRecord = newTypedRecord()
+	}
+
+	typedValue := reflect.ValueOf(typedRecord).Elem()
+	for i := 0; i < typedValue.NumField(); i++ {
+		field := typedValue.Field(i)
+		name := getJSONName(typedValue.Type().Field(i))
+
+		if value, ok := common[name]; ok {
+			field.Set(reflect.ValueOf(value))
+			continue
+		}
+
+		if value, ok := attributes[name]; ok {
+			setTypedField(field, value)
+		}
+	}
+
+	return typedRecord
+}
+
+// setTypedField sets a typed record field to an attribute value of the same type, it's left nil otherwise.
+func setTypedField(field reflect.Value, value *Value) {
+	switch field.Interface().(type) {
+	case *string:
+		if value.String != nil {
+			field.Set(reflect.ValueOf(value.String))
+		}
+	case *bool:
+		if value.Boolean != nil {
+			field.Set(reflect.ValueOf(value.Boolean))
+		}
+	case *float64:
+		if value.Float != nil {
+			field.Set(reflect.ValueOf(value.Float))
+		}
+	case *int:
+		if value.Int != nil {
+			field.Set(reflect.ValueOf(value.Int))
+			return
+		}
+
+		// JSON numbers are decoded as floats.
+		if value.Float != nil && *value.Float == float64(int(*value.Float)) {
+			intValue := int(*value.Float)
+			field.Set(reflect.ValueOf(&intValue))
+		}
+	}
+}
+
+func getJSONName(field reflect.StructField) string {
+	return strings.Split(field.Tag.Get("json"), ",")[0]
+}
@@ -0,0 +1,80 @@
+package gql_test
+
+import (
+	"testing"
+
+	gqlclient "github.com/99designs/gqlgen/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tharsis/ethermint/x/nameservice/helpers"
+	nstypes "github.com/tharsis/ethermint/x/nameservice/types"
+)
+
+const queryRecordsByType = `query ($filter: RecordTypeFilter!) {
+	queryRecordsByType(filter: $filter, all: true) {
+		id
+		type
+		record { id }
+		... on ServiceRecord { name version url description }
+	}
+}`
+
+type typedRecord struct {
+	ID     string
+	Type   string
+	Record struct{ ID string }
+
+	Name        *string
+	Version     *string
+	URL         *string
+	Description *string
+}
+
+func TestQueryRecordsByType(t *testing.T) {
+	node := newTestNode(t)
+	c := newTestClient(t, node)
+
+	records := map[string]map[string]interface{}{
+		"service-1": {"type": "ServiceRecord", "name": "service", "version": "1.0.0", "url": "https://example.com"},
+		"service-2": {"type": "ServiceRecord", "name": "service", "version": "2.0.0", "url": "https://example.com"},
+		// Attributes of another type are null.
+		"service-3": {"type": "ServiceRecord", "name": "service", "version": "1.0.0", "url": true},
+		"website":   {"type": "WebsiteRegistrationRecord", "version": "1.0.0", "url": "https://example.com"},
+	}
+	node.commitBlock(func(ctx sdk.Context) {
+		for id, attributes := range records {
+			node.app.NameServiceKeeper.PutRecord(ctx, nstypes.Record{
+				Id:         id,
+				Attributes: helpers.BytesToBase64(helpers.MarshalMapToJSONBytes(attributes)),
+			})
+		}
+	})
+
+	var res struct{ QueryRecordsByType []typedRecord }
+	c.MustPost(queryRecordsByType, &res, gqlclient.Var("filter", map[string]interface{}{
+		"serviceRecord": map[string]interface{}{"version": "1.0.0"},
+	}))
+	require.Len(t, res.QueryRecordsByType, 2)
+
+	typedRecords := map[string]typedRecord{}
+	for _, record := range res.QueryRecordsByType {
+		require.Equal(t, "ServiceRecord", record.Type)
+		require.Equal(t, record.ID, record.Record.ID)
+		require.Equal(t, "service", *record.Name)
+		require.Equal(t, "1.0.0", *record.Version)
+		require.Nil(t, record.Description)
+		typedRecords[record.ID] = record
+	}
+	require.Equal(t, "https://example.com", *typedRecords["service-1"].URL)
+	require.Nil(t, typedRecords["service-3"].URL)
+
+	// Exactly one type filter is set.
+	for _, filter := range []map[string]interface{}{
+		{},
+		{"serviceRecord": map[string]interface{}{}, "websiteRegistrationRecord": map[string]interface{}{}},
+	} {
+		err := c.Post(queryRecordsByType, &res, gqlclient.Var("filter", filter))
+		require.Error(t, err)
+	}
+}
@@ -8,9 +8,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	auctiontypes "github.com/tharsis/ethermint/x/auction/types"
 	bondtypes "github.com/tharsis/ethermint/x/bond/types"
+	nskeeper "github.com/tharsis/ethermint/x/nameservice/keeper"
 	nstypes "github.com/tharsis/ethermint/x/nameservice/types"
+	"golang.org/x/sync/errgroup"
 )
 
 // DefaultLogNumLines is the number of log lines to tail by default.
@@ -19,9 +22,15 @@ const DefaultLogNumLines = 50
 // MaxLogNumLines is the max number of log lines that can be tailed.
 const MaxLogNumLines = 1000
 
+// maxConcurrentLookups is the max number of concurrent lookups of the queries without a batch gRPC query.
+const maxConcurrentLookups = 10
+
 type Resolver struct {
-	ctx     client.Context
-	logFile string
+	ctx         client.Context
+	conn        gogogrpc.ClientConn
+	logFile     string
+	diagnostics bool
+	feed        *blockFeed
 }
 
 // Query is the entry point to query execution.
@@ -31,57 +40,87 @@ func (r *Resolver) Query() QueryResolver {
 
 type queryResolver struct{ *Resolver }
 
-func (q queryResolver) LookupAuthorities(ctx context.Context, names []string) ([]*AuthorityRecord, error) {
-	nsQueryClient := nstypes.NewQueryClient(q.ctx)
-	auctionQueryClient := auctiontypes.NewQueryClient(q.ctx)
-	var gqlResponse []*AuthorityRecord
+func (q queryResolver) LookupAuthorities(ctx context.Context, names []string, height *string) ([]*AuthorityRecord, error) {
+	ctx, err := q.withHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	gqlResponse := make([]*AuthorityRecord, len(names))
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentLookups)
+	for i, name := range names {
+		i, name := i, name
+		g.Go(func() error {
+			authority, err := q.lookupAuthority(ctx, name)
+			if err != nil {
+				return err
+			}
 
-	for _, name := range names {
-		res, err := nsQueryClient.Whois(context.Background(), &nstypes.QueryWhoisRequest{Name: name})
+			gqlResponse[i] = authority
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return gqlResponse, nil
+}
+
+func (q queryResolver) lookupAuthority(ctx context.Context, name string) (*AuthorityRecord, error) {
+	nsQueryClient := nstypes.NewQueryClient(q.conn)
+	res, err := nsQueryClient.Whois(ctx, &nstypes.QueryWhoisRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	nameAuthority := res.GetNameAuthority()
+	gqlNameAuthorityRecord, err := GetGQLNameAuthorityRecord(&nameAuthority)
+	if err != nil {
+		return nil, err
+	}
+
+	if nameAuthority.AuctionId != "" {
+		auctionQueryClient := auctiontypes.NewQueryClient(q.conn)
+		auctionResp, err := auctionQueryClient.GetAuction(ctx, &auctiontypes.AuctionRequest{Id: nameAuthority.GetAuctionId()})
 		if err != nil {
 			return nil, err
 		}
-
-		nameAuthority := res.GetNameAuthority()
-		gqlNameAuthorityRecord, err := GetGQLNameAuthorityRecord(&nameAuthority)
+		bidsResp, err := auctionQueryClient.GetBids(ctx, &auctiontypes.BidsRequest{AuctionId: nameAuthority.GetAuctionId()})
 		if err != nil {
 			return nil, err
 		}
 
-		if nameAuthority.AuctionId != "" {
-			auctionResp, err := auctionQueryClient.GetAuction(context.Background(), &auctiontypes.AuctionRequest{Id: nameAuthority.GetAuctionId()})
-			if err != nil {
-				return nil, err
-			}
-			bidsResp, err := auctionQueryClient.GetBids(context.Background(), &auctiontypes.BidsRequest{AuctionId: nameAuthority.GetAuctionId()})
-			if err != nil {
-				return nil, err
-			}
-
-			gqlAuctionRecord, err := GetGQLAuction(auctionResp.GetAuction(), bidsResp.GetBids())
-			if err != nil {
-				return nil, err
-			}
-
-			gqlNameAuthorityRecord.Auction = gqlAuctionRecord
+		gqlAuctionRecord, err := GetGQLAuction(auctionResp.GetAuction(), bidsResp.GetBids())
+		if err != nil {
+			return nil, err
 		}
 
-		gqlResponse = append(gqlResponse, gqlNameAuthorityRecord)
+		gqlNameAuthorityRecord.Auction = gqlAuctionRecord
 	}
 
-	return gqlResponse, nil
+	return gqlNameAuthorityRecord, nil
 }
 
-func (q queryResolver) ResolveNames(ctx context.Context, names []string) ([]*Record, error) {
-	nsQueryClient := nstypes.NewQueryClient(q.ctx)
-	var gqlResponse []*Record
-	for _, name := range names {
-		res, err := nsQueryClient.ResolveCrn(context.Background(), &nstypes.QueryResolveCrn{Crn: name})
+func (q queryResolver) ResolveNames(ctx context.Context, names []string, height *string) ([]*Record, error) {
+	ctx, err := q.withHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	nsQueryClient := nstypes.NewQueryClient(q.conn)
+	gqlResponse := make([]*Record, 0, len(names))
+	for _, batch := range splitBatches(names) {
+		res, err := nsQueryClient.ResolveCrns(ctx, &nstypes.QueryResolveCrnsRequest{Crns: batch})
 		if err != nil {
-			// Return nil for record not found.
-			gqlResponse = append(gqlResponse, nil)
-		} else {
-			gqlRecord, err := getGQLRecord(context.Background(), q, *res.GetRecord())
+			return nil, err
+		}
+
+		for _, record := range res.GetRecords() {
+			// Empty records, for names not found, resolve to nil.
+			gqlRecord, err := getGQLRecord(ctx, record)
 			if err != nil {
 				return nil, err
 			}
@@ -93,17 +132,29 @@ func (q queryResolver) ResolveNames(ctx context.Context, names []string) ([]*Rec
 	return gqlResponse, nil
 }
 
-func (q queryResolver) LookupNames(ctx context.Context, names []string) ([]*NameRecord, error) {
-	nsQueryClient := nstypes.NewQueryClient(q.ctx)
-	var gqlResponse []*NameRecord
+func (q queryResolver) LookupNames(ctx context.Context, names []string, height *string) ([]*NameRecord, error) {
+	ctx, err := q.withHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, name := range names {
-		res, err := nsQueryClient.LookupCrn(context.Background(), &nstypes.QueryLookupCrn{Crn: name})
+	nsQueryClient := nstypes.NewQueryClient(q.conn)
+	gqlResponse := make([]*NameRecord, 0, len(names))
+	for _, batch := range splitBatches(names) {
+		res, err := nsQueryClient.LookupCrns(ctx, &nstypes.QueryLookupCrnsRequest{Crns: batch})
 		if err != nil {
-			// Return nil for name not found.
-			gqlResponse = append(gqlResponse, nil)
-		} else {
-			gqlRecord, err := getGQLNameRecord(res.GetName())
+			return nil, err
+		}
+
+		for i := range res.GetNames() {
+			nameRecord := &res.GetNames()[i]
+			if nameRecord.Latest == nil {
+				// Return nil for name not found.
+				gqlResponse = append(gqlResponse, nil)
+				continue
+			}
+
+			gqlRecord, err := getGQLNameRecord(nameRecord)
 			if err != nil {
 				return nil, err
 			}
@@ -115,11 +166,16 @@ func (q queryResolver) LookupNames(ctx context.Context, names []string) ([]*Name
 	return gqlResponse, nil
 }
 
-func (q queryResolver) QueryRecords(ctx context.Context, attributes []*KeyValueInput, all *bool) ([]*Record, error) {
-	nsQueryClient := nstypes.NewQueryClient(q.ctx)
+func (q queryResolver) QueryRecords(ctx context.Context, attributes []*KeyValueInput, all *bool, height *string) ([]*Record, error) {
+	ctx, err := q.withHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	nsQueryClient := nstypes.NewQueryClient(q.conn)
 
 	res, err := nsQueryClient.ListRecords(
-		context.Background(),
+		ctx,
 		&nstypes.QueryListRecordsRequest{
 			Attributes: parseRequestAttributes(attributes),
 			All:        (all != nil && *all),
@@ -134,7 +190,7 @@ func (q queryResolver) QueryRecords(ctx context.Context, attributes []*KeyValueI
 	gqlResponse := make([]*Record, len(records))
 
 	for i, record := range records {
-		gqlRecord, err := getGQLRecord(context.Background(), q, record)
+		gqlRecord, err := getGQLRecord(ctx, record)
 		if err != nil {
 			return nil, err
 		}
@@ -145,27 +201,49 @@ func (q queryResolver) QueryRecords(ctx context.Context, attributes []*KeyValueI
 
 }
 
-func (q queryResolver) GetRecordsByIds(ctx context.Context, ids []string) ([]*Record, error) {
-	nsQueryClient := nstypes.NewQueryClient(q.ctx)
-	gqlResponse := make([]*Record, len(ids))
+func (q queryResolver) GetRecordsByIds(ctx context.Context, ids []string, height *string) ([]*Record, error) {
+	ctx, err := q.withHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
 
-	for i, id := range ids {
-		res, err := nsQueryClient.GetRecord(context.Background(), &nstypes.QueryRecordByIdRequest{Id: id})
+	return q.getRecords(ctx, ids)
+}
+
+// getRecords returns the records with the IDs at the height of the context, nil for the records not found.
+func (q queryResolver) getRecords(ctx context.Context, ids []string) ([]*Record, error) {
+	records, err := q.recordLoader(ctx).LoadAll(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	gqlResponse := make([]*Record, len(records))
+	for i, record := range records {
+		gqlRecord, err := getGQLRecord(ctx, record)
 		if err != nil {
-			// Return nil for record not found.
-			gqlResponse[i] = nil
-		} else {
-			record, err := getGQLRecord(context.Background(), q, res.GetRecord())
-			if err != nil {
-				return nil, err
-			}
-			gqlResponse[i] = record
+			return nil, err
 		}
+		gqlResponse[i] = gqlRecord
 	}
 
 	return gqlResponse, nil
 }
 
+// splitBatches splits a list into batches of the max size of the batch queries.
+func splitBatches(items []string) [][]string {
+	var batches [][]string
+	for len(items) > nskeeper.MaxBatchQuerySize {
+		batches = append(batches, items[:nskeeper.MaxBatchQuerySize])
+		items = items[nskeeper.MaxBatchQuerySize:]
+	}
+
+	if len(items) > 0 {
+		batches = append(batches, items)
+	}
+
+	return batches
+}
+
 func (q queryResolver) GetStatus(ctx context.Context) (*Status, error) {
 	nodeInfo, syncInfo, validatorInfo, err := getStatusInfo(q.ctx)
 	if err != nil {
@@ -199,7 +277,12 @@ func (q queryResolver) GetStatus(ctx context.Context) (*Status, error) {
 	}, nil
 }
 
-func (q queryResolver) GetAccounts(ctx context.Context, addresses []string) ([]*Account, error) {
+func (q queryResolver) GetAccounts(ctx context.Context, addresses []string, height *string) ([]*Account, error) {
+	ctx, err := q.withHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
 	accounts := make([]*Account, len(addresses))
 	for index, address := range addresses {
 		account, err := q.GetAccount(ctx, address)
@@ -212,7 +295,7 @@ func (q queryResolver) GetAccounts(ctx context.Context, addresses []string) ([]*
 }
 
 func (q queryResolver) GetAccount(ctx context.Context, address string) (*Account, error) {
-	authQueryClient := authtypes.NewQueryClient(q.ctx)
+	authQueryClient := authtypes.NewQueryClient(q.conn)
 	accountResponse, err := authQueryClient.Account(ctx, &authtypes.QueryAccountRequest{Address: address})
 	if err != nil {
 		return nil, err
@@ -229,7 +312,7 @@ func (q queryResolver) GetAccount(ctx context.Context, address string) (*Account
 	}
 
 	// Get the account balance
-	bankQueryClient := banktypes.NewQueryClient(q.ctx)
+	bankQueryClient := banktypes.NewQueryClient(q.conn)
 	balance, err := bankQueryClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: address})
 
 	accNum := strconv.FormatUint(account.GetAccountNumber(), 10)
@@ -244,7 +327,12 @@ func (q queryResolver) GetAccount(ctx context.Context, address string) (*Account
 	}, nil
 }
 
-func (q queryResolver) GetBondsByIds(ctx context.Context, ids []string) ([]*Bond, error) {
+func (q queryResolver) GetBondsByIds(ctx context.Context, ids []string, height *string) ([]*Bond, error) {
+	ctx, err := q.withHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
 	bonds := make([]*Bond, len(ids))
 	for index, id := range ids {
 		bondObj, err := q.GetBond(ctx, id)
@@ -258,8 +346,8 @@ func (q queryResolver) GetBondsByIds(ctx context.Context, ids []string) ([]*Bond
 }
 
 func (q *queryResolver) GetBond(ctx context.Context, id string) (*Bond, error) {
-	bondQueryClient := bondtypes.NewQueryClient(q.ctx)
-	bondResp, err := bondQueryClient.GetBondById(context.Background(), &bondtypes.QueryGetBondByIdRequest{Id: id})
+	bondQueryClient := bondtypes.NewQueryClient(q.conn)
+	bondResp, err := bondQueryClient.GetBondById(ctx, &bondtypes.QueryGetBondByIdRequest{Id: id})
 	if err != nil {
 		return nil, err
 	}
@@ -271,9 +359,14 @@ func (q *queryResolver) GetBond(ctx context.Context, id string) (*Bond, error) {
 	return getGQLBond(bondResp.GetBond())
 }
 
-func (q queryResolver) QueryBonds(ctx context.Context, attributes []*KeyValueInput) ([]*Bond, error) {
-	bondQueryClient := bondtypes.NewQueryClient(q.ctx)
-	bonds, err := bondQueryClient.Bonds(context.Background(), &bondtypes.QueryGetBondsRequest{})
+func (q queryResolver) QueryBonds(ctx context.Context, attributes []*KeyValueInput, height *string) ([]*Bond, error) {
+	ctx, err := q.withHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	bondQueryClient := bondtypes.NewQueryClient(q.conn)
+	bonds, err := bondQueryClient.Bonds(ctx, &bondtypes.QueryGetBondsRequest{})
 	if err != nil {
 		return nil, err
 	}
@@ -291,7 +384,12 @@ func (q queryResolver) QueryBonds(ctx context.Context, attributes []*KeyValueInp
 }
 
 // QueryBondsByOwner will return bonds by owner
-func (q queryResolver) QueryBondsByOwner(ctx context.Context, ownerAddresses []string) ([]*OwnerBonds, error) {
+func (q queryResolver) QueryBondsByOwner(ctx context.Context, ownerAddresses []string, height *string) ([]*OwnerBonds, error) {
+	ctx, err := q.withHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
 	ownerBonds := make([]*OwnerBonds, len(ownerAddresses))
 	for index, ownerAddress := range ownerAddresses {
 		bondsObj, err := q.GetBondsByOwner(ctx, ownerAddress)
@@ -305,8 +403,8 @@ func (q queryResolver) QueryBondsByOwner(ctx context.Context, ownerAddresses []s
 }
 
 func (q queryResolver) GetBondsByOwner(ctx context.Context, address string) (*OwnerBonds, error) {
-	bondQueryClient := bondtypes.NewQueryClient(q.ctx)
-	bondResp, err := bondQueryClient.GetBondsByOwner(context.Background(), &bondtypes.QueryGetBondsByOwnerRequest{Owner: address})
+	bondQueryClient := bondtypes.NewQueryClient(q.conn)
+	bondResp, err := bondQueryClient.GetBondsByOwner(ctx, &bondtypes.QueryGetBondsByOwnerRequest{Owner: address})
 	if err != nil {
 		return nil, err
 	}
@@ -323,15 +421,20 @@ func (q queryResolver) GetBondsByOwner(ctx context.Context, address string) (*Ow
 	return &OwnerBonds{Bonds: ownerBonds, Owner: address}, nil
 }
 
-func (q queryResolver) GetAuctionsByIds(ctx context.Context, ids []string) ([]*Auction, error) {
-	auctionQueryClient := auctiontypes.NewQueryClient(q.ctx)
+func (q queryResolver) GetAuctionsByIds(ctx context.Context, ids []string, height *string) ([]*Auction, error) {
+	ctx, err := q.withHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	auctionQueryClient := auctiontypes.NewQueryClient(q.conn)
 	gqlAuctionResponse := make([]*Auction, len(ids))
 	for i, id := range ids {
-		auctionObj, err := auctionQueryClient.GetAuction(context.Background(), &auctiontypes.AuctionRequest{Id: id})
+		auctionObj, err := auctionQueryClient.GetAuction(ctx, &auctiontypes.AuctionRequest{Id: id})
 		if err != nil {
 			return nil, err
 		}
-		bidsObj, err := auctionQueryClient.GetBids(context.Background(), &auctiontypes.BidsRequest{AuctionId: id})
+		bidsObj, err := auctionQueryClient.GetBids(ctx, &auctiontypes.BidsRequest{AuctionId: id})
 		if err != nil {
 			return nil, err
 		}
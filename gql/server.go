@@ -1,44 +1,182 @@
 package gql
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"time"
+
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/rs/cors"
 	"github.com/spf13/viper"
-	"net/http"
+
+	"github.com/tharsis/ethermint/server/config"
 )
 
-// Server configures and starts the GQL server.
-func Server(ctx client.Context) {
+// idleTimeout is how long idle keep-alive connections to the GQL server are kept.
+const idleTimeout = 120 * time.Second
+
+// StartServer configures and starts the GQL server, if enabled. The server is stopped with
+// Shutdown, which also ends the subscriptions, and the returned channel is closed once it's stopped.
+func StartServer(ctx client.Context, cfg config.Config) (*http.Server, chan struct{}, error) {
 	if !viper.GetBool("gql-server") {
-		return
+		return nil, nil, nil
 	}
-	logFile := viper.GetString("log-file")
 
+	useTLS := viper.GetBool("gql-tls")
+	if useTLS && (cfg.TLS.CertificatePath == "" || cfg.TLS.KeyPath == "") {
+		return nil, nil, errors.New("GQL TLS requires the tls.certificate-path and tls.key-path app config")
+	}
+
+	logFile := viper.GetString("log-file")
 	port := viper.GetString("gql-port")
+	adminToken := viper.GetString("gql-admin-token")
+	timeout := viper.GetDuration("gql-timeout")
+
+	conn, err := newCachedConn(ctx, ResponseCacheSize, ResponseCacheBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	auth := newAuthenticator(viper.GetStringSlice("gql-api-keys"), viper.GetString("gql-jwt-secret"), adminToken)
+	limiter := newRateLimiter(viper.GetFloat64("gql-rate-limit"), viper.GetInt("gql-rate-burst"))
+
+	gqlServer := newGQLServer(&Resolver{
+		ctx:         ctx,
+		conn:        conn,
+		logFile:     logFile,
+		diagnostics: viper.GetBool("gql-diagnostics") && adminToken != "",
+		feed:        newBlockFeed(ctx),
+	}, auth, viper.GetInt("gql-max-complexity"), viper.GetInt("gql-max-depth"))
 
-	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: &Resolver{
-		ctx:     ctx,
-		logFile: logFile,
-	}}))
+	srv := limiter.handler(auth.handler(withTimeout(timeout, withAdmin(adminToken, gqlServer))))
 
-	http.Handle("/", playground.Handler("GraphQL playground", "/api"))
+	mux := http.NewServeMux()
+	mux.Handle("/", playground.Handler("GraphQL playground", "/api"))
 
 	if viper.GetBool("gql-playground") {
 		apiBase := viper.GetString("gql-playground-api-base")
 
-		http.Handle("/webui", playground.Handler("GraphQL playground", apiBase+"/api"))
-		http.Handle("/console", playground.Handler("GraphQL playground", apiBase+"/graphql"))
+		mux.Handle("/webui", playground.Handler("GraphQL playground", apiBase+"/api"))
+		mux.Handle("/console", playground.Handler("GraphQL playground", apiBase+"/graphql"))
 	}
 
-	http.Handle("/api", srv)
-	http.Handle("/graphql", srv)
+	mux.Handle("/api", srv)
+	mux.Handle("/graphql", srv)
 
-	log.Info("Connect to GraphQL playground", "url", fmt.Sprintf("http://localhost:%s", port))
-	err := http.ListenAndServe(":"+port, nil)
-	if err != nil {
-		panic(err)
+	handlerWithCors := cors.New(cors.Options{
+		AllowedOrigins: viper.GetStringSlice("gql-cors-allowed-origins"),
+		AllowedMethods: []string{http.MethodGet, http.MethodPost},
+		AllowedHeaders: []string{"Content-Type", "Authorization", APIKeyHeader},
+	})
+
+	// Cancelling the base context on shutdown ends the websocket connections, which Shutdown doesn't close.
+	baseCtx, cancel := context.WithCancel(context.Background())
+	httpSrv := &http.Server{
+		Addr:              ":" + port,
+		Handler:           handlerWithCors.Handler(mux),
+		ReadHeaderTimeout: timeout,
+		IdleTimeout:       idleTimeout,
+		BaseContext:       func(net.Listener) context.Context { return baseCtx },
+	}
+	httpSrv.RegisterOnShutdown(cancel)
+	httpSrvDone := make(chan struct{}, 1)
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Info("Starting GQL server", "address", httpSrv.Addr, "tls", useTLS)
+
+		var err error
+		if useTLS {
+			err = httpSrv.ListenAndServeTLS(cfg.TLS.CertificatePath, cfg.TLS.KeyPath)
+		} else {
+			err = httpSrv.ListenAndServe()
+		}
+
+		if err == http.ErrServerClosed {
+			close(httpSrvDone)
+			return
+		}
+
+		log.Error("failed to start GQL server", "error", err.Error())
+		errCh <- err
+	}()
+
+	select {
+	case err := <-errCh:
+		cancel()
+		return nil, nil, fmt.Errorf("failed to boot GQL server: %w", err)
+	case <-time.After(types.ServerStartTime): // assume the GQL server started successfully
 	}
+
+	scheme := "http"
+	if useTLS {
+		scheme = "https"
+	}
+
+	log.Info("Connect to GraphQL playground", "url", fmt.Sprintf("%s://localhost:%s", scheme, port))
+
+	return httpSrv, httpSrvDone, nil
+}
+
+// newGQLServer returns the GQL handler, with the transports and extensions of the gqlgen default server,
+// the operation limits and the resolver metrics.
+func newGQLServer(resolver *Resolver, auth authenticator, maxComplexity int, maxDepth int) *handler.Server {
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolver}))
+
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc:              auth.websocketInit,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+
+	srv.SetQueryCache(lru.New(1000))
+
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New(100),
+	})
+
+	if maxComplexity > 0 {
+		srv.Use(extension.FixedComplexityLimit(maxComplexity))
+	}
+
+	if maxDepth > 0 {
+		srv.Use(depthLimit{maxDepth: maxDepth})
+	}
+
+	srv.AroundOperations(withRequestState)
+	srv.AroundFields(observeResolver)
+
+	return srv
+}
+
+// withTimeout limits the time of the requests, except the websocket connections of subscriptions.
+func withTimeout(timeout time.Duration, next http.Handler) http.Handler {
+	if timeout <= 0 {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isWebsocketUpgrade(r) {
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			r = r.WithContext(ctx)
+		}
+
+		next.ServeHTTP(w, r)
+	})
 }
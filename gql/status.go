@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 // NodeDataPath is the path to the chibaclonkd data folder.
@@ -89,6 +90,62 @@ func getValidatorSet(client client.Context) ([]*ValidatorInfo, error) {
 	return validatorSet, nil
 }
 
+// getBlockTimes returns the times of the latest blocks, newest first.
+func getBlockTimes(ctx context.Context, client client.Context, numBlocks int) ([]*BlockTime, error) {
+	nodeClient, err := client.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := nodeClient.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get one more block for the duration of the oldest one. BlockchainInfo returns at most 20 blocks
+	// per call, newest first, so the range is fetched in pages.
+	var metas []*tmtypes.BlockMeta
+	maxHeight := status.SyncInfo.LatestBlockHeight
+	for len(metas) <= numBlocks && maxHeight >= 1 {
+		minHeight := maxHeight - int64(numBlocks-len(metas))
+		if minHeight < 1 {
+			minHeight = 1
+		}
+
+		res, err := nodeClient.BlockchainInfo(ctx, minHeight, maxHeight)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(res.BlockMetas) == 0 {
+			break
+		}
+
+		metas = append(metas, res.BlockMetas...)
+		maxHeight = res.BlockMetas[len(res.BlockMetas)-1].Header.Height - 1
+	}
+
+	blockTimes := []*BlockTime{}
+	for i, meta := range metas {
+		if len(blockTimes) == numBlocks {
+			break
+		}
+
+		duration := "0s"
+		if i+1 < len(metas) {
+			duration = meta.Header.Time.Sub(metas[i+1].Header.Time).String()
+		}
+
+		blockTimes = append(blockTimes, &BlockTime{
+			Height:   strconv.FormatInt(meta.Header.Height, 10),
+			Time:     meta.Header.Time.String(),
+			Duration: duration,
+		})
+	}
+
+	return blockTimes, nil
+}
+
 // GetDiskUsage returns disk usage for the given path.
 func GetDiskUsage(dirPath string) (string, error) {
 	out, err := exec.Command("du", "-sh", dirPath).Output()
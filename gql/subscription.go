@@ -0,0 +1,367 @@
+package gql
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	nskeeper "github.com/tharsis/ethermint/x/nameservice/keeper"
+	nstypes "github.com/tharsis/ethermint/x/nameservice/types"
+)
+
+const (
+	// feedSubscriber is the name of the Tendermint event subscriber of the block feed.
+	feedSubscriber = "gql"
+
+	// feedBufferSize is the number of block updates buffered for a subscriber,
+	// slower subscribers are dropped.
+	feedBufferSize = 64
+)
+
+// blockUpdate is a new block, with the nameservice changesets committed since the previous update.
+type blockUpdate struct {
+	header     tmtypes.Header
+	changeSets []*nstypes.BlockChangeSet
+}
+
+// blockFeed follows new blocks through Tendermint events and fans them out to GQL subscriptions,
+// with the nameservice changesets of the blocks.
+type blockFeed struct {
+	ctx client.Context
+
+	mu          sync.Mutex
+	started     bool
+	subscribers map[chan *blockUpdate]struct{}
+}
+
+func newBlockFeed(ctx client.Context) *blockFeed {
+	return &blockFeed{
+		ctx:         ctx,
+		subscribers: map[chan *blockUpdate]struct{}{},
+	}
+}
+
+// subscribe returns a channel of block updates, closed when the subscriber is dropped,
+// and a function to unsubscribe.
+func (f *blockFeed) subscribe() (<-chan *blockUpdate, func(), error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.started {
+		if err := f.start(); err != nil {
+			return nil, nil, err
+		}
+
+		f.started = true
+	}
+
+	ch := make(chan *blockUpdate, feedBufferSize)
+	f.subscribers[ch] = struct{}{}
+
+	unsubscribe := func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if _, ok := f.subscribers[ch]; ok {
+			delete(f.subscribers, ch)
+			close(ch)
+		}
+	}
+
+	return ch, unsubscribe, nil
+}
+
+// start subscribes to new block headers, it's called with the first GQL subscription.
+func (f *blockFeed) start() error {
+	if !f.ctx.Client.IsRunning() {
+		if err := f.ctx.Client.Start(); err != nil {
+			return err
+		}
+	}
+
+	query := tmtypes.QueryForEvent(tmtypes.EventNewBlockHeaderValue).String()
+	events, err := f.ctx.Client.Subscribe(context.Background(), feedSubscriber, query, feedBufferSize)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		var lastHeight int64
+		for event := range events {
+			data, ok := event.Data.(tmtypes.EventDataNewBlockHeader)
+			if !ok {
+				continue
+			}
+
+			fromHeight := lastHeight + 1
+			if lastHeight == 0 {
+				fromHeight = data.Header.Height
+			}
+
+			changeSets, err := f.getBlockChangeSets(fromHeight, data.Header.Height)
+			if err != nil {
+				log.Error("failed to get block changesets", "height", data.Header.Height, "error", err)
+			}
+
+			lastHeight = data.Header.Height
+			f.publish(&blockUpdate{header: data.Header, changeSets: changeSets})
+		}
+	}()
+
+	return nil
+}
+
+// getBlockChangeSets returns the changesets of a range of blocks.
+func (f *blockFeed) getBlockChangeSets(fromHeight int64, toHeight int64) ([]*nstypes.BlockChangeSet, error) {
+	nsQueryClient := nstypes.NewQueryClient(f.ctx)
+
+	var changeSets []*nstypes.BlockChangeSet
+	for fromHeight <= toHeight {
+		res, err := nsQueryClient.GetBlockChangeSets(
+			context.Background(),
+			&nstypes.QueryGetBlockChangeSetsRequest{FromHeight: fromHeight, ToHeight: toHeight},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		changeSets = append(changeSets, res.GetBlockChangeSets()...)
+		if res.ToHeight < fromHeight {
+			break
+		}
+
+		fromHeight = res.ToHeight + 1
+	}
+
+	return changeSets, nil
+}
+
+func (f *blockFeed) publish(update *blockUpdate) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for ch := range f.subscribers {
+		select {
+		case ch <- update:
+		default:
+			log.Debug("dropping slow GQL subscriber")
+			delete(f.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// Subscription is the entry point to subscription execution.
+func (r *Resolver) Subscription() SubscriptionResolver {
+	return &subscriptionResolver{r}
+}
+
+type subscriptionResolver struct{ *Resolver }
+
+// follow calls handle with each block update until the subscription ends, then calls done.
+func (s subscriptionResolver) follow(ctx context.Context, handle func(update *blockUpdate) error, done func()) error {
+	updates, unsubscribe, err := s.feed.subscribe()
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer done()
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case update, ok := <-updates:
+				if !ok {
+					return
+				}
+
+				if err := handle(update); err != nil {
+					log.Debug("GQL subscription failed", "error", err)
+					return
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (s subscriptionResolver) OnNewBlock(ctx context.Context) (<-chan *Block, error) {
+	out := make(chan *Block, 1)
+
+	err := s.follow(ctx, func(update *blockUpdate) error {
+		select {
+		case out <- getGQLBlock(update.header):
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}, func() { close(out) })
+
+	return out, err
+}
+
+func (s subscriptionResolver) OnRecordChanged(ctx context.Context, attributes []*KeyValueInput, all *bool) (<-chan *Record, error) {
+	out := make(chan *Record, 1)
+	requestAttributes := parseRequestAttributes(attributes)
+	nsQueryClient := nstypes.NewQueryClient(s.ctx)
+
+	err := s.follow(ctx, func(update *blockUpdate) error {
+		for _, changeSet := range update.changeSets {
+			for _, id := range changeSet.Records {
+				res, err := nsQueryClient.GetRecord(context.Background(), &nstypes.QueryRecordByIdRequest{Id: id})
+				if err != nil {
+					// The record could have expired since.
+					continue
+				}
+
+				record := res.GetRecord()
+				recordType := record.ToRecordType()
+				if !nskeeper.MatchOnAttributes(&recordType, requestAttributes, all != nil && *all) {
+					continue
+				}
+
+				gqlRecord, err := getGQLRecord(context.Background(), record)
+				if err != nil {
+					return err
+				}
+
+				select {
+				case out <- gqlRecord:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+
+		return nil
+	}, func() { close(out) })
+
+	return out, err
+}
+
+func (s subscriptionResolver) OnNameChanged(ctx context.Context, crnPrefix *string) (<-chan *NameChange, error) {
+	out := make(chan *NameChange, 1)
+	q := queryResolver{s.Resolver}
+
+	err := s.follow(ctx, func(update *blockUpdate) error {
+		for _, changeSet := range update.changeSets {
+			for _, crn := range changeSet.Names {
+				if crnPrefix != nil && !strings.HasPrefix(crn, *crnPrefix) {
+					continue
+				}
+
+				records, err := q.LookupNames(ctx, []string{crn}, nil)
+				if err != nil {
+					return err
+				}
+
+				change := &NameChange{Name: crn, Record: records[0]}
+				select {
+				case out <- change:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+
+		return nil
+	}, func() { close(out) })
+
+	return out, err
+}
+
+func (s subscriptionResolver) OnAuthorityChanged(ctx context.Context, name *string) (<-chan *AuthorityChange, error) {
+	out := make(chan *AuthorityChange, 1)
+	q := queryResolver{s.Resolver}
+
+	err := s.follow(ctx, func(update *blockUpdate) error {
+		for _, changeSet := range update.changeSets {
+			for _, authority := range changeSet.Authorities {
+				if name != nil && authority != *name {
+					continue
+				}
+
+				records, err := q.LookupAuthorities(ctx, []string{authority}, nil)
+				if err != nil {
+					return err
+				}
+
+				change := &AuthorityChange{Name: authority, Authority: records[0]}
+				select {
+				case out <- change:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+
+		return nil
+	}, func() { close(out) })
+
+	return out, err
+}
+
+func (s subscriptionResolver) OnAuctionEvent(ctx context.Context, auctionID *string) (<-chan *AuctionEvent, error) {
+	out := make(chan *AuctionEvent, 1)
+	q := queryResolver{s.Resolver}
+
+	sendAuctionEvent := func(id string, bidderAddress *string) error {
+		if auctionID != nil && id != *auctionID {
+			return nil
+		}
+
+		auctions, err := q.GetAuctionsByIds(ctx, []string{id}, nil)
+		if err != nil {
+			return err
+		}
+
+		if auctions[0] == nil {
+			return nil
+		}
+
+		select {
+		case out <- &AuctionEvent{Auction: auctions[0], BidderAddress: bidderAddress}:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	err := s.follow(ctx, func(update *blockUpdate) error {
+		for _, changeSet := range update.changeSets {
+			for _, id := range changeSet.Auctions {
+				if err := sendAuctionEvent(id, nil); err != nil {
+					return err
+				}
+			}
+
+			for _, bid := range changeSet.AuctionBids {
+				bidderAddress := bid.BidderAddress
+				if err := sendAuctionEvent(bid.AuctionId, &bidderAddress); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}, func() { close(out) })
+
+	return out, err
+}
+
+func getGQLBlock(header tmtypes.Header) *Block {
+	return &Block{
+		Height: strconv.FormatInt(header.Height, 10),
+		Hash:   header.Hash().String(),
+		Time:   header.Time.String(),
+	}
+}
@@ -0,0 +1,60 @@
+package gql_test
+
+import (
+	"testing"
+	"time"
+
+	gqlclient "github.com/99designs/gqlgen/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tharsis/ethermint/x/nameservice/helpers"
+	nstypes "github.com/tharsis/ethermint/x/nameservice/types"
+)
+
+// nextWithTimeout reads the next message of a subscription, failing the test if none comes.
+func nextWithTimeout(t *testing.T, sub *gqlclient.Subscription, response interface{}) {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- sub.Next(response)
+	}()
+
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for a subscription message")
+	}
+}
+
+func TestOnRecordChanged(t *testing.T) {
+	node := newTestNode(t)
+	c := newTestClient(t, node)
+
+	sub := c.Websocket(`subscription { onRecordChanged { id names attributes { key } } }`)
+	defer sub.Close()
+
+	attributes := helpers.BytesToBase64(helpers.MarshalMapToJSONBytes(map[string]interface{}{"type": "test"}))
+	header, _ := node.commitBlock(func(ctx sdk.Context) {
+		keeper := node.app.NameServiceKeeper
+		keeper.PutRecord(ctx, nstypes.Record{Id: "unnamed", Attributes: attributes})
+		keeper.PutRecord(ctx, nstypes.Record{Id: "named", Attributes: attributes})
+		keeper.SetNameAuthority(ctx, "example.com", &nstypes.NameAuthority{Status: nstypes.AuthorityActive})
+		keeper.SetNameRecord(ctx, "crn://example.com/app", "named")
+	})
+	node.publishHeader(header)
+
+	// Only the named record is sent by default.
+	var res struct {
+		OnRecordChanged struct {
+			ID         string
+			Names      []string
+			Attributes []struct{ Key string }
+		}
+	}
+	nextWithTimeout(t, sub, &res)
+	require.Equal(t, "named", res.OnRecordChanged.ID)
+	require.Equal(t, []string{"crn://example.com/app"}, res.OnRecordChanged.Names)
+	require.Len(t, res.OnRecordChanged.Attributes, 1)
+	require.Equal(t, "type", res.OnRecordChanged.Attributes[0].Key)
+}
@@ -3,13 +3,16 @@ package gql
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"reflect"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	auctiontypes "github.com/tharsis/ethermint/x/auction/types"
 	bondtypes "github.com/tharsis/ethermint/x/bond/types"
 	nstypes "github.com/tharsis/ethermint/x/nameservice/types"
+	"google.golang.org/grpc/metadata"
 )
 
 // OwnerAttributeName denotes the owner attribute name for a bond.
@@ -53,9 +56,9 @@ func GetGQLNameAuthorityRecord(record *nstypes.NameAuthority) (*AuthorityRecord,
 	}, nil
 }
 
-func getGQLRecord(ctx context.Context, resolver QueryResolver, record nstypes.Record) (*Record, error) {
+func getGQLRecord(ctx context.Context, record nstypes.Record) (*Record, error) {
 	// Nil record.
-	if record.Deleted {
+	if record.Deleted || record.Id == "" {
 		return nil, nil
 	}
 
@@ -65,11 +68,6 @@ func getGQLRecord(ctx context.Context, resolver QueryResolver, record nstypes.Re
 		return nil, err
 	}
 
-	references, err := getReferences(ctx, resolver, &recordType)
-	if err != nil {
-		return nil, err
-	}
-
 	return &Record{
 		ID:         record.Id,
 		BondID:     record.GetBondId(),
@@ -78,7 +76,9 @@ func getGQLRecord(ctx context.Context, resolver QueryResolver, record nstypes.Re
 		Owners:     record.GetOwners(),
 		Names:      record.GetNames(),
 		Attributes: attributes,
-		References: references,
+
+		referenceIDs: getReferenceIDs(&recordType),
+		height:       getHeight(ctx),
 	}, nil
 }
 
@@ -181,7 +181,7 @@ func GetGQLAuction(auction *auctiontypes.Auction, bids []*auctiontypes.Bid) (*Au
 	return &gqlAuction, nil
 }
 
-func getReferences(ctx context.Context, resolver QueryResolver, r *nstypes.RecordType) ([]*Record, error) {
+func getReferenceIDs(r *nstypes.RecordType) []string {
 	var ids []string
 
 	for _, value := range r.Attributes {
@@ -197,7 +197,7 @@ func getReferences(ctx context.Context, resolver QueryResolver, r *nstypes.Recor
 		}
 	}
 
-	return resolver.GetRecordsByIds(ctx, ids)
+	return ids
 }
 
 func getAttributes(r *nstypes.RecordType) ([]*KeyValue, error) {
@@ -313,3 +313,41 @@ func parseRequestAttributes(attrs []*KeyValueInput) []*nstypes.QueryListRecordsR
 
 	return kvPairs
 }
+
+// withHeight returns a context to query the state at a block height. If the height is nil, the
+// latest height is pinned for the whole GQL query, so that its results are consistent and cacheable.
+func (r *Resolver) withHeight(ctx context.Context, height *string) (context.Context, error) {
+	if height == nil {
+		state := getRequestState(ctx)
+		if state == nil {
+			return ctx, nil
+		}
+
+		latestHeight, err := state.getLatestHeight(ctx, r.ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		height = &latestHeight
+	}
+
+	if h, err := strconv.ParseInt(*height, 10, 64); err != nil || h < 0 {
+		return nil, fmt.Errorf("invalid height %q", *height)
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, *height), nil
+}
+
+// getHeight returns the block height a context queries the state at, empty for the latest state.
+func getHeight(ctx context.Context) string {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 && heights[0] != "0" {
+		return heights[0]
+	}
+
+	return ""
+}
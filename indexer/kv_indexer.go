@@ -0,0 +1,504 @@
+// Package indexer implements the custom EVM indexer, which keeps the ethereum transactions, receipts and
+// logs of the committed blocks in a local key-value store, so that the JSON-RPC doesn't need to search the
+// Tendermint tx indexer nor to decode the ABCI events of the block results on every query.
+package indexer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	rpctypes "github.com/tharsis/ethermint/rpc/ethereum/types"
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+const (
+	KeyPrefixTxHash     = 1
+	KeyPrefixTxIndex    = 2
+	KeyPrefixBlock      = 3
+	KeyPrefixLog        = 4
+	KeyPrefixAddressLog = 5
+	KeyPrefixTopicLog   = 6
+
+	// logKeyLength is the length of the log location: height, group and position
+	logKeyLength = 8 + 4 + 4
+)
+
+var _ ethermint.EVMTxIndexer = &KVIndexer{}
+
+// KVIndexer implements an eth tx and logs indexer on a KV db.
+type KVIndexer struct {
+	db        dbm.DB
+	logger    log.Logger
+	clientCtx client.Context
+}
+
+// NewKVIndexer creates the KVIndexer
+func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
+	return &KVIndexer{db, logger, clientCtx}
+}
+
+// IndexBlock indexes all the eth txs and logs in a block through the following steps:
+// - Iterates over all of the Txs in Block
+// - Parses eth Tx infos from cosmos-sdk events for every TxResult
+// - Iterates over all the messages of the Tx
+// - Builds and stores the indexer.TxResult based on parsed events for every message
+// - Stores the logs of the block, indexed by address and topics
+func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx, endBlockEvents []abci.Event) error {
+	height := block.Header.Height
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	// record index of valid eth tx during the iteration
+	var (
+		ethTxIndex        int32
+		cumulativeGasUsed uint64
+	)
+	for txIndex, tx := range block.Txs {
+		result := txResults[txIndex]
+		if result.Code != 0 {
+			// the txs failed in the ante handler are not included by the eth blocks
+			cumulativeGasUsed += uint64(result.GasUsed)
+			continue
+		}
+
+		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
+		if err != nil {
+			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			cumulativeGasUsed += uint64(result.GasUsed)
+			continue
+		}
+
+		msgs := tx.GetMsgs()
+		for _, msg := range msgs {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+
+			// the eth tx index follows the eth txs list of the blocks returned by the json-rpc
+			index := ethTxIndex
+			ethTxIndex++
+
+			msgIndex, attrs := rpctypes.FindTxAttributes(result.Events, ethMsg.Hash)
+			if msgIndex < 0 {
+				kv.logger.Error("ethereum tx not found in msgs", "block", height, "txIndex", txIndex, "hash", ethMsg.Hash)
+				continue
+			}
+
+			var gasUsed uint64
+			if len(msgs) == 1 {
+				gasUsed = uint64(result.GasUsed)
+			} else {
+				gasUsed, err = rpctypes.GetUint64Attribute(attrs, evmtypes.AttributeKeyTxGasUsed)
+				if err != nil {
+					kv.logger.Error("Fail to parse gas used", "err", err, "block", height, "txIndex", txIndex)
+					continue
+				}
+			}
+
+			_, failed := attrs[evmtypes.AttributeKeyEthereumTxFailed]
+
+			txResult := ethermint.TxResult{
+				Height:            height,
+				TxIndex:           uint32(txIndex),
+				MsgIndex:          uint32(msgIndex),
+				EthTxIndex:        index,
+				Failed:            failed,
+				GasUsed:           gasUsed,
+				CumulativeGasUsed: cumulativeGasUsed + rpctypes.AccumulativeGasUsedOfMsg(result.Events, msgIndex),
+			}
+			if err := saveTxResult(kv.clientCtx.Codec, batch, common.HexToHash(ethMsg.Hash), &txResult); err != nil {
+				return errors.Wrapf(err, "IndexBlock %d", height)
+			}
+		}
+
+		cumulativeGasUsed += uint64(result.GasUsed)
+	}
+
+	if err := kv.indexLogs(batch, height, txResults, endBlockEvents); err != nil {
+		return errors.Wrapf(err, "IndexBlock %d", height)
+	}
+
+	if err := batch.Set(BlockKey(height), []byte{}); err != nil {
+		return errors.Wrapf(err, "IndexBlock %d", height)
+	}
+
+	if err := batch.Write(); err != nil {
+		return errors.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	}
+	return nil
+}
+
+// indexLogs stores the logs of the block grouped like the logs decoded from the block results, one group
+// per eth tx and the logs emitted by the modules at the end of the block, along with the address and
+// topic indexes of the logs.
+func (kv *KVIndexer) indexLogs(batch dbm.Batch, height int64, txResults []*abci.ResponseDeliverTx, endBlockEvents []abci.Event) error {
+	var blockLogs [][]*ethtypes.Log
+	for _, txResult := range txResults {
+		logs, err := rpctypes.AllTxLogsFromEvents(txResult.Events)
+		if err != nil {
+			return err
+		}
+
+		blockLogs = append(blockLogs, logs...)
+	}
+
+	logs, err := rpctypes.AllTxLogsFromEvents(endBlockEvents)
+	if err != nil {
+		return err
+	}
+	blockLogs = append(blockLogs, logs...)
+
+	for group, logs := range blockLogs {
+		for pos, log := range logs {
+			loc := LogLocation(height, uint32(group), uint32(pos))
+
+			bz, err := evmtypes.NewLogFromEth(log).Marshal()
+			if err != nil {
+				return err
+			}
+			if err := batch.Set(concat([]byte{KeyPrefixLog}, loc), bz); err != nil {
+				return err
+			}
+
+			if err := batch.Set(AddressLogKey(log.Address, loc), []byte{}); err != nil {
+				return err
+			}
+			for i, topic := range log.Topics {
+				if err := batch.Set(TopicLogKey(uint8(i), topic, loc), []byte{}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (kv *KVIndexer) LastIndexedBlock() (int64, error) {
+	return LoadLastBlock(kv.db)
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
+	return LoadFirstBlock(kv.db)
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (kv *KVIndexer) GetByTxHash(hash common.Hash) (*ethermint.TxResult, error) {
+	bz, err := kv.db.Get(TxHashKey(hash))
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	var txKey ethermint.TxResult
+	if err := kv.clientCtx.Codec.Unmarshal(bz, &txKey); err != nil {
+		return nil, errors.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return &txKey, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ethermint.TxResult, error) {
+	bz, err := kv.db.Get(TxIndexKey(blockNumber, txIndex))
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	return kv.GetByTxHash(common.BytesToHash(bz))
+}
+
+// GetLogsByHeight returns the logs of an indexed block, grouped by tx.
+func (kv *KVIndexer) GetLogsByHeight(height int64) ([][]*ethtypes.Log, error) {
+	indexed, err := kv.db.Has(BlockKey(height))
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetLogsByHeight %d", height)
+	}
+	if !indexed {
+		return nil, fmt.Errorf("block not indexed: %d", height)
+	}
+
+	prefix := append([]byte{KeyPrefixLog}, sdk.Uint64ToBigEndian(uint64(height))...)
+	it, err := dbm.IteratePrefix(kv.db, prefix)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetLogsByHeight %d", height)
+	}
+	defer it.Close()
+
+	blockLogs := [][]*ethtypes.Log{}
+	for ; it.Valid(); it.Next() {
+		group := int(binary.BigEndian.Uint32(it.Key()[9:13]))
+		log, err := unmarshalLog(it.Value())
+		if err != nil {
+			return nil, errors.Wrapf(err, "GetLogsByHeight %d", height)
+		}
+
+		for len(blockLogs) <= group {
+			blockLogs = append(blockLogs, []*ethtypes.Log{})
+		}
+		blockLogs[group] = append(blockLogs[group], log)
+	}
+
+	return blockLogs, nil
+}
+
+// FilterLogs returns the logs of the [from, to] blocks range matching the criteria. The candidate logs
+// are looked up through the address index, or the topic index of the first position that has topics, so
+// only the whole range is scanned when the criteria has neither addresses nor topics.
+func (kv *KVIndexer) FilterLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error) {
+	if from > to {
+		return []*ethtypes.Log{}, nil
+	}
+
+	start := sdk.Uint64ToBigEndian(uint64(from))
+	end := sdk.Uint64ToBigEndian(uint64(to + 1))
+
+	var prefixes [][]byte
+	switch {
+	case len(addresses) > 0:
+		for _, address := range addresses {
+			prefixes = append(prefixes, append([]byte{KeyPrefixAddressLog}, address.Bytes()...))
+		}
+	default:
+		for i, topicList := range topics {
+			if len(topicList) == 0 {
+				continue
+			}
+			for _, topic := range topicList {
+				prefixes = append(prefixes, TopicLogKey(uint8(i), topic, nil))
+			}
+			break
+		}
+	}
+
+	var locations [][]byte
+	if len(prefixes) == 0 {
+		locations = append(locations, nil)
+	} else {
+		seen := make(map[string]bool)
+		for _, prefix := range prefixes {
+			it, err := kv.db.Iterator(concat(prefix, start), concat(prefix, end))
+			if err != nil {
+				return nil, errors.Wrap(err, "FilterLogs")
+			}
+			for ; it.Valid(); it.Next() {
+				loc := string(it.Key()[len(prefix):])
+				if seen[loc] {
+					continue
+				}
+				seen[loc] = true
+				locations = append(locations, []byte(loc))
+			}
+			it.Close()
+		}
+		sort.Slice(locations, func(i, j int) bool {
+			return bytes.Compare(locations[i], locations[j]) < 0
+		})
+	}
+
+	logs := []*ethtypes.Log{}
+	appendLog := func(bz []byte) error {
+		log, err := unmarshalLog(bz)
+		if err != nil {
+			return err
+		}
+		if !matchLog(log, addresses, topics) {
+			return nil
+		}
+		if len(logs) >= limit {
+			return fmt.Errorf("query returned more than %d results", limit)
+		}
+		logs = append(logs, log)
+		return nil
+	}
+
+	for _, loc := range locations {
+		if loc != nil {
+			bz, err := kv.db.Get(concat([]byte{KeyPrefixLog}, loc))
+			if err != nil {
+				return nil, errors.Wrap(err, "FilterLogs")
+			}
+			if err := appendLog(bz); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		// no criteria to look up the index, scan all the logs in the range
+		it, err := kv.db.Iterator(concat([]byte{KeyPrefixLog}, start), concat([]byte{KeyPrefixLog}, end))
+		if err != nil {
+			return nil, errors.Wrap(err, "FilterLogs")
+		}
+		for ; it.Valid(); it.Next() {
+			if err := appendLog(it.Value()); err != nil {
+				it.Close()
+				return nil, err
+			}
+		}
+		it.Close()
+	}
+
+	return logs, nil
+}
+
+// matchLog returns true if the log matches the addresses and the positional topics criteria, an empty
+// criteria list matches any value.
+func matchLog(log *ethtypes.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		found := false
+		for _, address := range addresses {
+			if log.Address == address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+
+	for i, sub := range topics {
+		if len(sub) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range sub {
+			if log.Topics[i] == topic {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// concat returns a new slice with the content of a and b, without sharing their backing arrays.
+func concat(a, b []byte) []byte {
+	bz := make([]byte, 0, len(a)+len(b))
+	return append(append(bz, a...), b...)
+}
+
+func unmarshalLog(bz []byte) (*ethtypes.Log, error) {
+	var log evmtypes.Log
+	if err := log.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return log.ToEthereum(), nil
+}
+
+// TxHashKey returns the key for db entry: `tx hash -> tx result struct`
+func TxHashKey(hash common.Hash) []byte {
+	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
+}
+
+// TxIndexKey returns the key for db entry: `(block number, tx index) -> tx hash`
+func TxIndexKey(blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))
+	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
+}
+
+// BlockKey returns the key for the db entry marking an indexed block
+func BlockKey(height int64) []byte {
+	return append([]byte{KeyPrefixBlock}, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// LogLocation returns the location of a log in the block: the height, the group of the log (eth tx or end
+// block logs) and the position in the group.
+func LogLocation(height int64, group, pos uint32) []byte {
+	loc := make([]byte, logKeyLength)
+	binary.BigEndian.PutUint64(loc, uint64(height))
+	binary.BigEndian.PutUint32(loc[8:], group)
+	binary.BigEndian.PutUint32(loc[12:], pos)
+	return loc
+}
+
+// AddressLogKey returns the key for the address index entry of a log
+func AddressLogKey(address common.Address, loc []byte) []byte {
+	key := append([]byte{KeyPrefixAddressLog}, address.Bytes()...)
+	return append(key, loc...)
+}
+
+// TopicLogKey returns the key for the topic index entry of a log, at the position of the topic
+func TopicLogKey(pos uint8, topic common.Hash, loc []byte) []byte {
+	key := append([]byte{KeyPrefixTopicLog, pos}, topic.Bytes()...)
+	return append(key, loc...)
+}
+
+// LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
+func LoadLastBlock(db dbm.DB) (int64, error) {
+	it, err := db.ReverseIterator([]byte{KeyPrefixBlock}, []byte{KeyPrefixBlock + 1})
+	if err != nil {
+		return 0, errors.Wrap(err, "LoadLastBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseBlockNumberFromKey(it.Key())
+}
+
+// LoadFirstBlock loads the first indexed block, returns -1 if db is empty
+func LoadFirstBlock(db dbm.DB) (int64, error) {
+	it, err := db.Iterator([]byte{KeyPrefixBlock}, []byte{KeyPrefixBlock + 1})
+	if err != nil {
+		return 0, errors.Wrap(err, "LoadFirstBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseBlockNumberFromKey(it.Key())
+}
+
+// parseBlockNumberFromKey parses the block number from a block key
+func parseBlockNumberFromKey(key []byte) (int64, error) {
+	if len(key) != 9 {
+		return 0, fmt.Errorf("wrong block key length, expect: %d, got: %d", 9, len(key))
+	}
+
+	return int64(sdk.BigEndianToUint64(key[1:])), nil
+}
+
+// saveTxResult index the txResult into the kv db batch
+func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResult *ethermint.TxResult) error {
+	bz, err := codec.Marshal(txResult)
+	if err != nil {
+		return errors.Wrap(err, "marshal tx result")
+	}
+	if err := batch.Set(TxHashKey(txHash), bz); err != nil {
+		return errors.Wrap(err, "set tx-hash key")
+	}
+	if err := batch.Set(TxIndexKey(txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+		return errors.Wrap(err, "set tx-index key")
+	}
+	return nil
+}
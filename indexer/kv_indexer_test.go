@@ -0,0 +1,180 @@
+package indexer_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tharsis/ethermint/app"
+	"github.com/tharsis/ethermint/encoding"
+	"github.com/tharsis/ethermint/indexer"
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func TestKVIndexer(t *testing.T) {
+	from, privKey := tests.NewAddrKey()
+	signer := tests.NewSigner(privKey)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	newTx := func(nonce uint64) (*evmtypes.MsgEthereumTx, []byte) {
+		to := tests.GenerateAddress()
+		msg := evmtypes.NewTx(nil, nonce, &to, nil, 100000, nil, nil, nil, nil, nil)
+		msg.From = from.Hex()
+		require.NoError(t, msg.Sign(ethSigner, signer))
+
+		tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+		require.NoError(t, err)
+		bz, err := clientCtx.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return msg, bz
+	}
+
+	ethTxEvent := func(msg *evmtypes.MsgEthereumTx, txIndex int, gasUsed int, failed bool) abci.Event {
+		event := abci.Event{
+			Type: evmtypes.EventTypeEthereumTx,
+			Attributes: []abci.EventAttribute{
+				{Key: evmtypes.AttributeKeyEthereumTxHash, Value: msg.Hash},
+				{Key: evmtypes.AttributeKeyTxIndex, Value: strconv.Itoa(txIndex)},
+				{Key: evmtypes.AttributeKeyTxGasUsed, Value: strconv.Itoa(gasUsed)},
+			},
+		}
+		if failed {
+			event.Attributes = append(event.Attributes, abci.EventAttribute{Key: evmtypes.AttributeKeyEthereumTxFailed, Value: "reverted"})
+		}
+		return event
+	}
+
+	contract := tests.GenerateAddress()
+	registry := tests.GenerateAddress()
+	topicA, topicB := common.BytesToHash([]byte("a")), common.BytesToHash([]byte("b"))
+
+	logsEvent := func(logs ...*ethtypes.Log) abci.Event {
+		event := abci.Event{Type: evmtypes.EventTypeTxLog}
+		for _, log := range logs {
+			bz, err := json.Marshal(evmtypes.NewLogFromEth(log))
+			require.NoError(t, err)
+			event.Attributes = append(event.Attributes, abci.EventAttribute{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)})
+		}
+		return event
+	}
+
+	height := int64(10)
+	msg0, tx0 := newTx(0)
+	msg1, tx1 := newTx(1)
+	msg2, tx2 := newTx(2)
+
+	log0 := &ethtypes.Log{Address: contract, Topics: []common.Hash{topicA}, BlockNumber: uint64(height), TxHash: common.HexToHash(msg0.Hash)}
+	log1 := &ethtypes.Log{Address: contract, Topics: []common.Hash{topicB, topicA}, BlockNumber: uint64(height), TxHash: common.HexToHash(msg0.Hash), Index: 1}
+	log2 := &ethtypes.Log{Address: registry, Topics: []common.Hash{topicA}, BlockNumber: uint64(height), Index: 2}
+
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{tx0, tx1, tx2}}}
+	txResults := []*abci.ResponseDeliverTx{
+		{Code: 0, GasUsed: 21000, Events: []abci.Event{ethTxEvent(msg0, 0, 21000, false), logsEvent(log0, log1)}},
+		// failed in the ante handler
+		{Code: 11, GasUsed: 1000},
+		{Code: 0, GasUsed: 30000, Events: []abci.Event{ethTxEvent(msg2, 1, 30000, true)}},
+	}
+	endBlockEvents := []abci.Event{logsEvent(log2)}
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+
+	require.NoError(t, idxer.IndexBlock(block, txResults, endBlockEvents))
+
+	first, err = idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, height, first)
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, height, last)
+
+	// tx results
+	res0, err := idxer.GetByTxHash(common.HexToHash(msg0.Hash))
+	require.NoError(t, err)
+	require.Equal(t, height, res0.Height)
+	require.Equal(t, uint32(0), res0.TxIndex)
+	require.Equal(t, int32(0), res0.EthTxIndex)
+	require.False(t, res0.Failed)
+	require.Equal(t, uint64(21000), res0.GasUsed)
+	require.Equal(t, uint64(21000), res0.CumulativeGasUsed)
+
+	_, err = idxer.GetByTxHash(common.HexToHash(msg1.Hash))
+	require.Error(t, err)
+
+	res2, err := idxer.GetByBlockAndIndex(height, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), res2.TxIndex)
+	require.Equal(t, int32(1), res2.EthTxIndex)
+	require.True(t, res2.Failed)
+	require.Equal(t, uint64(30000), res2.GasUsed)
+	require.Equal(t, uint64(52000), res2.CumulativeGasUsed)
+
+	_, err = idxer.GetByBlockAndIndex(height, 2)
+	require.Error(t, err)
+
+	// block logs
+	blockLogs, err := idxer.GetLogsByHeight(height)
+	require.NoError(t, err)
+	require.Equal(t, [][]*ethtypes.Log{{log0, log1}, {log2}}, blockLogs)
+
+	_, err = idxer.GetLogsByHeight(height + 1)
+	require.Error(t, err)
+
+	// filtered logs
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		limit     int
+		expLogs   []*ethtypes.Log
+		expErr    bool
+	}{
+		{"all logs", height, height, nil, nil, 10, []*ethtypes.Log{log0, log1, log2}, false},
+		{"by address", height, height, []common.Address{contract}, nil, 10, []*ethtypes.Log{log0, log1}, false},
+		{"by addresses", height, height, []common.Address{registry, contract}, nil, 10, []*ethtypes.Log{log0, log1, log2}, false},
+		{"by first topic", height, height, nil, [][]common.Hash{{topicA}}, 10, []*ethtypes.Log{log0, log2}, false},
+		{"by second topic", height, height, nil, [][]common.Hash{{}, {topicA}}, 10, []*ethtypes.Log{log1}, false},
+		{"by topics", height, height, nil, [][]common.Hash{{topicA, topicB}}, 10, []*ethtypes.Log{log0, log1, log2}, false},
+		{"by address and topic", height, height, []common.Address{contract}, [][]common.Hash{{topicA}}, 10, []*ethtypes.Log{log0}, false},
+		{"out of range", height + 1, height + 10, nil, nil, 10, []*ethtypes.Log{}, false},
+		{"empty range", height, height - 1, nil, nil, 10, []*ethtypes.Log{}, false},
+		{"logs cap", 0, height, nil, nil, 2, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := idxer.FilterLogs(tc.from, tc.to, tc.addresses, tc.topics, tc.limit)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expLogs, logs)
+		})
+	}
+}
+
+func TestLogLocation(t *testing.T) {
+	// the locations are ordered by height, group and position
+	require.Less(t, string(indexer.LogLocation(1, 5, 5)), string(indexer.LogLocation(2, 0, 0)))
+	require.Less(t, string(indexer.LogLocation(2, 0, 5)), string(indexer.LogLocation(2, 1, 0)))
+	require.Less(t, string(indexer.LogLocation(2, 1, 0)), string(indexer.LogLocation(2, 1, 1)))
+	require.Len(t, indexer.LogLocation(1, 0, 0), 16)
+}
@@ -17,9 +17,11 @@ import (
 	"github.com/tharsis/ethermint/rpc/ethereum/namespaces/miner"
 	"github.com/tharsis/ethermint/rpc/ethereum/namespaces/net"
 	"github.com/tharsis/ethermint/rpc/ethereum/namespaces/personal"
+	"github.com/tharsis/ethermint/rpc/ethereum/namespaces/trace"
 	"github.com/tharsis/ethermint/rpc/ethereum/namespaces/txpool"
 	"github.com/tharsis/ethermint/rpc/ethereum/namespaces/web3"
 	"github.com/tharsis/ethermint/rpc/ethereum/types"
+	ethermint "github.com/tharsis/ethermint/types"
 
 	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 )
@@ -33,21 +35,22 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
 
 	apiVersion = "1.0"
 )
 
 // APICreator creates the json-rpc api implementations.
-type APICreator = func(*server.Context, client.Context, *rpcclient.WSClient) []rpc.API
+type APICreator = func(*server.Context, client.Context, *rpcclient.WSClient, ethermint.EVMTxIndexer) []rpc.API
 
 // apiCreators defines the json-rpc api namespaces.
 var apiCreators map[string]APICreator
 
 func init() {
 	apiCreators = map[string]APICreator{
-		EthNamespace: func(ctx *server.Context, clientCtx client.Context, tmWSClient *rpcclient.WSClient) []rpc.API {
+		EthNamespace: func(ctx *server.Context, clientCtx client.Context, tmWSClient *rpcclient.WSClient, indexer ethermint.EVMTxIndexer) []rpc.API {
 			nonceLock := new(types.AddrLocker)
-			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx)
+			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx, indexer)
 			return []rpc.API{
 				{
 					Namespace: EthNamespace,
@@ -63,7 +66,7 @@ func init() {
 				},
 			}
 		},
-		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient) []rpc.API {
+		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, ethermint.EVMTxIndexer) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: Web3Namespace,
@@ -73,7 +76,7 @@ func init() {
 				},
 			}
 		},
-		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient) []rpc.API {
+		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ ethermint.EVMTxIndexer) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NetNamespace,
@@ -83,8 +86,8 @@ func init() {
 				},
 			}
 		},
-		PersonalNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient) []rpc.API {
-			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx)
+		PersonalNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, indexer ethermint.EVMTxIndexer) []rpc.API {
+			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx, indexer)
 			return []rpc.API{
 				{
 					Namespace: PersonalNamespace,
@@ -94,18 +97,19 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient) []rpc.API {
+		TxPoolNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, indexer ethermint.EVMTxIndexer) []rpc.API {
+			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx, indexer)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
 					Version:   apiVersion,
-					Service:   txpool.NewPublicAPI(ctx.Logger),
+					Service:   txpool.NewPublicAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
 		},
-		DebugNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient) []rpc.API {
-			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx)
+		DebugNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, indexer ethermint.EVMTxIndexer) []rpc.API {
+			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx, indexer)
 			return []rpc.API{
 				{
 					Namespace: DebugNamespace,
@@ -115,8 +119,8 @@ func init() {
 				},
 			}
 		},
-		MinerNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient) []rpc.API {
-			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx)
+		MinerNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, indexer ethermint.EVMTxIndexer) []rpc.API {
+			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx, indexer)
 			return []rpc.API{
 				{
 					Namespace: MinerNamespace,
@@ -126,6 +130,17 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, indexer ethermint.EVMTxIndexer) []rpc.API {
+			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx, indexer)
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx, evmBackend, clientCtx),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
@@ -141,13 +156,16 @@ func unique(intSlice []string) []string {
 	return list
 }
 
-// GetRPCAPIs returns the list of all APIs
-func GetRPCAPIs(ctx *server.Context, clientCtx client.Context, tmWSClient *rpcclient.WSClient, selectedAPIs []string) []rpc.API {
+// GetRPCAPIs returns the list of all APIs, the indexer is nil if the custom EVM indexer is disabled.
+func GetRPCAPIs(
+	ctx *server.Context, clientCtx client.Context, tmWSClient *rpcclient.WSClient,
+	indexer ethermint.EVMTxIndexer, selectedAPIs []string,
+) []rpc.API {
 	var apis []rpc.API
 
 	for _, ns := range selectedAPIs {
 		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, tmWSClient)...)
+			apis = append(apis, creator(ctx, clientCtx, tmWSClient, indexer)...)
 		} else {
 			ctx.Logger.Error("invalid namespace value", "namespace", ns)
 		}
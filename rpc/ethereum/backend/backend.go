@@ -2,7 +2,6 @@ package backend
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -48,6 +47,7 @@ type Backend interface {
 	RPCGasCap() uint64            // global gas cap for eth_call over rpc: DoS protection
 	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
 	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
+	RPCTraceBlockRangeCap() int32 // max block range of trace_filter: DoS protection
 
 	RPCMinGasPrice() int64
 	SuggestGasTipCap(baseFee *big.Int) (*big.Int, error)
@@ -64,19 +64,23 @@ type Backend interface {
 	HeaderByNumber(blockNum types.BlockNumber) (*ethtypes.Header, error)
 	HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
 	PendingTransactions() ([]*sdk.Tx, error)
+	PendingTransactionsCount() (int, error)
 	GetTransactionCount(address common.Address, blockNum types.BlockNumber) (*hexutil.Uint64, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
 	GetCoinbase() (sdk.AccAddress, error)
 	GetTransactionByHash(txHash common.Hash) (*types.RPCTransaction, error)
 	GetTxByEthHash(txHash common.Hash) (*tmrpctypes.ResultTx, error)
 	GetTxByTxIndex(height int64, txIndex uint) (*tmrpctypes.ResultTx, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *types.BlockNumber) (hexutil.Uint64, error)
+	GetBlockReceipts(blockNum types.BlockNumber) ([]*evmtypes.Receipt, error)
+	GetTransactionReceipt(hash common.Hash) (*evmtypes.Receipt, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *types.BlockNumber, overrides *evmtypes.StateOverride, blockOverrides *evmtypes.BlockOverrides) (hexutil.Uint64, error)
 	BaseFee(height int64) (*big.Int, error)
 
 	// Filter API
 	BloomStatus() (uint64, uint64)
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	FilterIndexedLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, bool, error)
 	ChainConfig() *params.ChainConfig
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	GetEthereumMsgsFromTendermintBlock(block *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
@@ -86,6 +90,9 @@ var _ Backend = (*EVMBackend)(nil)
 
 var bAttributeKeyEthereumBloom = []byte(evmtypes.AttributeKeyEthereumBloom)
 
+// maxUnconfirmedTxs is the maximum number of transactions returned by the Tendermint mempool.
+const maxUnconfirmedTxs = 100
+
 // EVMBackend implements the Backend interface
 type EVMBackend struct {
 	ctx         context.Context
@@ -94,10 +101,11 @@ type EVMBackend struct {
 	logger      log.Logger
 	chainID     *big.Int
 	cfg         config.Config
+	indexer     ethermint.EVMTxIndexer
 }
 
-// NewEVMBackend creates a new EVMBackend instance
-func NewEVMBackend(ctx *server.Context, logger log.Logger, clientCtx client.Context) *EVMBackend {
+// NewEVMBackend creates a new EVMBackend instance, the indexer is nil if the custom EVM indexer is disabled.
+func NewEVMBackend(ctx *server.Context, logger log.Logger, clientCtx client.Context, indexer ethermint.EVMTxIndexer) *EVMBackend {
 	chainID, err := ethermint.ParseChainID(clientCtx.ChainID)
 	if err != nil {
 		panic(err)
@@ -112,6 +120,7 @@ func NewEVMBackend(ctx *server.Context, logger log.Logger, clientCtx client.Cont
 		logger:      logger.With("module", "evm-backend"),
 		chainID:     chainID,
 		cfg:         appConf,
+		indexer:     indexer,
 	}
 }
 
@@ -535,8 +544,10 @@ func (e *EVMBackend) HeaderByHash(blockHash common.Hash) (*ethtypes.Header, erro
 
 // PendingTransactions returns the transactions that are in the transaction pool
 // and have a from address that is one of the accounts this node manages.
+// It returns at most maxUnconfirmedTxs transactions.
 func (e *EVMBackend) PendingTransactions() ([]*sdk.Tx, error) {
-	res, err := e.clientCtx.Client.UnconfirmedTxs(e.ctx, nil)
+	limit := maxUnconfirmedTxs
+	res, err := e.clientCtx.Client.UnconfirmedTxs(e.ctx, &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -553,8 +564,28 @@ func (e *EVMBackend) PendingTransactions() ([]*sdk.Tx, error) {
 	return result, nil
 }
 
-// GetLogsByHeight returns all the logs from all the ethereum transactions in a block.
+// PendingTransactionsCount returns the number of transactions in the transaction pool.
+// Unlike PendingTransactions, it isn't limited to maxUnconfirmedTxs.
+func (e *EVMBackend) PendingTransactionsCount() (int, error) {
+	res, err := e.clientCtx.Client.NumUnconfirmedTxs(e.ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.Total, nil
+}
+
+// GetLogsByHeight returns all the logs from all the ethereum transactions in a block, and the logs
+// emitted by the modules at the end of the block.
 func (e *EVMBackend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	if e.indexer != nil && height != nil {
+		logs, err := e.indexer.GetLogsByHeight(*height)
+		if err == nil {
+			return logs, nil
+		}
+		e.logger.Debug("logs not indexed", "height", *height, "error", err.Error())
+	}
+
 	// NOTE: we query the state in case the tx result logs are not persisted after an upgrade.
 	blockRes, err := e.clientCtx.Client.BlockResults(e.ctx, height)
 	if err != nil {
@@ -563,7 +594,7 @@ func (e *EVMBackend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 
 	blockLogs := [][]*ethtypes.Log{}
 	for _, txResult := range blockRes.TxsResults {
-		logs, err := AllTxLogsFromEvents(txResult.Events)
+		logs, err := types.AllTxLogsFromEvents(txResult.Events)
 		if err != nil {
 			return nil, err
 		}
@@ -571,7 +602,39 @@ func (e *EVMBackend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 		blockLogs = append(blockLogs, logs...)
 	}
 
-	return blockLogs, nil
+	// the logs emitted by the modules at the end of the block, e.g. the registry logs
+	logs, err := types.AllTxLogsFromEvents(blockRes.EndBlockEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(blockLogs, logs...), nil
+}
+
+// FilterIndexedLogs returns the logs of the [from, to] blocks range matching the addresses and topics from
+// the custom EVM indexer. The returned bool is false if the indexer is disabled or if it hasn't indexed
+// the whole range, in which case the logs are to be filtered from the blocks.
+func (e *EVMBackend) FilterIndexedLogs(
+	from, to int64, addresses []common.Address, topics [][]common.Hash, limit int,
+) ([]*ethtypes.Log, bool, error) {
+	if e.indexer == nil {
+		return nil, false, nil
+	}
+
+	first, err := e.indexer.FirstIndexedBlock()
+	if err != nil {
+		return nil, false, err
+	}
+	last, err := e.indexer.LastIndexedBlock()
+	if err != nil {
+		return nil, false, err
+	}
+	if first < 0 || from < first || to > last {
+		return nil, false, nil
+	}
+
+	logs, err := e.indexer.FilterLogs(from, to, addresses, topics, limit)
+	return logs, true, err
 }
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -740,6 +803,14 @@ func (e *EVMBackend) GetTransactionByHash(txHash common.Hash) (*types.RPCTransac
 // TODO: Don't need to convert once hashing is fixed on Tendermint
 // https://github.com/tendermint/tendermint/issues/6539
 func (e *EVMBackend) GetTxByEthHash(hash common.Hash) (*tmrpctypes.ResultTx, error) {
+	if e.indexer != nil {
+		txResult, err := e.indexer.GetByTxHash(hash)
+		if err == nil {
+			return e.queryTendermintTx(txResult)
+		}
+		e.logger.Debug("tx not indexed", "hash", hash.Hex(), "error", err.Error())
+	}
+
 	query := fmt.Sprintf("%s.%s='%s'", evmtypes.TypeMsgEthereumTx, evmtypes.AttributeKeyEthereumTxHash, hash.Hex())
 	resTxs, err := e.clientCtx.Client.TxSearch(e.ctx, query, false, nil, nil, "")
 	if err != nil {
@@ -753,6 +824,14 @@ func (e *EVMBackend) GetTxByEthHash(hash common.Hash) (*tmrpctypes.ResultTx, err
 
 // GetTxByTxIndex uses `/tx_query` to find transaction by tx index of valid ethereum txs
 func (e *EVMBackend) GetTxByTxIndex(height int64, index uint) (*tmrpctypes.ResultTx, error) {
+	if e.indexer != nil {
+		txResult, err := e.indexer.GetByBlockAndIndex(height, int32(index))
+		if err == nil {
+			return e.queryTendermintTx(txResult)
+		}
+		e.logger.Debug("tx not indexed", "height", height, "index", index, "error", err.Error())
+	}
+
 	query := fmt.Sprintf("tx.height=%d AND %s.%s=%d",
 		height, evmtypes.TypeMsgEthereumTx,
 		evmtypes.AttributeKeyTxIndex, index,
@@ -767,6 +846,32 @@ func (e *EVMBackend) GetTxByTxIndex(height int64, index uint) (*tmrpctypes.Resul
 	return resTxs.Txs[0], nil
 }
 
+// queryTendermintTx returns the Tendermint tx of an indexed eth tx, from the block and the block results.
+func (e *EVMBackend) queryTendermintTx(txResult *ethermint.TxResult) (*tmrpctypes.ResultTx, error) {
+	block, err := e.clientCtx.Client.Block(e.ctx, &txResult.Height)
+	if err != nil {
+		return nil, err
+	}
+	blockRes, err := e.clientCtx.Client.BlockResults(e.ctx, &txResult.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	index := int(txResult.TxIndex)
+	if index >= len(block.Block.Txs) || index >= len(blockRes.TxsResults) {
+		return nil, errors.Errorf("tx index %d out of bound in block %d", index, txResult.Height)
+	}
+
+	tx := block.Block.Txs[index]
+	return &tmrpctypes.ResultTx{
+		Hash:     tx.Hash(),
+		Height:   txResult.Height,
+		Index:    txResult.TxIndex,
+		TxResult: *blockRes.TxsResults[index],
+		Tx:       tx,
+	}, nil
+}
+
 func (e *EVMBackend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
 	// Look up the wallet containing the requested signer
 	_, err := e.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.From.Bytes()))
@@ -807,8 +912,13 @@ func (e *EVMBackend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash
 		return common.Hash{}, err
 	}
 
+	var feePayer sdk.AccAddress
+	if args.FeePayer != nil {
+		feePayer = args.FeePayer.Bytes()
+	}
+
 	// Assemble transaction from fields
-	tx, err := msg.BuildTx(e.clientCtx.TxConfig.NewTxBuilder(), res.Params.EvmDenom)
+	tx, err := msg.BuildTxWithFeePayer(e.clientCtx.TxConfig.NewTxBuilder(), res.Params.EvmDenom, feePayer)
 	if err != nil {
 		e.logger.Error("build cosmos tx failed", "error", err.Error())
 		return common.Hash{}, err
@@ -841,26 +951,24 @@ func (e *EVMBackend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (e *EVMBackend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *types.BlockNumber) (hexutil.Uint64, error) {
+func (e *EVMBackend) EstimateGas(
+	args evmtypes.TransactionArgs, blockNrOptional *types.BlockNumber,
+	overrides *evmtypes.StateOverride, blockOverrides *evmtypes.BlockOverrides,
+) (hexutil.Uint64, error) {
 	blockNr := types.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
 	}
 
-	bz, err := json.Marshal(&args)
+	req, err := NewEthCallRequest(args, e.RPCGasCap(), overrides, blockOverrides)
 	if err != nil {
 		return 0, err
 	}
 
-	req := evmtypes.EthCallRequest{
-		Args:   bz,
-		GasCap: e.RPCGasCap(),
-	}
-
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
-	res, err := e.queryClient.EstimateGas(types.ContextWithHeight(blockNr.Int64()), &req)
+	res, err := e.queryClient.EstimateGas(types.ContextWithHeight(blockNr.Int64()), req)
 	if err != nil {
 		return 0, err
 	}
@@ -925,6 +1033,11 @@ func (e *EVMBackend) RPCBlockRangeCap() int32 {
 	return e.cfg.JSONRPC.BlockRangeCap
 }
 
+// RPCTraceBlockRangeCap defines the max block range allowed for `trace_filter` query.
+func (e *EVMBackend) RPCTraceBlockRangeCap() int32 {
+	return e.cfg.JSONRPC.TraceBlockRangeCap
+}
+
 // RPCMinGasPrice returns the minimum gas price for a transaction obtained from
 // the node config. If set value is 0, it will default to 20.
 
@@ -0,0 +1,61 @@
+package backend
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/ethermint/rpc/ethereum/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// GetBlockReceipts returns the receipts of all the ethereum transactions in a block, built from a single
+// query of the block results.
+func (e *EVMBackend) GetBlockReceipts(blockNum types.BlockNumber) ([]*evmtypes.Receipt, error) {
+	resBlock, err := e.GetTendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block not found for height %d", blockNum)
+	}
+
+	blockRes, err := e.clientCtx.Client.BlockResults(e.ctx, &resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, err := e.BaseFee(resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.BlockReceipts(e.clientCtx, resBlock, blockRes, e.chainID, baseFee)
+}
+
+// GetTransactionReceipt returns the receipt of an ethereum transaction, the one returned with the receipts of its
+// block. It returns nil if the transaction isn't found.
+func (e *EVMBackend) GetTransactionReceipt(hash common.Hash) (*evmtypes.Receipt, error) {
+	res, err := e.GetTxByEthHash(hash)
+	if err != nil {
+		e.logger.Debug("tx not found", "hash", hash.Hex(), "error", err.Error())
+		return nil, nil
+	}
+
+	resBlock, err := e.clientCtx.Client.Block(e.ctx, &res.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	blockRes, err := e.clientCtx.Client.BlockResults(e.ctx, &res.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, err := e.BaseFee(res.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.TxReceipt(e.clientCtx, resBlock, blockRes, res.Index, hash, e.chainID, baseFee)
+}
@@ -0,0 +1,267 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/tharsis/ethermint/encoding"
+	"github.com/tharsis/ethermint/rpc/ethereum/types"
+	"github.com/tharsis/ethermint/tests"
+	"github.com/tharsis/ethermint/x/evm"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
+)
+
+// mockClient is a Tendermint client serving a single block.
+type mockClient struct {
+	tmrpcclient.Client
+
+	block    *tmrpctypes.ResultBlock
+	blockRes *tmrpctypes.ResultBlockResults
+}
+
+func (c mockClient) Block(_ context.Context, _ *int64) (*tmrpctypes.ResultBlock, error) {
+	return c.block, nil
+}
+
+func (c mockClient) BlockResults(_ context.Context, _ *int64) (*tmrpctypes.ResultBlockResults, error) {
+	return c.blockRes, nil
+}
+
+// TxSearch returns the txs with an ethereum tx event of the hash of the query.
+func (c mockClient) TxSearch(_ context.Context, query string, _ bool, _, _ *int, _ string) (*tmrpctypes.ResultTxSearch, error) {
+	res := &tmrpctypes.ResultTxSearch{}
+	for i, txResult := range c.blockRes.TxsResults {
+		for _, event := range txResult.Events {
+			hash := types.FindAttribute(event.Attributes, []byte(evmtypes.AttributeKeyEthereumTxHash))
+			if event.Type == evmtypes.EventTypeEthereumTx && strings.Contains(query, string(hash)) {
+				res.Txs = append(res.Txs, &tmrpctypes.ResultTx{
+					Height:   c.block.Block.Height,
+					Index:    uint32(i),
+					TxResult: *txResult,
+					Tx:       c.block.Block.Txs[i],
+				})
+			}
+		}
+	}
+	res.TotalCount = len(res.Txs)
+	return res, nil
+}
+
+type mockEVMQueryClient struct {
+	evmtypes.QueryClient
+}
+
+// Params returns the default params, at the height of the test block.
+func (mockEVMQueryClient) Params(_ context.Context, _ *evmtypes.QueryParamsRequest, opts ...grpc.CallOption) (*evmtypes.QueryParamsResponse, error) {
+	for _, opt := range opts {
+		if header, ok := opt.(grpc.HeaderCallOption); ok {
+			*header.HeaderAddr = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, "10")
+		}
+	}
+	return &evmtypes.QueryParamsResponse{Params: evmtypes.DefaultParams()}, nil
+}
+
+type mockFeeMarketQueryClient struct {
+	feemarkettypes.QueryClient
+}
+
+func (mockFeeMarketQueryClient) Params(context.Context, *feemarkettypes.QueryParamsRequest, ...grpc.CallOption) (*feemarkettypes.QueryParamsResponse, error) {
+	return &feemarkettypes.QueryParamsResponse{Params: feemarkettypes.DefaultParams()}, nil
+}
+
+func TestBlockReceipts(t *testing.T) {
+	chainID := big.NewInt(9000)
+	from, privKey := tests.NewAddrKey()
+	signer := tests.NewSigner(privKey)
+	ethSigner := ethtypes.LatestSignerForChainID(chainID)
+
+	encodingConfig := encoding.MakeConfig(module.NewBasicManager(evm.AppModuleBasic{}))
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	to := tests.GenerateAddress()
+	newMsg := func(nonce uint64, to *common.Address, dynamic bool) *evmtypes.MsgEthereumTx {
+		var msg *evmtypes.MsgEthereumTx
+		if dynamic {
+			msg = evmtypes.NewTx(chainID, nonce, to, nil, 100000, nil, big.NewInt(2000), big.NewInt(10), nil, &ethtypes.AccessList{})
+		} else {
+			msg = evmtypes.NewTx(chainID, nonce, to, nil, 100000, big.NewInt(1500), nil, nil, nil, nil)
+		}
+		msg.From = from.Hex()
+		require.NoError(t, msg.Sign(ethSigner, signer))
+		return msg
+	}
+	encodeTx := func(msgs ...sdk.Msg) []byte {
+		builder := clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		bz, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	ethTxEvent := func(msg *evmtypes.MsgEthereumTx, txIndex int, gasUsed int, failed bool) abci.Event {
+		event := abci.Event{
+			Type: evmtypes.EventTypeEthereumTx,
+			Attributes: []abci.EventAttribute{
+				{Key: evmtypes.AttributeKeyEthereumTxHash, Value: msg.Hash},
+				{Key: evmtypes.AttributeKeyTxGasUsed, Value: strconv.Itoa(gasUsed)},
+			},
+		}
+		if txIndex >= 0 {
+			event.Attributes = append(event.Attributes, abci.EventAttribute{Key: evmtypes.AttributeKeyTxIndex, Value: strconv.Itoa(txIndex)})
+		}
+		if failed {
+			event.Attributes = append(event.Attributes, abci.EventAttribute{Key: evmtypes.AttributeKeyEthereumTxFailed, Value: "reverted"})
+		}
+		return event
+	}
+	logsEvent := func(logs ...*ethtypes.Log) abci.Event {
+		event := abci.Event{Type: evmtypes.EventTypeTxLog}
+		for _, log := range logs {
+			bz, err := json.Marshal(evmtypes.NewLogFromEth(log))
+			require.NoError(t, err)
+			event.Attributes = append(event.Attributes, abci.EventAttribute{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)})
+		}
+		return event
+	}
+
+	height := int64(10)
+	msgA := newMsg(0, &to, false)
+	msgB := newMsg(1, nil, true)
+	msgC := newMsg(2, &to, false)
+	msgD := newMsg(3, &to, true)
+
+	logA := &ethtypes.Log{Address: to, Topics: []common.Hash{common.BytesToHash([]byte("a"))}, BlockNumber: uint64(height), TxHash: common.HexToHash(msgA.Hash)}
+
+	block := &tmtypes.Block{
+		Header: tmtypes.Header{ChainID: "ethermint_9000-1", Height: height, ValidatorsHash: common.Hash{1}.Bytes()},
+		Data: tmtypes.Data{Txs: []tmtypes.Tx{
+			encodeTx(msgA, msgB),
+			encodeTx(newMsg(4, &to, false)),
+			encodeTx(msgC),
+			encodeTx(msgD),
+		}},
+	}
+	blockRes := &tmrpctypes.ResultBlockResults{
+		Height: height,
+		TxsResults: []*abci.ResponseDeliverTx{
+			{GasUsed: 80000, Events: []abci.Event{
+				ethTxEvent(msgA, 0, 30000, false), logsEvent(logA),
+				ethTxEvent(msgB, 1, 50000, true), logsEvent(),
+			}},
+			// failed in the ante handler
+			{Code: 11, GasUsed: 1000},
+			// no result
+			{GasUsed: 5000},
+			// no tx index
+			{GasUsed: 21000, Events: []abci.Event{ethTxEvent(msgD, -1, 21000, false), logsEvent()}},
+		},
+		BeginBlockEvents: []abci.Event{{
+			Type:       feemarkettypes.EventTypeFeeMarket,
+			Attributes: []abci.EventAttribute{{Key: feemarkettypes.AttributeKeyBaseFee, Value: "1000"}},
+		}},
+	}
+	resBlock := &tmrpctypes.ResultBlock{Block: block}
+	clientCtx = clientCtx.WithClient(mockClient{block: resBlock, blockRes: blockRes})
+
+	receipts, err := types.BlockReceipts(clientCtx, resBlock, blockRes, chainID, big.NewInt(1000))
+	require.NoError(t, err)
+	require.Len(t, receipts, 3)
+
+	blockHash := common.BytesToHash(block.Header.Hash()).Hex()
+	require.Equal(t, &evmtypes.Receipt{
+		Status:            ethtypes.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 30000,
+		LogsBloom:         ethtypes.LogsBloom([]*ethtypes.Log{logA}),
+		Logs:              evmtypes.NewLogsFromEth([]*ethtypes.Log{logA}),
+		TxHash:            msgA.Hash,
+		GasUsed:           30000,
+		Type:              ethtypes.LegacyTxType,
+		BlockHash:         blockHash,
+		BlockNumber:       uint64(height),
+		TxIndex:           0,
+		From:              from.Hex(),
+		To:                to.Hex(),
+	}, receipts[0])
+	require.Equal(t, &evmtypes.Receipt{
+		Status:            ethtypes.ReceiptStatusFailed,
+		CumulativeGasUsed: 80000,
+		LogsBloom:         ethtypes.LogsBloom(nil),
+		Logs:              evmtypes.NewLogsFromEth([]*ethtypes.Log{}),
+		TxHash:            msgB.Hash,
+		GasUsed:           50000,
+		Type:              ethtypes.DynamicFeeTxType,
+		BlockHash:         blockHash,
+		BlockNumber:       uint64(height),
+		TxIndex:           1,
+		From:              from.Hex(),
+		ContractAddress:   crypto.CreateAddress(from, 1).Hex(),
+		EffectiveGasPrice: "1010",
+	}, receipts[1])
+
+	// the txs without index are indexed by their position in the block, the txs without result included
+	require.Equal(t, msgD.Hash, receipts[2].TxHash)
+	require.Equal(t, uint64(3), receipts[2].TxIndex)
+	require.Equal(t, uint64(80000+1000+5000+21000), receipts[2].CumulativeGasUsed)
+	require.Equal(t, uint64(21000), receipts[2].GasUsed)
+	require.Equal(t, "1010", receipts[2].EffectiveGasPrice)
+
+	// the receipts of the txs are the receipts of the block
+	backend := &EVMBackend{
+		ctx:       context.Background(),
+		clientCtx: clientCtx,
+		queryClient: &types.QueryClient{
+			QueryClient: mockEVMQueryClient{},
+			FeeMarket:   mockFeeMarketQueryClient{},
+		},
+		logger:  log.NewNopLogger(),
+		chainID: chainID,
+	}
+	for _, receipt := range receipts {
+		txReceipt, err := backend.GetTransactionReceipt(common.HexToHash(receipt.TxHash))
+		require.NoError(t, err)
+		require.Equal(t, receipt, txReceipt)
+	}
+
+	txReceipt, err := backend.GetTransactionReceipt(common.HexToHash(msgC.Hash))
+	require.NoError(t, err)
+	require.Nil(t, txReceipt)
+
+	blockReceipts, err := backend.GetBlockReceipts(types.BlockNumber(height))
+	require.NoError(t, err)
+	require.Equal(t, receipts, blockReceipts)
+
+	txReceipt, err = types.TxReceipt(clientCtx, resBlock, blockRes, 2, common.HexToHash(msgC.Hash), chainID, nil)
+	require.NoError(t, err)
+	require.Nil(t, txReceipt)
+
+	_, err = types.TxReceipt(clientCtx, resBlock, blockRes, 2, common.HexToHash(msgA.Hash), chainID, nil)
+	require.Error(t, err)
+
+	// the block results are missing a tx result
+	blockRes.TxsResults = blockRes.TxsResults[:3]
+	_, err = types.BlockReceipts(clientCtx, resBlock, blockRes, chainID, nil)
+	require.Error(t, err)
+	_, err = types.TxReceipt(clientCtx, resBlock, blockRes, 3, common.HexToHash(msgD.Hash), chainID, nil)
+	require.Error(t, err)
+}
@@ -2,6 +2,7 @@ package debug
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/davecgh/go-spew/spew"
 	tmrpctypes "github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 
@@ -26,6 +28,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tharsis/ethermint/rpc/ethereum/backend"
@@ -69,7 +73,7 @@ func NewAPI(
 
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
-func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
+func (a *API) TraceTransaction(hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error) {
 	a.logger.Debug("debug_traceTransaction", "hash", hash)
 	// Get transaction by hash
 	transaction, err := a.backend.GetTxByEthHash(hash)
@@ -148,7 +152,7 @@ func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (
 	}
 
 	if config != nil {
-		traceTxRequest.TraceConfig = config
+		traceTxRequest.TraceConfig = config.EVMTraceConfig()
 	}
 
 	// minus one to get the context of block beginning
@@ -175,7 +179,7 @@ func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (
 
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
+func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *rpctypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
 	a.logger.Debug("debug_traceBlockByNumber", "height", height)
 	if height == 0 {
 		return nil, errors.New("genesis is not traceable")
@@ -192,7 +196,7 @@ func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.T
 
 // TraceBlockByHash returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (a *API) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
+func (a *API) TraceBlockByHash(hash common.Hash, config *rpctypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
 	a.logger.Debug("debug_traceBlockByHash", "hash", hash)
 	// Get Tendermint Block
 	resBlock, err := a.backend.GetTendermintBlockByHash(hash)
@@ -209,10 +213,77 @@ func (a *API) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) (
 	return a.traceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
 }
 
+// TraceCall lets you trace a given eth_call. It collects the structured logs created during the execution of
+// EVM if the given transaction was added on top of the provided block and returns them as a JSON object.
+func (a *API) TraceCall(
+	args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args, "block number or hash", blockNrOrHash)
+
+	var (
+		resBlock *tmrpctypes.ResultBlock
+		err      error
+	)
+	switch {
+	case blockNrOrHash.BlockHash != nil:
+		resBlock, err = a.backend.GetTendermintBlockByHash(*blockNrOrHash.BlockHash)
+	case blockNrOrHash.BlockNumber != nil:
+		resBlock, err = a.backend.GetTendermintBlockByNumber(*blockNrOrHash.BlockNumber)
+	default:
+		return nil, errors.New("types BlockHash and BlockNumber cannot be both nil")
+	}
+	if err != nil {
+		a.logger.Debug("get block failed", "block number or hash", blockNrOrHash, "error", err.Error())
+		return nil, err
+	}
+
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, errors.New("block not found")
+	}
+
+	var (
+		traceConfig    *rpctypes.TraceConfig
+		overrides      *evmtypes.StateOverride
+		blockOverrides *evmtypes.BlockOverrides
+	)
+	if config != nil {
+		traceConfig = &config.TraceConfig
+		overrides = config.StateOverrides
+		blockOverrides = config.BlockOverrides
+	}
+
+	call, err := backend.NewEthCallRequest(args, a.backend.RPCGasCap(), overrides, blockOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Call:        call,
+		TraceConfig: traceConfig.EVMTraceConfig(),
+		BlockNumber: resBlock.Block.Height,
+		BlockTime:   resBlock.Block.Time,
+		BlockHash:   common.Bytes2Hex(resBlock.BlockID.Hash),
+	}
+
+	// the call is executed on top of the block
+	traceResult, err := a.queryClient.TraceCall(rpctypes.ContextWithHeight(resBlock.Block.Height), &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
 // traceBlock configures a new tracer according to the provided configuration, and
 // executes all the transactions contained within. The return value will be one item
 // per transaction, dependent on the requested tracer.
-func (a *API) traceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error) {
+func (a *API) traceBlock(height rpctypes.BlockNumber, config *rpctypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error) {
 	txs := block.Block.Txs
 	txsLength := len(txs)
 
@@ -221,6 +292,32 @@ func (a *API) traceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConf
 		return []*evmtypes.TxTraceResult{}, nil
 	}
 
+	txsMessages := a.blockMessages(block.Block.Txs)
+	ctxWithHeight := blockContext(int64(height))
+
+	traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
+		Txs:         txsMessages,
+		TraceConfig: config.EVMTraceConfig(),
+		BlockNumber: block.Block.Height,
+		BlockTime:   block.Block.Time,
+		BlockHash:   common.Bytes2Hex(block.BlockID.Hash),
+	}
+
+	res, err := a.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedResults := make([]*evmtypes.TxTraceResult, txsLength)
+	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
+		return nil, err
+	}
+
+	return decodedResults, nil
+}
+
+// blockMessages returns the Ethereum messages of the given block transactions.
+func (a *API) blockMessages(txs tmtypes.Txs) []*evmtypes.MsgEthereumTx {
 	txDecoder := a.clientCtx.TxConfig.TxDecoder()
 
 	var txsMessages []*evmtypes.MsgEthereumTx
@@ -240,34 +337,18 @@ func (a *API) traceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConf
 			txsMessages = append(txsMessages, ethMessage)
 		}
 	}
+	return txsMessages
+}
 
+// blockContext returns the query context of the state at the beginning of the block.
+func blockContext(height int64) context.Context {
 	// minus one to get the context at the beginning of the block
 	contextHeight := height - 1
 	if contextHeight < 1 {
 		// 0 is a special value for `ContextWithHeight`.
 		contextHeight = 1
 	}
-	ctxWithHeight := rpctypes.ContextWithHeight(int64(contextHeight))
-
-	traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
-		Txs:         txsMessages,
-		TraceConfig: config,
-		BlockNumber: block.Block.Height,
-		BlockTime:   block.Block.Time,
-		BlockHash:   common.Bytes2Hex(block.BlockID.Hash),
-	}
-
-	res, err := a.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
-	if err != nil {
-		return nil, err
-	}
-
-	decodedResults := make([]*evmtypes.TxTraceResult, txsLength)
-	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
-		return nil, err
-	}
-
-	return decodedResults, nil
+	return rpctypes.ContextWithHeight(contextHeight)
 }
 
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
@@ -494,9 +575,179 @@ func (a *API) SeedHash(number uint64) (string, error) {
 	return fmt.Sprintf("0x%x", ethash.SeedHash(number)), nil
 }
 
-// IntermediateRoots executes a block, and returns a list
-// of intermediate roots: the stateroot after each transaction.
-func (a *API) IntermediateRoots(hash common.Hash, _ *evmtypes.TraceConfig) ([]common.Hash, error) {
+// IntermediateRoots executes a block, and returns a list of intermediate roots: the root after each
+// transaction. The roots are commitments to the store writes of the transactions, chained from the block hash,
+// instead of Ethereum state roots.
+func (a *API) IntermediateRoots(hash common.Hash, _ *rpctypes.TraceConfig) ([]common.Hash, error) {
 	a.logger.Debug("debug_intermediateRoots", "hash", hash)
-	return ([]common.Hash)(nil), nil
+
+	resBlock, err := a.backend.GetTendermintBlockByHash(hash)
+	if err != nil {
+		a.logger.Debug("get block failed", "hash", hash.Hex(), "error", err.Error())
+		return nil, err
+	}
+
+	if resBlock == nil || resBlock.Block == nil {
+		a.logger.Debug("block not found", "hash", hash.Hex())
+		return nil, errors.New("block not found")
+	}
+
+	if resBlock.Block.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	res, err := a.queryClient.IntermediateRoots(blockContext(resBlock.Block.Height), &evmtypes.QueryTraceBlockRequest{
+		Txs:         a.blockMessages(resBlock.Block.Txs),
+		BlockNumber: resBlock.Block.Height,
+		BlockTime:   resBlock.Block.Time,
+		BlockHash:   common.Bytes2Hex(resBlock.BlockID.Hash),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]common.Hash, len(res.Roots))
+	for i, root := range res.Roots {
+		roots[i] = common.BytesToHash(root)
+	}
+	return roots, nil
+}
+
+// StorageRangeResult is the result of a debug_storageRangeAt API call.
+type StorageRangeResult struct {
+	Storage storageMap   `json:"storage"`
+	NextKey *common.Hash `json:"nextKey"` // nil if Storage includes the last key in the trie.
+}
+
+type storageMap map[common.Hash]storageEntry
+
+type storageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
+// StorageRangeAt returns the storage of a contract at the given block hash and transaction index, from the
+// given key. The storage is keyed by the hash of the slots, like the go-ethereum result, and the slots are
+// returned in their store order. At most 256 slots are returned, a max result of 0 returns the most slots.
+func (a *API) StorageRangeAt(
+	blockHash common.Hash, txIndex int, contractAddress common.Address, keyStart hexutil.Bytes, maxResult int,
+) (StorageRangeResult, error) {
+	a.logger.Debug("debug_storageRangeAt", "hash", blockHash, "index", txIndex, "address", contractAddress)
+
+	if maxResult < 0 {
+		return StorageRangeResult{}, fmt.Errorf("max result cannot be negative, got %d", maxResult)
+	}
+
+	resBlock, err := a.backend.GetTendermintBlockByHash(blockHash)
+	if err != nil {
+		a.logger.Debug("get block failed", "hash", blockHash.Hex(), "error", err.Error())
+		return StorageRangeResult{}, err
+	}
+
+	if resBlock == nil || resBlock.Block == nil {
+		a.logger.Debug("block not found", "hash", blockHash.Hex())
+		return StorageRangeResult{}, errors.New("block not found")
+	}
+
+	predecessors := a.blockMessages(resBlock.Block.Txs)
+	if txIndex < 0 || txIndex > len(predecessors) {
+		return StorageRangeResult{}, fmt.Errorf("transaction index %d out of range", txIndex)
+	}
+
+	res, err := a.queryClient.StorageRange(blockContext(resBlock.Block.Height), &evmtypes.QueryStorageRangeRequest{
+		Address:      contractAddress.Hex(),
+		KeyStart:     common.BytesToHash(keyStart).Hex(),
+		MaxResult:    uint64(maxResult),
+		Predecessors: predecessors[:txIndex],
+		BlockNumber:  resBlock.Block.Height,
+		BlockTime:    resBlock.Block.Time,
+		BlockHash:    common.Bytes2Hex(resBlock.BlockID.Hash),
+	})
+	if err != nil {
+		return StorageRangeResult{}, err
+	}
+
+	result := StorageRangeResult{Storage: make(storageMap, len(res.Storage))}
+	for _, state := range res.Storage {
+		key := common.HexToHash(state.Key)
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = storageEntry{
+			Key:   &key,
+			Value: common.HexToHash(state.Value),
+		}
+	}
+	if res.NextKey != "" {
+		next := common.HexToHash(res.NextKey)
+		result.NextKey = &next
+	}
+	return result, nil
+}
+
+// AccountRange returns the EVM accounts of the state at the given block, from the given address. At most 256
+// accounts are returned, with at most 256 storage slots each, the rest of a storage is returned by
+// debug_storageRangeAt. The incompletes flag is ignored, the accounts are read from the store and their
+// preimages are always known.
+func (a *API) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash, start hexutil.Bytes, maxResults int, nocode, nostorage, incompletes bool,
+) (state.IteratorDump, error) {
+	a.logger.Debug("debug_accountRange", "block number or hash", blockNrOrHash)
+
+	if maxResults < 0 {
+		return state.IteratorDump{}, fmt.Errorf("max results cannot be negative, got %d", maxResults)
+	}
+
+	var blockNum rpctypes.BlockNumber
+	switch {
+	case blockNrOrHash.BlockHash != nil:
+		header, err := a.backend.HeaderByHash(*blockNrOrHash.BlockHash)
+		if err != nil {
+			return state.IteratorDump{}, err
+		}
+		blockNum = rpctypes.NewBlockNumber(header.Number)
+	case blockNrOrHash.BlockNumber != nil:
+		blockNum = *blockNrOrHash.BlockNumber
+	default:
+		return state.IteratorDump{}, errors.New("types BlockHash and BlockNumber cannot be both nil")
+	}
+	switch {
+	case blockNum == rpctypes.EthEarliestBlockNumber:
+		blockNum = 1
+	case blockNum < rpctypes.EthEarliestBlockNumber:
+		// 0 is a special value for `ContextWithHeight`, the latest block
+		blockNum = 0
+	}
+
+	res, err := a.queryClient.AccountRange(rpctypes.ContextWithHeight(blockNum.Int64()), &evmtypes.QueryAccountRangeRequest{
+		Start:      common.BytesToAddress(start).Hex(),
+		MaxResults: uint64(maxResults),
+		NoCode:     nocode,
+		NoStorage:  nostorage,
+	})
+	if err != nil {
+		return state.IteratorDump{}, err
+	}
+
+	dump := state.IteratorDump{
+		Accounts: make(map[common.Address]state.DumpAccount, len(res.Accounts)),
+	}
+	for _, acc := range res.Accounts {
+		address := common.HexToAddress(acc.Address)
+		account := state.DumpAccount{
+			Balance:   acc.Balance,
+			Nonce:     acc.Nonce,
+			CodeHash:  common.HexToHash(acc.CodeHash).Bytes(),
+			Code:      acc.Code,
+			SecureKey: crypto.Keccak256(address.Bytes()),
+		}
+		if len(acc.Storage) > 0 {
+			account.Storage = make(map[common.Hash]string, len(acc.Storage))
+			for _, s := range acc.Storage {
+				account.Storage[common.HexToHash(s.Key)] = s.Value
+			}
+		}
+		dump.Accounts[address] = account
+	}
+	if res.Next != "" {
+		dump.Next = common.HexToAddress(res.Next).Bytes()
+	}
+	return dump, nil
 }
@@ -2,7 +2,6 @@ package eth
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
@@ -415,7 +414,7 @@ func (e *PublicAPI) GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, err
 		return nil, fmt.Errorf("ethereum tx not found in msgs: %s", hexTx)
 	}
 	// parse tx logs from events
-	return backend.TxLogsFromEvents(res.TxResult.Events, msgIndex)
+	return rpctypes.TxLogsFromEvents(res.TxResult.Events, msgIndex)
 }
 
 // Sign signs the provided data using the private key of address via Geth's signature standard.
@@ -498,9 +497,11 @@ func (e *PublicAPI) FillTransaction(args evmtypes.TransactionArgs) (*rpctypes.Si
 	}, nil
 }
 
-// SendRawTransaction send a raw Ethereum transaction.
-func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
-	e.logger.Debug("eth_sendRawTransaction", "length", len(data))
+// SendRawTransaction send a raw Ethereum transaction. The optional fee payer pays the fees on behalf of the
+// sender, through a fee allowance granted to the sender. It's not signed by the sender, any account which
+// granted an allowance to the sender can be named.
+func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes, feePayer *common.Address) (common.Hash, error) {
+	e.logger.Debug("eth_sendRawTransaction", "length", len(data), "fee payer", feePayer)
 
 	// RLP decode raw transaction bytes
 	tx := &ethtypes.Transaction{}
@@ -527,7 +528,12 @@ func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 		return common.Hash{}, err
 	}
 
-	cosmosTx, err := ethereumTx.BuildTx(e.clientCtx.TxConfig.NewTxBuilder(), res.Params.EvmDenom)
+	var payer sdk.AccAddress
+	if feePayer != nil {
+		payer = feePayer.Bytes()
+	}
+
+	cosmosTx, err := ethereumTx.BuildTxWithFeePayer(e.clientCtx.TxConfig.NewTxBuilder(), res.Params.EvmDenom, payer)
 	if err != nil {
 		e.logger.Error("failed to build cosmos tx", "error", err.Error())
 		return common.Hash{}, err
@@ -641,14 +647,17 @@ func (e *PublicAPI) Resend(ctx context.Context, args evmtypes.TransactionArgs, g
 }
 
 // Call performs a raw contract call.
-func (e *PublicAPI) Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, _ *rpctypes.StateOverride) (hexutil.Bytes, error) {
+func (e *PublicAPI) Call(
+	args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash,
+	overrides *evmtypes.StateOverride, blockOverrides *evmtypes.BlockOverrides,
+) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_call", "args", args.String(), "block number or hash", blockNrOrHash)
 
 	blockNum, err := e.getBlockNumber(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
-	data, err := e.doCall(args, blockNum)
+	data, err := e.doCall(args, blockNum, overrides, blockOverrides)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -660,17 +669,13 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.B
 // estimated gas used on the operation or an error if fails.
 func (e *PublicAPI) doCall(
 	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	overrides *evmtypes.StateOverride, blockOverrides *evmtypes.BlockOverrides,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
-	bz, err := json.Marshal(&args)
+	req, err := backend.NewEthCallRequest(args, e.backend.RPCGasCap(), overrides, blockOverrides)
 	if err != nil {
 		return nil, err
 	}
 
-	req := evmtypes.EthCallRequest{
-		Args:   bz,
-		GasCap: e.backend.RPCGasCap(),
-	}
-
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
@@ -690,7 +695,7 @@ func (e *PublicAPI) doCall(
 	// this makes sure resources are cleaned up.
 	defer cancel()
 
-	res, err := e.queryClient.EthCall(ctx, &req)
+	res, err := e.queryClient.EthCall(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -706,9 +711,12 @@ func (e *PublicAPI) doCall(
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+func (e *PublicAPI) EstimateGas(
+	args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber,
+	overrides *evmtypes.StateOverride, blockOverrides *evmtypes.BlockOverrides,
+) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGas(args, blockNrOptional, overrides, blockOverrides)
 }
 
 // GetBlockByHash returns the block identified by hash.
@@ -816,152 +824,40 @@ func (e *PublicAPI) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockN
 
 // GetTransactionReceipt returns the transaction receipt identified by hash.
 func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
-	hexTx := hash.Hex()
-	e.logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
-
-	res, err := e.backend.GetTxByEthHash(hash)
-	if err != nil {
-		e.logger.Debug("tx not found", "hash", hexTx, "error", err.Error())
-		return nil, nil
-	}
-
-	msgIndex, attrs := rpctypes.FindTxAttributes(res.TxResult.Events, hexTx)
-	if msgIndex < 0 {
-		return nil, fmt.Errorf("ethereum tx not found in msgs: %s", hexTx)
-	}
-
-	resBlock, err := e.clientCtx.Client.Block(e.ctx, &res.Height)
-	if err != nil {
-		e.logger.Debug("block not found", "height", res.Height, "error", err.Error())
-		return nil, nil
-	}
-
-	tx, err := e.clientCtx.TxConfig.TxDecoder()(res.Tx)
-	if err != nil {
-		e.logger.Debug("decoding failed", "error", err.Error())
-		return nil, fmt.Errorf("failed to decode tx: %w", err)
-	}
-
-	// the `msgIndex` is inferred from tx events, should be within the bound.
-	msg := tx.GetMsgs()[msgIndex]
-	ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
-	if !ok {
-		e.logger.Debug(fmt.Sprintf("invalid tx type: %T", msg))
-		return nil, fmt.Errorf("invalid tx type: %T", msg)
-	}
+	e.logger.Debug("eth_getTransactionReceipt", "hash", hash.Hex())
 
-	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	receipt, err := e.backend.GetTransactionReceipt(hash)
 	if err != nil {
-		e.logger.Error("failed to unpack tx data", "error", err.Error())
+		e.logger.Debug("failed to get receipt", "hash", hash.Hex(), "error", err.Error())
 		return nil, err
 	}
-
-	cumulativeGasUsed := uint64(0)
-	blockRes, err := e.clientCtx.Client.BlockResults(e.ctx, &res.Height)
-	if err != nil {
-		e.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+	if receipt == nil {
 		return nil, nil
 	}
 
-	for i := 0; i < int(res.Index) && i < len(blockRes.TxsResults); i++ {
-		cumulativeGasUsed += uint64(blockRes.TxsResults[i].GasUsed)
-	}
-	cumulativeGasUsed += rpctypes.AccumulativeGasUsedOfMsg(res.TxResult.Events, msgIndex)
-
-	var gasUsed uint64
-	if len(tx.GetMsgs()) == 1 {
-		// backward compatibility
-		gasUsed = uint64(res.TxResult.GasUsed)
-	} else {
-		gasUsed, err = rpctypes.GetUint64Attribute(attrs, evmtypes.AttributeKeyTxGasUsed)
-		if err != nil {
-			return nil, err
-		}
-	}
+	return rpctypes.NewRPCReceipt(receipt), nil
+}
 
-	// Get the transaction result from the log
-	_, found := attrs[evmtypes.AttributeKeyEthereumTxFailed]
-	var status hexutil.Uint
-	if found {
-		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
-	} else {
-		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
-	}
+// GetBlockReceipts returns the receipts of all the transactions in a block, identified by number or hash.
+func (e *PublicAPI) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	e.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
 
-	from, err := ethMsg.GetSender(e.chainIDEpoch)
+	blockNum, err := e.getBlockNumber(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
 
-	// parse tx logs from events
-	logs, err := backend.TxLogsFromEvents(res.TxResult.Events, msgIndex)
+	receipts, err := e.backend.GetBlockReceipts(blockNum)
 	if err != nil {
-		e.logger.Debug("logs not found", "hash", hexTx, "error", err.Error())
-	}
-
-	// Try to find txIndex from events
-	found = false
-	txIndex, err := rpctypes.GetUint64Attribute(attrs, evmtypes.AttributeKeyTxIndex)
-	if err == nil {
-		found = true
-	} else {
-		// Fallback to find tx index by iterating all valid eth transactions
-		msgs := e.backend.GetEthereumMsgsFromTendermintBlock(resBlock, blockRes)
-		for i := range msgs {
-			if msgs[i].Hash == hexTx {
-				txIndex = uint64(i)
-				found = true
-				break
-			}
-		}
-	}
-	if !found {
-		return nil, errors.New("can't find index of ethereum tx")
-	}
-
-	receipt := map[string]interface{}{
-		// Consensus fields: These fields are defined by the Yellow Paper
-		"status":            status,
-		"cumulativeGasUsed": hexutil.Uint64(cumulativeGasUsed),
-		"logsBloom":         ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
-		"logs":              logs,
-
-		// Implementation fields: These fields are added by geth when processing a transaction.
-		// They are stored in the chain database.
-		"transactionHash": hash,
-		"contractAddress": nil,
-		"gasUsed":         hexutil.Uint64(gasUsed),
-		"type":            hexutil.Uint(txData.TxType()),
-
-		// Inclusion information: These fields provide information about the inclusion of the
-		// transaction corresponding to this receipt.
-		"blockHash":        common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
-		"blockNumber":      hexutil.Uint64(res.Height),
-		"transactionIndex": hexutil.Uint64(txIndex),
-
-		// sender and receiver (contract or EOA) addreses
-		"from": from,
-		"to":   txData.GetTo(),
-	}
-
-	if logs == nil {
-		receipt["logs"] = [][]*ethtypes.Log{}
-	}
-
-	// If the ContractAddress is 20 0x0 bytes, assume it is not a contract creation
-	if txData.GetTo() == nil {
-		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
+		e.logger.Debug("failed to get block receipts", "block", blockNum, "error", err.Error())
+		return nil, err
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
-		baseFee, err := e.backend.BaseFee(res.Height)
-		if err != nil {
-			return nil, err
-		}
-		receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.GetEffectiveGasPrice(baseFee))
+	result := make([]map[string]interface{}, len(receipts))
+	for i, receipt := range receipts {
+		result[i] = rpctypes.NewRPCReceipt(receipt)
 	}
-
-	return receipt, nil
+	return result, nil
 }
 
 // GetPendingTransactions returns the transactions that are in the transaction pool
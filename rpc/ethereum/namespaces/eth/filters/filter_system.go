@@ -20,15 +20,14 @@ import (
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
-
 	"github.com/tharsis/ethermint/rpc/ethereum/pubsub"
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 var (
-	txEvents     = tmtypes.QueryForEvent(tmtypes.EventTxValue).String()
-	evmEvents    = tmquery.MustCompile(fmt.Sprintf("%s='%s' AND %s.%s='%s'", tmtypes.EventTypeKey, tmtypes.EventTxValue, sdk.EventTypeMessage, sdk.AttributeKeyModule, evmtypes.ModuleName)).String()
+	txEvents = tmtypes.QueryForEvent(tmtypes.EventTxValue).String()
+	// logEvents matches the EVM transactions and the blocks with logs emitted by the modules at the end of the block.
+	logEvents    = tmquery.MustCompile(fmt.Sprintf("%s.%s EXISTS", evmtypes.EventTypeTxLog, evmtypes.AttributeKeyTxLog)).String()
 	headerEvents = tmtypes.QueryForEvent(tmtypes.EventNewBlockHeaderValue).String()
 )
 
@@ -177,7 +176,7 @@ func (es *EventSystem) subscribeLogs(crit filters.FilterCriteria) (*Subscription
 	sub := &Subscription{
 		id:        rpc.NewID(),
 		typ:       filters.LogsSubscription,
-		event:     evmEvents,
+		event:     logEvents,
 		logsCrit:  crit,
 		created:   time.Now().UTC(),
 		logs:      make(chan []*ethtypes.Log),
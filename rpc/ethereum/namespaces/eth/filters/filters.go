@@ -130,6 +130,17 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		f.criteria.ToBlock = big.NewInt(1)
 	}
 
+	// the ranges indexed by the custom EVM indexer are not limited by the block range cap
+	if from, to := f.criteria.FromBlock.Int64(), f.criteria.ToBlock.Int64(); from <= head {
+		if to > head {
+			to = head
+		}
+		indexedLogs, indexed, err := f.backend.FilterIndexedLogs(from, to, f.criteria.Addresses, f.criteria.Topics, logLimit)
+		if indexed {
+			return indexedLogs, err
+		}
+	}
+
 	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
 		return nil, errors.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
@@ -1,10 +1,17 @@
 package filters
 
 import (
+	"fmt"
 	"math/big"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+	coretypes "github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/tharsis/ethermint/rpc/ethereum/types"
 )
 
 // FilterLogs creates a slice of logs matching the given criteria.
@@ -104,3 +111,29 @@ func returnLogs(logs []*ethtypes.Log) []*ethtypes.Log {
 	}
 	return logs
 }
+
+// LogsFromEvent returns the logs of a logs subscription event, i.e. the logs of a transaction or the logs
+// emitted by the modules at the end of a block.
+func LogsFromEvent(ev coretypes.ResultEvent) ([]*ethtypes.Log, error) {
+	var events []abci.Event
+	switch data := ev.Data.(type) {
+	case tmtypes.EventDataTx:
+		events = data.TxResult.Result.Events
+	case tmtypes.EventDataNewBlock:
+		events = data.ResultEndBlock.Events
+	default:
+		return nil, fmt.Errorf("invalid event data type %T", ev.Data)
+	}
+
+	txLogs, err := types.AllTxLogsFromEvents(events)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*ethtypes.Log
+	for _, l := range txLogs {
+		logs = append(logs, l...)
+	}
+
+	return logs, nil
+}
@@ -0,0 +1,349 @@
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/coretypes"
+
+	"github.com/tharsis/ethermint/rpc/ethereum/backend"
+	rpctypes "github.com/tharsis/ethermint/rpc/ethereum/types"
+	evmtracers "github.com/tharsis/ethermint/x/evm/tracers"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// flatCallTracer is the native tracer of the Parity trace format, see the x/evm/tracers package.
+const flatCallTracer = "flatCallTracer"
+
+// LocalizedTrace is a call trace of a transaction, in the Parity (OpenEthereum) format used by the block
+// explorers to index the internal transactions.
+type LocalizedTrace struct {
+	evmtracers.FlatCallFrame
+	BlockHash           common.Hash    `json:"blockHash"`
+	BlockNumber         hexutil.Uint64 `json:"blockNumber"`
+	TransactionHash     common.Hash    `json:"transactionHash"`
+	TransactionPosition hexutil.Uint64 `json:"transactionPosition"`
+}
+
+// TraceResults is a transaction replay of trace_replayBlockTransactions. The state diffs and the VM traces
+// aren't supported.
+type TraceResults struct {
+	Output          hexutil.Bytes              `json:"output"`
+	StateDiff       interface{}                `json:"stateDiff"`
+	Trace           []evmtracers.FlatCallFrame `json:"trace"`
+	VMTrace         interface{}                `json:"vmTrace"`
+	TransactionHash common.Hash                `json:"transactionHash"`
+}
+
+// FilterArgs are the arguments of trace_filter.
+type FilterArgs struct {
+	FromBlock   *rpctypes.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpctypes.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address      `json:"fromAddress"`
+	ToAddress   []common.Address      `json:"toAddress"`
+	After       *hexutil.Uint64       `json:"after"`
+	Count       *hexutil.Uint64       `json:"count"`
+}
+
+// txTraces are the call traces of a transaction.
+type txTraces struct {
+	hash   common.Hash
+	frames []evmtracers.FlatCallFrame
+}
+
+// API is the trace namespace, which replays the blocks with the flat call tracer.
+type API struct {
+	logger      log.Logger
+	backend     backend.Backend
+	clientCtx   client.Context
+	queryClient *rpctypes.QueryClient
+}
+
+// NewAPI creates a new API definition for the tracing methods of the Parity trace namespace.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.Backend,
+	clientCtx client.Context,
+) *API {
+	return &API{
+		logger:      ctx.Logger.With("module", "trace"),
+		backend:     backend,
+		clientCtx:   clientCtx,
+		queryClient: rpctypes.NewQueryClient(clientCtx),
+	}
+}
+
+// Block returns the call traces of the transactions of a block.
+func (a *API) Block(blockNr rpctypes.BlockNumber) ([]*LocalizedTrace, error) {
+	a.logger.Debug("trace_block", "number", blockNr)
+
+	block, err := a.tendermintBlock(blockNr)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := a.traceBlock(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return localize(block, txs), nil
+}
+
+// Transaction returns the call traces of a transaction.
+func (a *API) Transaction(hash common.Hash) ([]*LocalizedTrace, error) {
+	a.logger.Debug("trace_transaction", "hash", hash)
+
+	tx, err := a.backend.GetTxByEthHash(hash)
+	if err != nil {
+		a.logger.Debug("tx not found", "hash", hash)
+		return nil, err
+	}
+
+	block, err := a.tendermintBlock(rpctypes.BlockNumber(tx.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := a.traceBlock(block)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []*LocalizedTrace{}
+	for _, trace := range localize(block, txs) {
+		if trace.TransactionHash == hash {
+			traces = append(traces, trace)
+		}
+	}
+	return traces, nil
+}
+
+// ReplayBlockTransactions replays the transactions of a block and returns their call traces. Only the
+// "trace" trace type is supported.
+func (a *API) ReplayBlockTransactions(blockNr rpctypes.BlockNumber, traceTypes []string) ([]*TraceResults, error) {
+	a.logger.Debug("trace_replayBlockTransactions", "number", blockNr, "types", traceTypes)
+
+	for _, traceType := range traceTypes {
+		if traceType != "trace" {
+			return nil, fmt.Errorf("trace type %s not supported", traceType)
+		}
+	}
+
+	block, err := a.tendermintBlock(blockNr)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := a.traceBlock(block)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*TraceResults, len(txs))
+	for i, tx := range txs {
+		results[i] = &TraceResults{
+			Trace:           tx.frames,
+			TransactionHash: tx.hash,
+		}
+		if len(tx.frames) > 0 && tx.frames[0].Result != nil && tx.frames[0].Result.Output != nil {
+			results[i].Output = *tx.frames[0].Result.Output
+		}
+	}
+	return results, nil
+}
+
+// Filter returns the call traces of the given block range, filtered by sender and recipient. The block
+// range is capped by the trace block range cap of the JSON-RPC configuration.
+func (a *API) Filter(args FilterArgs) ([]*LocalizedTrace, error) {
+	a.logger.Debug("trace_filter", "args", args)
+
+	latest, err := a.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := int64(latest), int64(latest)
+	if args.FromBlock != nil && *args.FromBlock >= 0 {
+		from = args.FromBlock.Int64()
+	}
+	if args.ToBlock != nil && *args.ToBlock >= 0 {
+		to = args.ToBlock.Int64()
+	}
+	if from == 0 {
+		// genesis is not traceable
+		from = 1
+	}
+
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from %d to %d", from, to)
+	}
+	if blockRangeCap := int64(a.backend.RPCTraceBlockRangeCap()); blockRangeCap > 0 && to-from+1 > blockRangeCap {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockRangeCap)
+	}
+
+	var (
+		after  uint64
+		traces = []*LocalizedTrace{}
+	)
+	if args.After != nil {
+		after = uint64(*args.After)
+	}
+
+	for height := from; height <= to; height++ {
+		block, err := a.tendermintBlock(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+
+		txs, err := a.traceBlock(block)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range localize(block, txs) {
+			if !args.matches(trace) {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) == uint64(*args.Count) {
+				return traces, nil
+			}
+		}
+	}
+
+	return traces, nil
+}
+
+// matches returns true if the trace is sent by one of the from addresses, and to one of the to addresses.
+func (args FilterArgs) matches(trace *LocalizedTrace) bool {
+	from, to := trace.Action.From, trace.Action.To
+	switch trace.Type {
+	case "create":
+		if trace.Result != nil {
+			to = trace.Result.Address
+		}
+	case "suicide":
+		from, to = trace.Action.Address, trace.Action.RefundAddress
+	}
+
+	return matchAddress(args.FromAddress, from) && matchAddress(args.ToAddress, to)
+}
+
+func matchAddress(addresses []common.Address, address *common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	if address == nil {
+		return false
+	}
+	for _, addr := range addresses {
+		if addr == *address {
+			return true
+		}
+	}
+	return false
+}
+
+// tendermintBlock returns the block of the given number, genesis excluded.
+func (a *API) tendermintBlock(blockNr rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error) {
+	if blockNr == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	block, err := a.backend.GetTendermintBlockByNumber(blockNr)
+	if err != nil {
+		a.logger.Debug("get block failed", "height", blockNr, "error", err.Error())
+		return nil, err
+	}
+
+	if block == nil || block.Block == nil {
+		return nil, fmt.Errorf("block %d not found", blockNr)
+	}
+	return block, nil
+}
+
+// traceBlock replays the Ethereum transactions of a block with the flat call tracer.
+func (a *API) traceBlock(block *tmrpctypes.ResultBlock) ([]txTraces, error) {
+	blockRes, err := a.clientCtx.Client.BlockResults(context.Background(), &block.Block.Height)
+	if err != nil {
+		a.logger.Debug("block result not found", "height", block.Block.Height, "error", err.Error())
+		return nil, err
+	}
+
+	msgs := a.backend.GetEthereumMsgsFromTendermintBlock(block, blockRes)
+	if len(msgs) == 0 {
+		return []txTraces{}, nil
+	}
+
+	// minus one to get the context at the beginning of the block
+	contextHeight := block.Block.Height - 1
+	if contextHeight < 1 {
+		// 0 is a special value for `ContextWithHeight`.
+		contextHeight = 1
+	}
+
+	res, err := a.queryClient.TraceBlock(rpctypes.ContextWithHeight(contextHeight), &evmtypes.QueryTraceBlockRequest{
+		Txs:         msgs,
+		TraceConfig: &evmtypes.TraceConfig{Tracer: flatCallTracer},
+		BlockNumber: block.Block.Height,
+		BlockTime:   block.Block.Time,
+		BlockHash:   common.Bytes2Hex(block.BlockID.Hash),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []struct {
+		Result []evmtracers.FlatCallFrame `json:"result"`
+		Error  string                     `json:"error"`
+	}
+	if err := json.Unmarshal(res.Data, &results); err != nil {
+		return nil, err
+	}
+	if len(results) != len(msgs) {
+		return nil, fmt.Errorf("expected %d trace results, got %d", len(msgs), len(results))
+	}
+
+	txs := make([]txTraces, len(msgs))
+	for i, result := range results {
+		if result.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %s: %s", msgs[i].Hash, result.Error)
+		}
+		txs[i] = txTraces{
+			hash:   common.HexToHash(msgs[i].Hash),
+			frames: result.Result,
+		}
+	}
+	return txs, nil
+}
+
+// localize returns the call traces of the transactions of a block, with their block and transaction.
+func localize(block *tmrpctypes.ResultBlock, txs []txTraces) []*LocalizedTrace {
+	traces := []*LocalizedTrace{}
+	for i, tx := range txs {
+		for _, frame := range tx.frames {
+			traces = append(traces, &LocalizedTrace{
+				FlatCallFrame:       frame,
+				BlockHash:           common.BytesToHash(block.BlockID.Hash),
+				BlockNumber:         hexutil.Uint64(block.Block.Height),
+				TransactionHash:     tx.hash,
+				TransactionPosition: hexutil.Uint64(i),
+			})
+		}
+	}
+	return traces
+}
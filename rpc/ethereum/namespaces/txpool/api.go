@@ -1,51 +1,162 @@
 package txpool
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/tharsis/ethermint/rpc/ethereum/backend"
 	"github.com/tharsis/ethermint/rpc/ethereum/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/tharsis/ethermint/issues/124
+// The pool content is read from the Tendermint mempool, which returns at most 100 transactions:
+// Content, ContentFrom and Inspect only list the first 100 transactions of a larger pool.
+//
+// The queued transactions are always empty: the ante handler rejects the transactions with a nonce gap,
+// so all the transactions of the mempool are executable.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.Backend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.Backend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
-// Content returns the transactions contained within the transaction pool
+// poolTxs are the transactions of the pool by sender and nonce.
+type poolTxs map[common.Address]map[uint64]*types.RPCTransaction
+
+// Content returns the transactions contained within the transaction pool, grouped by sender and nonce.
+// Only the first 100 transactions of the mempool are returned.
 func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_content")
+
+	pending, err := api.content()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]*types.RPCTransaction{
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+	for sender, txs := range pending {
+		content["pending"][sender.Hex()] = formatTxs(txs)
+	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool for the given address.
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address.Hex())
+
+	pending, err := api.content()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*types.RPCTransaction{
+		"pending": formatTxs(pending[address]),
+		"queued":  make(map[string]*types.RPCTransaction),
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+
+	pending, err := api.content()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+	for sender, txs := range pending {
+		content["pending"][sender.Hex()] = inspectTxs(txs)
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+// The pending count is the size of the whole mempool, which includes the Cosmos transactions.
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+
+	pending, err := api.backend.PendingTransactionsCount()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
+		"pending": hexutil.Uint(pending),
 		"queued":  hexutil.Uint(0),
+	}, nil
+}
+
+// content returns the Ethereum transactions of the mempool, which are all pending.
+func (api *PublicAPI) content() (poolTxs, error) {
+	txs, err := api.backend.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make(poolTxs)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not an ethereum tx
+				continue
+			}
+
+			rpcTx, err := types.NewTransactionFromMsg(ethMsg, common.Hash{}, uint64(0), uint64(0), nil)
+			if err != nil {
+				return nil, err
+			}
+
+			if pending[rpcTx.From] == nil {
+				pending[rpcTx.From] = make(map[uint64]*types.RPCTransaction)
+			}
+			pending[rpcTx.From][uint64(rpcTx.Nonce)] = rpcTx
+		}
+	}
+
+	return pending, nil
+}
+
+func formatTxs(txs map[uint64]*types.RPCTransaction) map[string]*types.RPCTransaction {
+	formatted := make(map[string]*types.RPCTransaction, len(txs))
+	for nonce, tx := range txs {
+		formatted[strconv.FormatUint(nonce, 10)] = tx
+	}
+	return formatted
+}
+
+// inspectTxs returns the summaries of transactions, in the go-ethereum format.
+func inspectTxs(txs map[uint64]*types.RPCTransaction) map[string]string {
+	summaries := make(map[string]string, len(txs))
+	for nonce, tx := range txs {
+		to := "contract creation"
+		if tx.To != nil {
+			to = tx.To.Hex()
+		}
+		summaries[strconv.FormatUint(nonce, 10)] = fmt.Sprintf(
+			"%s: %v wei + %v gas × %v wei", to, tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt(),
+		)
 	}
+	return summaries
 }
@@ -0,0 +1,144 @@
+package txpool
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tharsis/ethermint/rpc/ethereum/backend"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// mockBackend returns the transactions of the mempool, the other backend methods aren't implemented.
+type mockBackend struct {
+	backend.Backend
+
+	txs   []*sdk.Tx
+	count int
+}
+
+func (b mockBackend) PendingTransactions() ([]*sdk.Tx, error) {
+	return b.txs, nil
+}
+
+func (b mockBackend) PendingTransactionsCount() (int, error) {
+	return b.count, nil
+}
+
+// cosmosTx is a mempool transaction without Ethereum messages.
+type cosmosTx struct {
+	sdk.Tx
+}
+
+func (tx cosmosTx) GetMsgs() []sdk.Msg {
+	return []sdk.Msg{&banktypes.MsgSend{}}
+}
+
+func newEthTx(t *testing.T, key *ecdsa.PrivateKey, nonce uint64, to *common.Address) sdk.Tx {
+	chainID := big.NewInt(9000)
+	tx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), &ethtypes.LegacyTx{
+		Nonce:    nonce,
+		To:       to,
+		Value:    big.NewInt(10),
+		Gas:      21000,
+		GasPrice: big.NewInt(1),
+	})
+	require.NoError(t, err)
+
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(tx))
+	return msg
+}
+
+func newTestAPI(t *testing.T) (*PublicAPI, common.Address, common.Address, common.Address) {
+	key1, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	key2, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender1, sender2 := crypto.PubkeyToAddress(key1.PublicKey), crypto.PubkeyToAddress(key2.PublicKey)
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	txs := []sdk.Tx{
+		newEthTx(t, key1, 0, &to),
+		newEthTx(t, key1, 1, nil),
+		newEthTx(t, key2, 5, &to),
+		cosmosTx{},
+	}
+	mempool := make([]*sdk.Tx, len(txs))
+	for i := range txs {
+		mempool[i] = &txs[i]
+	}
+
+	api := NewPublicAPI(log.NewNopLogger(), mockBackend{txs: mempool, count: 150})
+	return api, sender1, sender2, to
+}
+
+func TestContent(t *testing.T) {
+	api, sender1, sender2, to := newTestAPI(t)
+
+	content, err := api.Content()
+	require.NoError(t, err)
+	require.Empty(t, content["queued"])
+
+	pending := content["pending"]
+	require.Len(t, pending, 2)
+	require.Len(t, pending[sender1.Hex()], 2)
+	require.Len(t, pending[sender2.Hex()], 1)
+
+	// The transactions of a sender are keyed by their decimal nonce.
+	require.Equal(t, sender1, pending[sender1.Hex()]["0"].From)
+	require.Equal(t, hexutil.Uint64(0), pending[sender1.Hex()]["0"].Nonce)
+	require.Equal(t, &to, pending[sender1.Hex()]["0"].To)
+	require.Equal(t, hexutil.Uint64(1), pending[sender1.Hex()]["1"].Nonce)
+	require.Nil(t, pending[sender1.Hex()]["1"].To)
+	require.Equal(t, sender2, pending[sender2.Hex()]["5"].From)
+}
+
+func TestContentFrom(t *testing.T) {
+	api, sender1, _, _ := newTestAPI(t)
+
+	content, err := api.ContentFrom(sender1)
+	require.NoError(t, err)
+	require.Len(t, content["pending"], 2)
+	require.Empty(t, content["queued"])
+	require.Equal(t, hexutil.Uint64(1), content["pending"]["1"].Nonce)
+
+	content, err = api.ContentFrom(common.HexToAddress("0x1000000000000000000000000000000000000002"))
+	require.NoError(t, err)
+	require.Empty(t, content["pending"])
+}
+
+func TestInspect(t *testing.T) {
+	api, sender1, sender2, to := newTestAPI(t)
+
+	inspect, err := api.Inspect()
+	require.NoError(t, err)
+	require.Empty(t, inspect["queued"])
+	require.Equal(t, map[string]string{
+		"0": to.Hex() + ": 10 wei + 21000 gas × 1 wei",
+		"1": "contract creation: 10 wei + 21000 gas × 1 wei",
+	}, inspect["pending"][sender1.Hex()])
+	require.Equal(t, map[string]string{
+		"5": to.Hex() + ": 10 wei + 21000 gas × 1 wei",
+	}, inspect["pending"][sender2.Hex()])
+}
+
+func TestStatus(t *testing.T) {
+	api, _, _, _ := newTestAPI(t)
+
+	// The pending count is the size of the mempool, not the number of listed transactions.
+	status, err := api.Status()
+	require.NoError(t, err)
+	require.Equal(t, map[string]hexutil.Uint{"pending": 150, "queued": 0}, status)
+}
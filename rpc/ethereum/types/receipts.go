@@ -0,0 +1,215 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// BlockReceipts builds the receipts of the ethereum transactions of a block from the block results, the
+// receipts are in the order of the ethereum transactions returned by the block queries. The base fee is
+// only needed for the effective gas price of the dynamic fee transactions, it's nil before London.
+// The transactions without results, e.g. the ones not executed, have no receipts.
+func BlockReceipts(
+	clientCtx client.Context,
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+	chainID, baseFee *big.Int,
+) ([]*evmtypes.Receipt, error) {
+	block := resBlock.Block
+	if len(blockRes.TxsResults) != len(block.Txs) {
+		return nil, fmt.Errorf("block %d has %d txs but %d tx results", block.Height, len(block.Txs), len(blockRes.TxsResults))
+	}
+
+	blockHash := common.BytesToHash(block.Header.Hash()).Hex()
+
+	receipts := []*evmtypes.Receipt{}
+	cumulativeGasUsed := uint64(0)
+	ethTxIndex := uint64(0)
+	for i, txBz := range block.Txs {
+		txResult := blockRes.TxsResults[i]
+		msgs := ethereumMsgs(clientCtx, txBz, txResult)
+
+		for _, ethMsg := range msgs {
+			// the index of the ethereum tx in the block, the older events have no index
+			txIndex := ethTxIndex
+			ethTxIndex++
+
+			msgIndex, attrs := FindTxAttributes(txResult.Events, ethMsg.Hash)
+			if msgIndex < 0 {
+				continue
+			}
+			if index, err := GetUint64Attribute(attrs, evmtypes.AttributeKeyTxIndex); err == nil {
+				txIndex = index
+			}
+
+			receipt, err := newReceipt(blockHash, block.Height, txResult, ethMsg, len(msgs), msgIndex, attrs, cumulativeGasUsed, txIndex, chainID, baseFee)
+			if err != nil {
+				return nil, err
+			}
+			receipts = append(receipts, receipt)
+		}
+
+		cumulativeGasUsed += uint64(txResult.GasUsed)
+	}
+
+	return receipts, nil
+}
+
+// TxReceipt builds the receipt of the ethereum transaction hash, included by the tx at txPosition in
+// the block, from the block results. It's the receipt returned by BlockReceipts for the transaction,
+// and returns nil if the transaction has no result.
+func TxReceipt(
+	clientCtx client.Context,
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+	txPosition uint32,
+	hash common.Hash,
+	chainID, baseFee *big.Int,
+) (*evmtypes.Receipt, error) {
+	block := resBlock.Block
+	if int(txPosition) >= len(block.Txs) || int(txPosition) >= len(blockRes.TxsResults) {
+		return nil, fmt.Errorf("tx %d not found in block %d", txPosition, block.Height)
+	}
+
+	txResult := blockRes.TxsResults[txPosition]
+	msgs := ethereumMsgs(clientCtx, block.Txs[txPosition], txResult)
+
+	var ethMsg *evmtypes.MsgEthereumTx
+	msgPosition := 0
+	for i, msg := range msgs {
+		if msg.Hash == hash.Hex() {
+			ethMsg = msg
+			msgPosition = i
+			break
+		}
+	}
+	if ethMsg == nil {
+		return nil, fmt.Errorf("ethereum tx not found in msgs: %s", hash.Hex())
+	}
+
+	msgIndex, attrs := FindTxAttributes(txResult.Events, ethMsg.Hash)
+	if msgIndex < 0 {
+		return nil, nil
+	}
+
+	txIndex, err := GetUint64Attribute(attrs, evmtypes.AttributeKeyTxIndex)
+	if err != nil {
+		// the older events have no index, count the ethereum txs of the block before the tx
+		txIndex = uint64(msgPosition)
+		for i, txBz := range block.Txs[:txPosition] {
+			txIndex += uint64(len(ethereumMsgs(clientCtx, txBz, blockRes.TxsResults[i])))
+		}
+	}
+
+	cumulativeGasUsed := uint64(0)
+	for _, res := range blockRes.TxsResults[:txPosition] {
+		cumulativeGasUsed += uint64(res.GasUsed)
+	}
+
+	blockHash := common.BytesToHash(block.Header.Hash()).Hex()
+	return newReceipt(blockHash, block.Height, txResult, ethMsg, len(msgs), msgIndex, attrs, cumulativeGasUsed, txIndex, chainID, baseFee)
+}
+
+// ethereumMsgs returns the ethereum transactions of a block tx. The txs failed in the ante handler are
+// not included by the eth blocks, they have no ethereum transactions.
+func ethereumMsgs(clientCtx client.Context, txBz tmtypes.Tx, txResult *abci.ResponseDeliverTx) []*evmtypes.MsgEthereumTx {
+	if txResult.Code != 0 {
+		return nil
+	}
+
+	tx, err := clientCtx.TxConfig.TxDecoder()(txBz)
+	if err != nil {
+		return nil
+	}
+
+	msgs := tx.GetMsgs()
+	ethMsgs := make([]*evmtypes.MsgEthereumTx, 0, len(msgs))
+	for _, msg := range msgs {
+		if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			ethMsgs = append(ethMsgs, ethMsg)
+		}
+	}
+	return ethMsgs
+}
+
+// newReceipt builds the receipt of an ethereum transaction from the attributes of its event, msgIndex
+// is the index of the event in the tx result, cumulativeGasUsed the gas used by the previous txs.
+func newReceipt(
+	blockHash string,
+	height int64,
+	txResult *abci.ResponseDeliverTx,
+	ethMsg *evmtypes.MsgEthereumTx,
+	msgCount, msgIndex int,
+	attrs map[string]string,
+	cumulativeGasUsed, txIndex uint64,
+	chainID, baseFee *big.Int,
+) (*evmtypes.Receipt, error) {
+	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	var gasUsed uint64
+	if msgCount == 1 {
+		// backward compatibility
+		gasUsed = uint64(txResult.GasUsed)
+	} else {
+		gasUsed, err = GetUint64Attribute(attrs, evmtypes.AttributeKeyTxGasUsed)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	status := ethtypes.ReceiptStatusSuccessful
+	if _, failed := attrs[evmtypes.AttributeKeyEthereumTxFailed]; failed {
+		status = ethtypes.ReceiptStatusFailed
+	}
+
+	from, err := ethMsg.GetSender(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := TxLogsFromEvents(txResult.Events, msgIndex)
+	if err != nil {
+		logs = []*ethtypes.Log{}
+	}
+
+	receipt := &evmtypes.Receipt{
+		Status:            status,
+		CumulativeGasUsed: cumulativeGasUsed + AccumulativeGasUsedOfMsg(txResult.Events, msgIndex),
+		LogsBloom:         ethtypes.LogsBloom(logs),
+		Logs:              evmtypes.NewLogsFromEth(logs),
+		TxHash:            ethMsg.Hash,
+		GasUsed:           gasUsed,
+		Type:              uint32(txData.TxType()),
+		BlockHash:         blockHash,
+		BlockNumber:       uint64(height),
+		TxIndex:           txIndex,
+		From:              from.Hex(),
+	}
+
+	if to := txData.GetTo(); to != nil {
+		receipt.To = to.Hex()
+	} else {
+		receipt.ContractAddress = crypto.CreateAddress(from, txData.GetNonce()).Hex()
+	}
+
+	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok && baseFee != nil {
+		receipt.EffectiveGasPrice = dynamicTx.GetEffectiveGasPrice(baseFee).String()
+	}
+
+	return receipt, nil
+}
@@ -1,11 +1,14 @@
 package types
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -52,23 +55,6 @@ type RPCTransaction struct {
 	S                *hexutil.Big         `json:"s"`
 }
 
-// StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
-
-// OverrideAccount indicates the overriding fields of account during the execution of
-// a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
-}
-
 type FeeHistoryResult struct {
 	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
 	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
@@ -87,3 +73,31 @@ type OneFeeHistory struct {
 	Reward       []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio float64    // the ratio of gas used to the gas limit for each block
 }
+
+// TraceConfig holds extra parameters to trace functions. The configuration of the native tracer is a JSON
+// object, which is passed as a string to the EVM queries.
+type TraceConfig struct {
+	evmtypes.TraceConfig
+	TracerConfig json.RawMessage `json:"tracerConfig"`
+}
+
+// EVMTraceConfig returns the trace configuration of the EVM queries, nil if the config is nil.
+func (c *TraceConfig) EVMTraceConfig() *evmtypes.TraceConfig {
+	if c == nil {
+		return nil
+	}
+
+	cfg := c.TraceConfig
+	if len(c.TracerConfig) > 0 {
+		cfg.TracerJsonConfig = string(c.TracerConfig)
+	}
+	return &cfg
+}
+
+// TraceCallConfig is the config for the debug_traceCall API. It holds the state and block overrides of
+// the traced call.
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *evmtypes.StateOverride  `json:"stateOverrides"`
+	BlockOverrides *evmtypes.BlockOverrides `json:"blockOverrides"`
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -234,6 +235,55 @@ func NewRPCTransaction(
 	return result, nil
 }
 
+// NewRPCReceipt returns a receipt that will serialize to the RPC representation of the
+// eth_getTransactionReceipt and eth_getBlockReceipts APIs.
+func NewRPCReceipt(receipt *evmtypes.Receipt) map[string]interface{} {
+	logs := evmtypes.LogsToEthereum(receipt.Logs)
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+
+	result := map[string]interface{}{
+		// Consensus fields: These fields are defined by the Yellow Paper
+		"status":            hexutil.Uint(receipt.Status),
+		"cumulativeGasUsed": hexutil.Uint64(receipt.CumulativeGasUsed),
+		"logsBloom":         ethtypes.BytesToBloom(receipt.LogsBloom),
+		"logs":              logs,
+
+		// Implementation fields: These fields are added by geth when processing a transaction.
+		// They are stored in the chain database.
+		"transactionHash": common.HexToHash(receipt.TxHash),
+		"contractAddress": nil,
+		"gasUsed":         hexutil.Uint64(receipt.GasUsed),
+		"type":            hexutil.Uint(receipt.Type),
+
+		// Inclusion information: These fields provide information about the inclusion of the
+		// transaction corresponding to this receipt.
+		"blockHash":        receipt.BlockHash,
+		"blockNumber":      hexutil.Uint64(receipt.BlockNumber),
+		"transactionIndex": hexutil.Uint64(receipt.TxIndex),
+
+		// sender and receiver (contract or EOA) addreses
+		"from": common.HexToAddress(receipt.From),
+		"to":   nil,
+	}
+
+	if receipt.To != "" {
+		to := common.HexToAddress(receipt.To)
+		result["to"] = &to
+	}
+
+	if receipt.ContractAddress != "" {
+		result["contractAddress"] = common.HexToAddress(receipt.ContractAddress)
+	}
+
+	if price, ok := new(big.Int).SetString(receipt.EffectiveGasPrice, 10); ok {
+		result["effectiveGasPrice"] = hexutil.Big(*price)
+	}
+
+	return result
+}
+
 // BaseFeeFromEvents parses the feemarket basefee from cosmos events
 func BaseFeeFromEvents(events []abci.Event) *big.Int {
 	for _, event := range events {
@@ -365,3 +415,57 @@ func AccumulativeGasUsedOfMsg(events []abci.Event, msgIndex int) (gasUsed uint64
 	}
 	return
 }
+
+// AllTxLogsFromEvents parses all ethereum logs from cosmos events
+func AllTxLogsFromEvents(events []abci.Event) ([][]*ethtypes.Log, error) {
+	allLogs := make([][]*ethtypes.Log, 0, 4)
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+
+		logs, err := ParseTxLogsFromEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		allLogs = append(allLogs, logs)
+	}
+	return allLogs, nil
+}
+
+// TxLogsFromEvents parses ethereum logs from cosmos events for specific msg index
+func TxLogsFromEvents(events []abci.Event, msgIndex int) ([]*ethtypes.Log, error) {
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+
+		if msgIndex > 0 {
+			// not the eth tx we want
+			msgIndex--
+			continue
+		}
+
+		return ParseTxLogsFromEvent(event)
+	}
+	return nil, fmt.Errorf("eth tx logs not found for message index %d", msgIndex)
+}
+
+// ParseTxLogsFromEvent parse tx logs from one event
+func ParseTxLogsFromEvent(event abci.Event) ([]*ethtypes.Log, error) {
+	logs := make([]*evmtypes.Log, 0, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		if !bytes.Equal([]byte(attr.Key), []byte(evmtypes.AttributeKeyTxLog)) {
+			continue
+		}
+
+		var log evmtypes.Log
+		if err := json.Unmarshal([]byte(attr.Value), &log); err != nil {
+			return nil, err
+		}
+
+		logs = append(logs, &log)
+	}
+	return evmtypes.LogsToEthereum(logs), nil
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func TestNewRPCReceipt(t *testing.T) {
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	to := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000003")
+	txHash := common.HexToHash("0x01")
+	log := &ethtypes.Log{Address: to, Topics: []common.Hash{common.HexToHash("0x02")}, TxHash: txHash}
+
+	receipt := &evmtypes.Receipt{
+		Status:            ethtypes.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 50000,
+		LogsBloom:         ethtypes.LogsBloom([]*ethtypes.Log{log}),
+		Logs:              evmtypes.NewLogsFromEth([]*ethtypes.Log{log}),
+		TxHash:            txHash.Hex(),
+		GasUsed:           30000,
+		Type:              ethtypes.DynamicFeeTxType,
+		BlockHash:         common.HexToHash("0x03").Hex(),
+		BlockNumber:       10,
+		TxIndex:           1,
+		From:              from.Hex(),
+		To:                to.Hex(),
+		EffectiveGasPrice: "1010",
+	}
+
+	result := NewRPCReceipt(receipt)
+	require.Equal(t, hexutil.Uint(ethtypes.ReceiptStatusSuccessful), result["status"])
+	require.Equal(t, hexutil.Uint64(50000), result["cumulativeGasUsed"])
+	require.Equal(t, ethtypes.BytesToBloom(receipt.LogsBloom), result["logsBloom"])
+	require.Equal(t, []*ethtypes.Log{log}, result["logs"])
+	require.Equal(t, txHash, result["transactionHash"])
+	require.Nil(t, result["contractAddress"])
+	require.Equal(t, hexutil.Uint64(30000), result["gasUsed"])
+	require.Equal(t, hexutil.Uint(ethtypes.DynamicFeeTxType), result["type"])
+	require.Equal(t, receipt.BlockHash, result["blockHash"])
+	require.Equal(t, hexutil.Uint64(10), result["blockNumber"])
+	require.Equal(t, hexutil.Uint64(1), result["transactionIndex"])
+	require.Equal(t, from, result["from"])
+	require.Equal(t, &to, result["to"])
+	require.Equal(t, hexutil.Big(*big.NewInt(1010)), result["effectiveGasPrice"])
+
+	// contract creation without logs, before London
+	receipt.To = ""
+	receipt.ContractAddress = contract.Hex()
+	receipt.Logs = nil
+	receipt.EffectiveGasPrice = ""
+
+	result = NewRPCReceipt(receipt)
+	require.Nil(t, result["to"])
+	require.Equal(t, contract, result["contractAddress"])
+	require.Equal(t, []*ethtypes.Log{}, result["logs"])
+	require.NotContains(t, result, "effectiveGasPrice")
+}
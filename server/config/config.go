@@ -40,6 +40,8 @@ const (
 
 	DefaultBlockRangeCap int32 = 10000
 
+	DefaultTraceBlockRangeCap int32 = 100
+
 	DefaultEVMTimeout = 5 * time.Second
 	// default 1.0 eth
 	DefaultTxFeeCap float64 = 1.0
@@ -94,10 +96,14 @@ type JSONRPCConfig struct {
 	LogsCap int32 `mapstructure:"logs-cap"`
 	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query.
 	BlockRangeCap int32 `mapstructure:"block-range-cap"`
+	// TraceBlockRangeCap defines the max block range allowed for `trace_filter` query, 0 means no limit.
+	TraceBlockRangeCap int32 `mapstructure:"trace-block-range-cap"`
 	// HTTPTimeout is the read/write timeout of http json-rpc server.
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
 	// HTTPIdleTimeout is the idle timeout of http json-rpc server.
 	HTTPIdleTimeout time.Duration `mapstructure:"http-idle-timeout"`
+	// EnableIndexer defines if enable the custom indexer service.
+	EnableIndexer bool `mapstructure:"enable-indexer"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -177,25 +183,27 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
 func DefaultJSONRPCConfig() *JSONRPCConfig {
 	return &JSONRPCConfig{
-		Enable:          true,
-		API:             GetDefaultAPINamespaces(),
-		Address:         DefaultJSONRPCAddress,
-		WsAddress:       DefaultJSONRPCWsAddress,
-		GasCap:          DefaultGasCap,
-		EVMTimeout:      DefaultEVMTimeout,
-		TxFeeCap:        DefaultTxFeeCap,
-		FilterCap:       DefaultFilterCap,
-		FeeHistoryCap:   DefaultFeeHistoryCap,
-		BlockRangeCap:   DefaultBlockRangeCap,
-		LogsCap:         DefaultLogsCap,
-		HTTPTimeout:     DefaultHTTPTimeout,
-		HTTPIdleTimeout: DefaultHTTPIdleTimeout,
+		Enable:             true,
+		API:                GetDefaultAPINamespaces(),
+		Address:            DefaultJSONRPCAddress,
+		WsAddress:          DefaultJSONRPCWsAddress,
+		GasCap:             DefaultGasCap,
+		EVMTimeout:         DefaultEVMTimeout,
+		TxFeeCap:           DefaultTxFeeCap,
+		FilterCap:          DefaultFilterCap,
+		FeeHistoryCap:      DefaultFeeHistoryCap,
+		BlockRangeCap:      DefaultBlockRangeCap,
+		TraceBlockRangeCap: DefaultTraceBlockRangeCap,
+		LogsCap:            DefaultLogsCap,
+		HTTPTimeout:        DefaultHTTPTimeout,
+		HTTPIdleTimeout:    DefaultHTTPIdleTimeout,
+		EnableIndexer:      false,
 	}
 }
 
@@ -229,6 +237,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC block range cap cannot be negative")
 	}
 
+	if c.TraceBlockRangeCap < 0 {
+		return errors.New("JSON-RPC trace block range cap cannot be negative")
+	}
+
 	if c.HTTPTimeout < 0 {
 		return errors.New("JSON-RPC HTTP timeout duration cannot be negative")
 	}
@@ -279,6 +291,12 @@ func (c TLSConfig) Validate() error {
 func GetConfig(v *viper.Viper) Config {
 	cfg := config.GetConfig(v)
 
+	// the configs written before the trace namespace have no trace block range cap
+	traceBlockRangeCap := DefaultTraceBlockRangeCap
+	if v.IsSet("json-rpc.trace-block-range-cap") {
+		traceBlockRangeCap = v.GetInt32("json-rpc.trace-block-range-cap")
+	}
+
 	return Config{
 		Config: cfg,
 		EVM: EVMConfig{
@@ -286,19 +304,21 @@ func GetConfig(v *viper.Viper) Config {
 			MaxTxGasWanted: v.GetUint64("evm.max-tx-gas-wanted"),
 		},
 		JSONRPC: JSONRPCConfig{
-			Enable:          v.GetBool("json-rpc.enable"),
-			API:             v.GetStringSlice("json-rpc.api"),
-			Address:         v.GetString("json-rpc.address"),
-			WsAddress:       v.GetString("json-rpc.ws-address"),
-			GasCap:          v.GetUint64("json-rpc.gas-cap"),
-			FilterCap:       v.GetInt32("json-rpc.filter-cap"),
-			FeeHistoryCap:   v.GetInt32("json-rpc.feehistory-cap"),
-			TxFeeCap:        v.GetFloat64("json-rpc.txfee-cap"),
-			EVMTimeout:      v.GetDuration("json-rpc.evm-timeout"),
-			LogsCap:         v.GetInt32("json-rpc.logs-cap"),
-			BlockRangeCap:   v.GetInt32("json-rpc.block-range-cap"),
-			HTTPTimeout:     v.GetDuration("json-rpc.http-timeout"),
-			HTTPIdleTimeout: v.GetDuration("json-rpc.http-idle-timeout"),
+			Enable:             v.GetBool("json-rpc.enable"),
+			API:                v.GetStringSlice("json-rpc.api"),
+			Address:            v.GetString("json-rpc.address"),
+			WsAddress:          v.GetString("json-rpc.ws-address"),
+			GasCap:             v.GetUint64("json-rpc.gas-cap"),
+			FilterCap:          v.GetInt32("json-rpc.filter-cap"),
+			FeeHistoryCap:      v.GetInt32("json-rpc.feehistory-cap"),
+			TxFeeCap:           v.GetFloat64("json-rpc.txfee-cap"),
+			EVMTimeout:         v.GetDuration("json-rpc.evm-timeout"),
+			LogsCap:            v.GetInt32("json-rpc.logs-cap"),
+			BlockRangeCap:      v.GetInt32("json-rpc.block-range-cap"),
+			TraceBlockRangeCap: traceBlockRangeCap,
+			HTTPTimeout:        v.GetDuration("json-rpc.http-timeout"),
+			HTTPIdleTimeout:    v.GetDuration("json-rpc.http-idle-timeout"),
+			EnableIndexer:      v.GetBool("json-rpc.enable-indexer"),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
@@ -3,6 +3,7 @@ package config
 import (
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -12,3 +13,20 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestTraceBlockRangeCap(t *testing.T) {
+	// configs without the cap get the default
+	v := viper.New()
+	v.Set("telemetry.global-labels", []interface{}{})
+	require.Equal(t, DefaultTraceBlockRangeCap, GetConfig(v).JSONRPC.TraceBlockRangeCap)
+
+	v.Set("json-rpc.trace-block-range-cap", 0)
+	require.Equal(t, int32(0), GetConfig(v).JSONRPC.TraceBlockRangeCap)
+
+	cfg := DefaultConfig()
+	cfg.JSONRPC.TraceBlockRangeCap = 0
+	require.NoError(t, cfg.JSONRPC.Validate())
+
+	cfg.JSONRPC.TraceBlockRangeCap = -1
+	require.Error(t, cfg.JSONRPC.Validate())
+}
@@ -32,7 +32,7 @@ address = "{{ .JSONRPC.Address }}"
 ws-address = "{{ .JSONRPC.WsAddress }}"
 
 # API defines a list of JSON-RPC namespaces that should be enabled
-# Example: "eth,txpool,personal,net,debug,web3"
+# Example: "eth,txpool,personal,net,debug,web3,trace"
 api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
 
 # GasCap sets a cap on gas that can be used in eth_call/estimateGas (0=infinite). Default: 25,000,000.
@@ -56,12 +56,20 @@ logs-cap = {{ .JSONRPC.LogsCap }}
 # BlockRangeCap defines the max block range allowed for 'eth_getLogs' query.
 block-range-cap = {{ .JSONRPC.BlockRangeCap }}
 
+# TraceBlockRangeCap defines the max block range allowed for 'trace_filter' query, 0 means no limit.
+trace-block-range-cap = {{ .JSONRPC.TraceBlockRangeCap }}
+
 # HTTPTimeout is the read/write timeout of http json-rpc server.
 http-timeout = "{{ .JSONRPC.HTTPTimeout }}"
 
 # HTTPIdleTimeout is the idle timeout of http json-rpc server.
 http-idle-timeout = "{{ .JSONRPC.HTTPIdleTimeout }}"
 
+# EnableIndexer enables the custom EVM indexer, which indexes the ethereum transactions, receipts and logs
+# of the committed blocks in a local database, to serve the tx lookups and 'eth_getLogs' queries without
+# the Tendermint tx indexer. The 'block-range-cap' doesn't apply to the 'eth_getLogs' ranges that are indexed.
+enable-indexer = {{ .JSONRPC.EnableIndexer }}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -6,6 +6,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/tharsis/ethermint/gql"
 )
 
 // Tendermint full-node start flags
@@ -33,18 +35,20 @@ const (
 
 // JSON-RPC flags
 const (
-	JSONRPCEnable          = "json-rpc.enable"
-	JSONRPCAPI             = "json-rpc.api"
-	JSONRPCAddress         = "json-rpc.address"
-	JSONWsAddress          = "json-rpc.ws-address"
-	JSONRPCGasCap          = "json-rpc.gas-cap"
-	JSONRPCEVMTimeout      = "json-rpc.evm-timeout"
-	JSONRPCTxFeeCap        = "json-rpc.txfee-cap"
-	JSONRPCFilterCap       = "json-rpc.filter-cap"
-	JSONRPCLogsCap         = "json-rpc.logs-cap"
-	JSONRPCBlockRangeCap   = "json-rpc.block-range-cap"
-	JSONRPCHTTPTimeout     = "json-rpc.http-timeout"
-	JSONRPCHTTPIdleTimeout = "json-rpc.http-idle-timeout"
+	JSONRPCEnable             = "json-rpc.enable"
+	JSONRPCAPI                = "json-rpc.api"
+	JSONRPCAddress            = "json-rpc.address"
+	JSONWsAddress             = "json-rpc.ws-address"
+	JSONRPCGasCap             = "json-rpc.gas-cap"
+	JSONRPCEVMTimeout         = "json-rpc.evm-timeout"
+	JSONRPCTxFeeCap           = "json-rpc.txfee-cap"
+	JSONRPCFilterCap          = "json-rpc.filter-cap"
+	JSONRPCLogsCap            = "json-rpc.logs-cap"
+	JSONRPCBlockRangeCap      = "json-rpc.block-range-cap"
+	JSONRPCTraceBlockRangeCap = "json-rpc.trace-block-range-cap"
+	JSONRPCHTTPTimeout        = "json-rpc.http-timeout"
+	JSONRPCHTTPIdleTimeout    = "json-rpc.http-idle-timeout"
+	JSONRPCEnableIndexer      = "json-rpc.enable-indexer"
 )
 
 // EVM flags
@@ -96,6 +100,17 @@ func AddGQLFlags(cmd *cobra.Command) *cobra.Command {
 	cmd.PersistentFlags().String("gql-playground-api-base", "", "GQL API base path to use in GQL playground.")
 	cmd.PersistentFlags().String("gql-port", "9473", "Port to use for the GQL server.")
 	cmd.PersistentFlags().String("log-file", "", "File to tail for GQL 'getLogs' API.")
+	cmd.PersistentFlags().Bool("gql-diagnostics", false, "Enable the GQL 'getLogs' API and node diagnostics (requires --gql-admin-token).")
+	cmd.PersistentFlags().String("gql-admin-token", "", "Bearer token required by the GQL 'getLogs' API and node diagnostics.")
+	cmd.PersistentFlags().Int("gql-max-complexity", gql.DefaultMaxComplexity, "Max complexity of a GQL operation (0 to disable).")
+	cmd.PersistentFlags().Int("gql-max-depth", gql.DefaultMaxDepth, "Max depth of a GQL operation (0 to disable).")
+	cmd.PersistentFlags().Duration("gql-timeout", gql.DefaultTimeout, "Time limit of a GQL request, except subscriptions (0 to disable).")
+	cmd.PersistentFlags().Float64("gql-rate-limit", 0, "Max GQL requests per second per client IP (0 to disable).")
+	cmd.PersistentFlags().Int("gql-rate-burst", gql.DefaultRateBurst, "Number of GQL requests a client IP can burst above the rate limit.")
+	cmd.PersistentFlags().StringSlice("gql-api-keys", nil, "API keys accepted by the GQL server in the X-API-Key header (requires authentication if set).")
+	cmd.PersistentFlags().String("gql-jwt-secret", "", "HMAC secret of the JWTs accepted by the GQL server as bearer tokens (requires authentication if set).")
+	cmd.PersistentFlags().StringSlice("gql-cors-allowed-origins", []string{"*"}, "Origins allowed to make cross-origin GQL requests.")
+	cmd.PersistentFlags().Bool("gql-tls", false, "Serve GQL over TLS, with the certificate and key of the app config [tls] section.")
 
 	return cmd
 }
@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/service"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+const (
+	ServiceName = "EVMIndexerService"
+
+	NewBlockWaitTimeout = 60 * time.Second
+)
+
+// EVMIndexerService indexes the committed blocks into the custom EVM indexer. On start, it backfills the
+// blocks committed since the last indexed one, from the earliest block kept by the node if the indexer is
+// empty, then it follows the new blocks.
+type EVMIndexerService struct {
+	service.BaseService
+
+	txIdxr ethermint.EVMTxIndexer
+	client rpcclient.Client
+	cancel context.CancelFunc
+}
+
+// NewEVMIndexerService returns a new service instance.
+func NewEVMIndexerService(
+	txIdxr ethermint.EVMTxIndexer,
+	client rpcclient.Client,
+) *EVMIndexerService {
+	is := &EVMIndexerService{txIdxr: txIdxr, client: client}
+	is.BaseService = *service.NewBaseService(nil, ServiceName, is)
+	return is
+}
+
+// OnStart implements service.Service by subscribing for new blocks
+// and indexing them by events.
+func (eis *EVMIndexerService) OnStart() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	eis.cancel = cancel
+
+	status, err := eis.client.Status(ctx)
+	if err != nil {
+		return err
+	}
+	latestBlock := status.SyncInfo.LatestBlockHeight
+	newBlockSignal := make(chan struct{}, 1)
+
+	// Use SubscribeUnbuffered here to ensure the subscription does not get
+	// canceled due to not pulling messages fast enough.
+	blockHeadersChan, err := eis.client.Subscribe(
+		ctx,
+		ServiceName,
+		tmtypes.QueryForEvent(tmtypes.EventNewBlockHeaderValue).String(),
+		0)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg := <-blockHeadersChan:
+				eventDataHeader, ok := msg.Data.(tmtypes.EventDataNewBlockHeader)
+				if !ok {
+					continue
+				}
+				if eventDataHeader.Header.Height > atomic.LoadInt64(&latestBlock) {
+					atomic.StoreInt64(&latestBlock, eventDataHeader.Header.Height)
+					// notify
+					select {
+					case newBlockSignal <- struct{}{}:
+					default:
+					}
+				}
+			}
+		}
+	}()
+
+	lastBlock, err := eis.txIdxr.LastIndexedBlock()
+	if err != nil {
+		return err
+	}
+	if lastBlock == -1 {
+		// backfill from the earliest block kept by the node
+		lastBlock = status.SyncInfo.EarliestBlockHeight - 1
+		if lastBlock < 0 {
+			lastBlock = 0
+		}
+	}
+
+	go func() {
+		for {
+			latest := atomic.LoadInt64(&latestBlock)
+			if latest <= lastBlock {
+				// nothing to index. wait for signal of new block
+				select {
+				case <-ctx.Done():
+					return
+				case <-newBlockSignal:
+				case <-time.After(NewBlockWaitTimeout):
+					// no new block event for a while, poll the node in case the subscription is lost
+					if status, err := eis.client.Status(ctx); err == nil && status.SyncInfo.LatestBlockHeight > latest {
+						atomic.StoreInt64(&latestBlock, status.SyncInfo.LatestBlockHeight)
+					}
+				}
+				continue
+			}
+			for i := lastBlock + 1; i <= latest; i++ {
+				if err := eis.indexBlock(ctx, i); err != nil {
+					eis.Logger.Error("failed to index block", "height", i, "err", err)
+					// retry later, avoid spinning on a block that can't be indexed yet
+					select {
+					case <-ctx.Done():
+						return
+					case <-time.After(time.Second):
+					}
+					break
+				}
+				lastBlock = i
+			}
+		}
+	}()
+	return nil
+}
+
+// OnStop implements service.Service by stopping the indexing goroutines.
+func (eis *EVMIndexerService) OnStop() {
+	if eis.cancel != nil {
+		eis.cancel()
+	}
+}
+
+// indexBlock fetches the block and its results from the node, and indexes them.
+func (eis *EVMIndexerService) indexBlock(ctx context.Context, height int64) error {
+	block, err := eis.client.Block(ctx, &height)
+	if err != nil {
+		return err
+	}
+	blockResult, err := eis.client.BlockResults(ctx, &height)
+	if err != nil {
+		return err
+	}
+	return eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults, blockResult.EndBlockEvents)
+}
+
+// OpenIndexerDB opens the custom EVM indexer db in the data dir of the node.
+func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmindexer", backendType, dataDir)
+}
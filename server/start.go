@@ -36,12 +36,15 @@ import (
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/rpc/client/local"
 	tmtypes "github.com/tendermint/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
 
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	"github.com/tharsis/ethermint/gql"
+	"github.com/tharsis/ethermint/indexer"
 	ethdebug "github.com/tharsis/ethermint/rpc/ethereum/namespaces/debug"
 	"github.com/tharsis/ethermint/server/config"
 	srvflags "github.com/tharsis/ethermint/server/flags"
+	ethermint "github.com/tharsis/ethermint/types"
 )
 
 const (
@@ -171,6 +174,8 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, config.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, config.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().Int32(srvflags.JSONRPCTraceBlockRangeCap, config.DefaultTraceBlockRangeCap, "Sets the max block range allowed for `trace_filter` query, 0 means no limit")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom EVM tx and logs indexer for the json-rpc")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)")
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")
@@ -429,8 +434,34 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, appCreator ty
 	var (
 		httpSrv     *http.Server
 		httpSrvDone chan struct{}
+		idxer       ethermint.EVMTxIndexer
 	)
 
+	if config.JSONRPC.Enable && config.JSONRPC.EnableIndexer {
+		idxDB, err := OpenIndexerDB(home, tmdb.BackendType(cfg.DBBackend))
+		if err != nil {
+			logger.Error("failed to open evm indexer DB", "error", err.Error())
+			return err
+		}
+		defer func() {
+			if err := idxDB.Close(); err != nil {
+				logger.Error("error closing evm indexer db", "error", err.Error())
+			}
+		}()
+
+		idxLogger := ctx.Logger.With("module", "evmindex")
+		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		indexerService := NewEVMIndexerService(idxer, clientCtx.Client)
+		indexerService.SetLogger(idxLogger)
+
+		if err := indexerService.Start(); err != nil {
+			return err
+		}
+		defer func() {
+			_ = indexerService.Stop()
+		}()
+	}
+
 	if config.JSONRPC.Enable {
 		genDoc, err := tmtypes.GenesisDocFromFile(cfg.GenesisFile())
 		if err != nil {
@@ -441,14 +472,16 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, appCreator ty
 
 		tmEndpoint := "/websocket"
 		tmRPCAddr := cfg.RPC.ListenAddress
-		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, config)
+		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, config, idxer)
 		if err != nil {
 			return err
 		}
 	}
 
-	// Start the GQL Server
-	go gql.Server(clientCtx)
+	gqlSrv, gqlSrvDone, err := gql.StartServer(clientCtx, config)
+	if err != nil {
+		return err
+	}
 
 	defer func() {
 		if tmNode.IsRunning() {
@@ -487,6 +520,21 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, appCreator ty
 			}
 		}
 
+		if gqlSrv != nil {
+			shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancelFn()
+
+			if err := gqlSrv.Shutdown(shutdownCtx); err != nil {
+				logger.Error("GQL server shutdown produced a warning", "error", err.Error())
+			} else {
+				logger.Info("GQL server shut down, waiting 5 sec")
+				select {
+				case <-time.Tick(5 * time.Second):
+				case <-gqlSrvDone:
+				}
+			}
+		}
+
 		logger.Info("Bye!")
 	}()
 
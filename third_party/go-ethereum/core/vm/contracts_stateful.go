@@ -0,0 +1,111 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// StatefulPrecompiledContract is a precompiled contract of an EVM instance, which runs
+// with the context of its call.
+type StatefulPrecompiledContract interface {
+	// RequiredGas returns the gas charged before the contract runs.
+	RequiredGas(input []byte) uint64
+	// RunStateful runs a call of the contract with the gas left once RequiredGas is
+	// charged, and returns the gas left. The state changes of the call are reverted
+	// if it returns an error.
+	RunStateful(evm *EVM, call PrecompileCall, input []byte, gas uint64) (ret []byte, leftOverGas uint64, err error)
+}
+
+// PrecompileCall is the context of a stateful precompiled contract call.
+type PrecompileCall struct {
+	// Type is the call opcode: CALL, CALLCODE, DELEGATECALL or STATICCALL.
+	Type OpCode
+	// Caller is the address of the calling account or contract.
+	Caller common.Address
+	// Value is the value sent with the call, it's zero for the delegate and static calls.
+	Value *big.Int
+	// ReadOnly is whether the call can't change the state: a static call, or a call
+	// in a static context.
+	ReadOnly bool
+}
+
+// WithPrecompiles sets the stateful precompiled contracts of the EVM, on top of the
+// precompiled contracts of the chain rules. It must be called before the EVM runs,
+// and panics if an address is a precompiled contract of the chain rules.
+func (evm *EVM) WithPrecompiles(precompiles map[common.Address]StatefulPrecompiledContract) {
+	evm.precompiles = nil
+	evm.precompileAddresses = make([]common.Address, 0, len(precompiles))
+
+	for addr := range precompiles {
+		if _, ok := evm.precompile(addr); ok {
+			panic(fmt.Sprintf("stateful precompiled contract %s conflicts with a precompiled contract", addr))
+		}
+		evm.precompileAddresses = append(evm.precompileAddresses, addr)
+	}
+	sort.Slice(evm.precompileAddresses, func(i, j int) bool {
+		return bytes.Compare(evm.precompileAddresses[i].Bytes(), evm.precompileAddresses[j].Bytes()) < 0
+	})
+
+	evm.precompiles = precompiles
+}
+
+// ActivePrecompiles returns the addresses of the precompiled contracts of the EVM: the
+// ones of the chain rules, followed by its stateful precompiled contracts.
+func (evm *EVM) ActivePrecompiles() []common.Address {
+	addresses := ActivePrecompiles(evm.chainRules)
+	if len(evm.precompileAddresses) == 0 {
+		return addresses
+	}
+
+	active := make([]common.Address, 0, len(addresses)+len(evm.precompileAddresses))
+	active = append(active, addresses...)
+	return append(active, evm.precompileAddresses...)
+}
+
+// runPrecompile runs the precompiled contract at addr: a stateful precompiled contract
+// of the EVM, or p, the one of the chain rules.
+func (evm *EVM) runPrecompile(p PrecompiledContract, addr common.Address, call PrecompileCall, input []byte, gas uint64) ([]byte, uint64, error) {
+	if sp, ok := evm.precompiles[addr]; ok {
+		return RunStatefulPrecompiledContract(evm, sp, call, input, gas)
+	}
+	return RunPrecompiledContract(p, input, gas)
+}
+
+// RunStatefulPrecompiledContract runs and evaluates the output of a stateful precompiled
+// contract. The required gas is charged before the contract runs.
+// It returns
+// - the returned bytes,
+// - the _remaining_ gas,
+// - any error that occurred
+func RunStatefulPrecompiledContract(evm *EVM, p StatefulPrecompiledContract, call PrecompileCall, input []byte, suppliedGas uint64) (ret []byte, remainingGas uint64, err error) {
+	gasCost := p.RequiredGas(input)
+	if suppliedGas < gasCost {
+		return nil, 0, ErrOutOfGas
+	}
+	suppliedGas -= gasCost
+	output, leftOverGas, err := p.RunStateful(evm, call, input, suppliedGas)
+	if leftOverGas > suppliedGas {
+		return nil, 0, ErrOutOfGas
+	}
+	return output, leftOverGas, err
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// EVMTxIndexer defines the interface of the custom EVM indexer, which indexes the ethereum
+// transactions, receipts and logs of the committed blocks.
+type EVMTxIndexer interface {
+	// LastIndexedBlock returns the last block number which was indexed and flushed into database.
+	// Returns -1 if the db is empty.
+	LastIndexedBlock() (int64, error)
+	// FirstIndexedBlock returns the first indexed block number, returns -1 if the db is empty.
+	FirstIndexedBlock() (int64, error)
+
+	// IndexBlock indexes all the ethereum txs and logs of the block, the end block events are
+	// needed for the logs emitted by the modules at the end of the block.
+	IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx, endBlockEvents []abci.Event) error
+
+	// GetByTxHash returns the TxResult by the ethereum tx hash.
+	GetByTxHash(common.Hash) (*TxResult, error)
+	// GetByBlockAndIndex returns the TxResult by the block number and the index of the ethereum tx.
+	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+
+	// GetLogsByHeight returns the logs of the block grouped by tx, in the same layout as the logs
+	// decoded from the block results.
+	GetLogsByHeight(height int64) ([][]*ethtypes.Log, error)
+	// FilterLogs returns the logs of the blocks in the [from, to] range matching the addresses and
+	// topics criteria, it errors if more than limit logs are matched.
+	FilterLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
+}
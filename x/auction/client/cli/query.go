@@ -26,6 +26,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdGetAuction(),
 		GetCmdGetBid(),
 		GetCmdGetBids(),
+		GetCmdBids(),
 		GetCmdAuctionsByBidder(),
 		GetCmdAuctionsByOwner(),
 		GetCmdQueryParams(),
@@ -261,3 +262,65 @@ func GetCmdBalance() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdBids is the parent command for the bids of the --from key.
+func GetCmdBids() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "bids",
+		Short:                      "Bids of a local key.",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(GetCmdPendingBids())
+
+	return cmd
+}
+
+// GetCmdPendingBids lists the unrevealed bids of the --from key.
+func GetCmdPendingBids() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending",
+		Short: "List auctions with bids that still need to be revealed.",
+		Long: `List auctions with bids that still need to be revealed.
+
+Bids are due once the auction is in the reveal phase. Bids without a stored reveal can't be revealed with reveal-all.`,
+		Example: fmt.Sprintf("$ %s query %s bids pending --from bidder", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// The tx context resolves the --from key from the keyring.
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Unlike the query context, the tx context doesn't read --height.
+			height, err := cmd.Flags().GetInt64(flags.FlagHeight)
+			if err != nil {
+				return err
+			}
+			clientCtx = clientCtx.WithHeight(height)
+
+			store, err := newRevealStoreFromCmd(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			pendingBids, err := getPendingBids(cmd.Context(), types.NewQueryClient(clientCtx), store)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(pendingBids)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of the bidder key")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	cmd.Flags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
+	flags.AddQueryFlagsToCmd(cmd)
+	addRevealPassphraseFlag(cmd)
+
+	return cmd
+}
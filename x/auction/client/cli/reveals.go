@@ -0,0 +1,345 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/xsalsa20symmetric"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/argon2"
+
+	"github.com/tharsis/ethermint/x/auction/types"
+)
+
+// RevealsDirName is the directory under the keyring home where encrypted bid reveals are kept, one directory per auction.
+const RevealsDirName = "auction-reveals"
+
+// ReserveRevealsDirName is the directory under the reveals directory where encrypted reserve price reveals are kept.
+const ReserveRevealsDirName = "reserve"
+
+// revealSecretMessage is signed by the bidder key to derive the reveal encryption secret,
+// so that only the key owner can read the stored reveals. It also salts the passphrase secrets.
+const revealSecretMessage = "auction bid reveal encryption"
+
+// revealStore manages the encrypted bid reveals of a bidder key.
+//
+// Local keys encrypt the reveals with a secret derived from their signature of a fixed message. Keys that
+// can't sign it (ledger, offline and multisig keys) use a secret derived from a reveal passphrase instead.
+type revealStore struct {
+	dir    string
+	bidder string
+	secret []byte
+
+	// deriveSecret derives the secret when a reveal is first encrypted or decrypted.
+	deriveSecret func() ([]byte, error)
+}
+
+// addRevealPassphraseFlag adds the --reveal-passphrase flag to a command using the reveal store.
+func addRevealPassphraseFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagRevealPassphrase, "", "Passphrase encrypting the stored reveals of keys that can't sign, e.g. ledger keys (prompted if omitted)")
+}
+
+// newRevealStoreFromCmd opens the reveal store of the --from key, with the passphrase of the --reveal-passphrase flag.
+func newRevealStoreFromCmd(cmd *cobra.Command, clientCtx client.Context) (*revealStore, error) {
+	passphrase, err := cmd.Flags().GetString(FlagRevealPassphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRevealStore(clientCtx, passphrase)
+}
+
+// newRevealStore opens the reveal store of the --from key. The passphrase is only used by keys that can't
+// sign, it's prompted for if empty.
+func newRevealStore(clientCtx client.Context, passphrase string) (*revealStore, error) {
+	if clientCtx.Keyring == nil || clientCtx.GetFromName() == "" {
+		return nil, fmt.Errorf("a keyring key (--from) is required to manage bid reveals")
+	}
+
+	record, err := clientCtx.Keyring.Key(clientCtx.GetFromName())
+	if err != nil {
+		return nil, err
+	}
+
+	dir := clientCtx.KeyringDir
+	if dir == "" {
+		dir = clientCtx.HomeDir
+	}
+
+	store := &revealStore{
+		dir:    filepath.Join(dir, RevealsDirName),
+		bidder: clientCtx.GetFromAddress().String(),
+	}
+
+	if record.GetType() == keyring.TypeLocal {
+		store.deriveSecret = func() ([]byte, error) {
+			// Signatures are deterministic, so the derived secret is stable across invocations.
+			sig, _, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), []byte(revealSecretMessage))
+			if err != nil {
+				return nil, err
+			}
+
+			secret := sha256.Sum256(sig)
+			return secret[:], nil
+		}
+	} else {
+		store.deriveSecret = func() ([]byte, error) {
+			return store.passphraseSecret(passphrase, clientCtx.Input)
+		}
+	}
+
+	return store, nil
+}
+
+func (s *revealStore) getSecret() ([]byte, error) {
+	if s.secret == nil {
+		secret, err := s.deriveSecret()
+		if err != nil {
+			return nil, err
+		}
+
+		s.secret = secret
+	}
+
+	return s.secret, nil
+}
+
+func (s *revealStore) passphraseCheckPath() string {
+	return filepath.Join(s.dir, fmt.Sprintf("%s.check", s.bidder))
+}
+
+// passphraseSecret derives the secret of a reveal passphrase. The first passphrase used by the key is
+// recorded in a check file, later passphrases that don't match it are rejected, so that reveals are never
+// saved with a mistyped passphrase.
+func (s *revealStore) passphraseSecret(passphrase string, in io.Reader) ([]byte, error) {
+	_, err := os.Stat(s.passphraseCheckPath())
+	isNew := os.IsNotExist(err)
+
+	if passphrase == "" {
+		if in == nil {
+			return nil, fmt.Errorf("the --from key can't sign, a reveal passphrase (--%s) is required", FlagRevealPassphrase)
+		}
+
+		buf := bufio.NewReader(in)
+		passphrase, err = input.GetPassword("Enter reveal passphrase:", buf)
+		if err != nil {
+			return nil, err
+		}
+
+		if isNew {
+			confirmation, err := input.GetPassword("Re-enter reveal passphrase:", buf)
+			if err != nil {
+				return nil, err
+			}
+
+			if passphrase != confirmation {
+				return nil, fmt.Errorf("reveal passphrases don't match")
+			}
+		}
+	}
+
+	secret := argon2.IDKey([]byte(passphrase), []byte(revealSecretMessage+s.bidder), 1, 64*1024, 4, 32)
+
+	if isNew {
+		if err := os.MkdirAll(s.dir, 0700); err != nil {
+			return nil, err
+		}
+
+		if err := ioutil.WriteFile(s.passphraseCheckPath(), xsalsa20symmetric.EncryptSymmetric([]byte(s.bidder), secret), 0600); err != nil {
+			return nil, err
+		}
+
+		return secret, nil
+	}
+
+	ciphertext, err := ioutil.ReadFile(s.passphraseCheckPath())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := xsalsa20symmetric.DecryptSymmetric(ciphertext, secret); err != nil {
+		return nil, fmt.Errorf("invalid reveal passphrase")
+	}
+
+	return secret, nil
+}
+
+func (s *revealStore) encrypt(plaintext []byte) ([]byte, error) {
+	secret, err := s.getSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	return xsalsa20symmetric.EncryptSymmetric(plaintext, secret), nil
+}
+
+func (s *revealStore) decrypt(ciphertext []byte) ([]byte, error) {
+	secret, err := s.getSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	return xsalsa20symmetric.DecryptSymmetric(ciphertext, secret)
+}
+
+func (s *revealStore) path(auctionID string, commitHash string) string {
+	return filepath.Join(s.dir, auctionID, fmt.Sprintf("%s-%s.enc", s.bidder, commitHash))
+}
+
+// Save stores the reveal of a committed bid. Reveals are kept per commit hash, so that
+// updating a bid never overwrites the reveal of a commit that is still on-chain.
+func (s *revealStore) Save(auctionID string, commitHash string, reveal []byte) error {
+	ciphertext, err := s.encrypt(reveal)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Join(s.dir, auctionID), 0700); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(s.path(auctionID, commitHash), ciphertext, 0600)
+}
+
+// Load returns the reveal of a committed bid.
+func (s *revealStore) Load(auctionID string, commitHash string) ([]byte, error) {
+	ciphertext, err := ioutil.ReadFile(s.path(auctionID, commitHash))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decrypt(ciphertext)
+}
+
+// HasReveal returns true if the reveal of the commit is stored.
+func (s *revealStore) HasReveal(auctionID string, commitHash string) bool {
+	_, err := os.Stat(s.path(auctionID, commitHash))
+	return err == nil
+}
+
+func (s *revealStore) reservePath(reservePriceHash string) string {
+	return filepath.Join(s.dir, ReserveRevealsDirName, fmt.Sprintf("%s-%s.enc", s.bidder, reservePriceHash))
+}
+
+// SaveReserve stores the reveal of a hidden reserve price. The auction ID isn't known before the
+// auction is created, so reserve reveals are kept by reserve price hash.
+func (s *revealStore) SaveReserve(reservePriceHash string, reveal []byte) error {
+	ciphertext, err := s.encrypt(reveal)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Join(s.dir, ReserveRevealsDirName), 0700); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(s.reservePath(reservePriceHash), ciphertext, 0600)
+}
+
+// LoadReserve returns the reveal of a hidden reserve price.
+func (s *revealStore) LoadReserve(reservePriceHash string) ([]byte, error) {
+	ciphertext, err := ioutil.ReadFile(s.reservePath(reservePriceHash))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decrypt(ciphertext)
+}
+
+// PendingBid is a committed bid that hasn't been revealed yet.
+type PendingBid struct {
+	AuctionID      string `json:"auction_id" yaml:"auction_id"`
+	AuctionStatus  string `json:"auction_status" yaml:"auction_status"`
+	CommitHash     string `json:"commit_hash" yaml:"commit_hash"`
+	RevealsEndTime string `json:"reveals_end_time" yaml:"reveals_end_time"`
+	// Due is true if the auction is in the reveal phase.
+	Due bool `json:"due" yaml:"due"`
+	// HasReveal is true if the reveal is stored locally.
+	HasReveal bool `json:"has_reveal" yaml:"has_reveal"`
+}
+
+// getPendingBids returns the unrevealed bids of the bidder in auctions that are still in the commit or reveal phase.
+func getPendingBids(ctx context.Context, queryClient types.QueryClient, store *revealStore) ([]PendingBid, error) {
+	res, err := queryClient.AuctionsByBidder(ctx, &types.AuctionsByBidderRequest{BidderAddress: store.bidder})
+	if err != nil {
+		return nil, err
+	}
+
+	pendingBids := []PendingBid{}
+	if res.Auctions == nil {
+		return pendingBids, nil
+	}
+
+	for _, auction := range res.Auctions.Auctions {
+		if auction.Status != types.AuctionStatusCommitPhase && auction.Status != types.AuctionStatusRevealPhase {
+			continue
+		}
+
+		bidRes, err := queryClient.GetBid(ctx, &types.BidRequest{AuctionId: auction.Id, Bidder: store.bidder})
+		if err != nil {
+			return nil, err
+		}
+
+		bid := bidRes.Bid
+		if bid == nil || bid.Status != types.BidStatusCommitted {
+			continue
+		}
+
+		pendingBids = append(pendingBids, PendingBid{
+			AuctionID:      auction.Id,
+			AuctionStatus:  auction.Status,
+			CommitHash:     bid.CommitHash,
+			RevealsEndTime: auction.GetRevealsEndTime(),
+			Due:            auction.Status == types.AuctionStatusRevealPhase,
+			HasReveal:      store.HasReveal(auction.Id, bid.CommitHash),
+		})
+	}
+
+	return pendingBids, nil
+}
+
+// getRevealMsgs returns the reveal msgs of the due pending bids with a stored reveal, the bids without
+// a stored reveal are reported to errOut.
+func getRevealMsgs(pendingBids []PendingBid, store *revealStore, bidder sdk.AccAddress, errOut io.Writer) ([]sdk.Msg, error) {
+	var msgs []sdk.Msg
+	for _, pendingBid := range pendingBids {
+		if !pendingBid.Due {
+			continue
+		}
+
+		if !pendingBid.HasReveal {
+			fmt.Fprintf(errOut, "Skipping auction %s, no stored reveal for commit %s.\n", pendingBid.AuctionID, pendingBid.CommitHash)
+			continue
+		}
+
+		revealBytes, err := store.Load(pendingBid.AuctionID, pendingBid.CommitHash)
+		if err != nil {
+			return nil, err
+		}
+
+		msg := types.NewMsgRevealBid(pendingBid.AuctionID, hex.EncodeToString(revealBytes), bidder)
+		err = msg.ValidateBasic()
+		if err != nil {
+			return nil, err
+		}
+
+		msgs = append(msgs, &msg)
+	}
+
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("no bids due for reveal")
+	}
+
+	return msgs, nil
+}
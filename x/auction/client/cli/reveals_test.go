@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/tharsis/ethermint/x/auction/types"
+)
+
+func newTestKeyring(t *testing.T) keyring.Keyring {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	return keyring.NewInMemory(codec.NewProtoCodec(registry))
+}
+
+func newTestClientCtx(t *testing.T, kr keyring.Keyring, dir string, name string) client.Context {
+	record, err := kr.Key(name)
+	require.NoError(t, err)
+	address, err := record.GetAddress()
+	require.NoError(t, err)
+
+	return client.Context{}.WithKeyring(kr).WithKeyringDir(dir).WithFromName(name).WithFromAddress(address)
+}
+
+func TestRevealStoreLocalKey(t *testing.T) {
+	kr := newTestKeyring(t)
+	dir := t.TempDir()
+
+	_, _, err := kr.NewMnemonic("bidder", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("other", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	store, err := newRevealStore(newTestClientCtx(t, kr, dir, "bidder"), "")
+	require.NoError(t, err)
+
+	require.False(t, store.HasReveal("auction-1", "commit-1"))
+	require.NoError(t, store.Save("auction-1", "commit-1", []byte("reveal-1")))
+	require.NoError(t, store.SaveReserve("reserve-1", []byte("reserve")))
+	require.True(t, store.HasReveal("auction-1", "commit-1"))
+
+	// The reveals are encrypted.
+	ciphertext, err := ioutil.ReadFile(store.path("auction-1", "commit-1"))
+	require.NoError(t, err)
+	require.NotContains(t, string(ciphertext), "reveal-1")
+
+	// The secret is stable across invocations, the passphrase isn't used by local keys.
+	store, err = newRevealStore(newTestClientCtx(t, kr, dir, "bidder"), "passphrase")
+	require.NoError(t, err)
+
+	reveal, err := store.Load("auction-1", "commit-1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("reveal-1"), reveal)
+
+	reveal, err = store.LoadReserve("reserve-1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("reserve"), reveal)
+
+	_, err = store.Load("auction-1", "commit-2")
+	require.Error(t, err)
+
+	// Other keys can't read the reveals.
+	other, err := newRevealStore(newTestClientCtx(t, kr, dir, "other"), "")
+	require.NoError(t, err)
+	other.bidder = store.bidder
+
+	_, err = other.Load("auction-1", "commit-1")
+	require.Error(t, err)
+}
+
+func TestRevealStorePassphrase(t *testing.T) {
+	kr := newTestKeyring(t)
+	dir := t.TempDir()
+
+	// Offline keys (like ledger and multisig keys) can't sign the reveal secret message.
+	_, err := kr.SaveOfflineKey("bidder", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	clientCtx := newTestClientCtx(t, kr, dir, "bidder")
+
+	store, err := newRevealStore(clientCtx, "")
+	require.NoError(t, err)
+	require.Error(t, store.Save("auction-1", "commit-1", []byte("reveal-1")))
+
+	store, err = newRevealStore(clientCtx, "passphrase-1")
+	require.NoError(t, err)
+	require.NoError(t, store.Save("auction-1", "commit-1", []byte("reveal-1")))
+
+	reveal, err := store.Load("auction-1", "commit-1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("reveal-1"), reveal)
+
+	// A passphrase that doesn't match the first one is rejected, before anything is saved with it.
+	store, err = newRevealStore(clientCtx, "passphrase-2")
+	require.NoError(t, err)
+	require.Error(t, store.Save("auction-1", "commit-2", []byte("reveal-2")))
+	require.False(t, store.HasReveal("auction-1", "commit-2"))
+
+	_, err = store.Load("auction-1", "commit-1")
+	require.Error(t, err)
+
+	// The passphrase is prompted for if not given.
+	store, err = newRevealStore(clientCtx.WithInput(strings.NewReader("passphrase-1\n")), "")
+	require.NoError(t, err)
+
+	reveal, err = store.Load("auction-1", "commit-1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("reveal-1"), reveal)
+
+	// The first passphrase of a key is prompted for twice.
+	_, err = kr.SaveOfflineKey("new", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	store, err = newRevealStore(newTestClientCtx(t, kr, dir, "new").WithInput(strings.NewReader("passphrase-1\npassphrase-2\n")), "")
+	require.NoError(t, err)
+	require.Error(t, store.Save("auction-1", "commit-1", []byte("reveal-1")))
+
+	store, err = newRevealStore(newTestClientCtx(t, kr, dir, "new").WithInput(strings.NewReader("passphrase-1\npassphrase-1\n")), "")
+	require.NoError(t, err)
+	require.NoError(t, store.Save("auction-1", "commit-1", []byte("reveal-1")))
+}
+
+type mockQueryClient struct {
+	types.QueryClient
+
+	auctions []types.Auction
+	bids     map[string]*types.Bid
+}
+
+func (c mockQueryClient) AuctionsByBidder(_ context.Context, _ *types.AuctionsByBidderRequest, _ ...grpc.CallOption) (*types.AuctionsByBidderResponse, error) {
+	return &types.AuctionsByBidderResponse{Auctions: &types.Auctions{Auctions: c.auctions}}, nil
+}
+
+func (c mockQueryClient) GetBid(_ context.Context, req *types.BidRequest, _ ...grpc.CallOption) (*types.BidResponse, error) {
+	return &types.BidResponse{Bid: c.bids[req.AuctionId]}, nil
+}
+
+func TestPendingBidsAndRevealMsgs(t *testing.T) {
+	kr := newTestKeyring(t)
+	_, _, err := kr.NewMnemonic("bidder", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	clientCtx := newTestClientCtx(t, kr, t.TempDir(), "bidder")
+
+	store, err := newRevealStore(clientCtx, "")
+	require.NoError(t, err)
+
+	queryClient := mockQueryClient{
+		auctions: []types.Auction{
+			{Id: "commit", Status: types.AuctionStatusCommitPhase},
+			{Id: "reveal", Status: types.AuctionStatusRevealPhase},
+			{Id: "reveal-no-reveal", Status: types.AuctionStatusRevealPhase},
+			{Id: "revealed", Status: types.AuctionStatusRevealPhase},
+			{Id: "completed", Status: types.AuctionStatusCompleted},
+		},
+		bids: map[string]*types.Bid{
+			"commit":           {CommitHash: "commit-1", Status: types.BidStatusCommitted},
+			"reveal":           {CommitHash: "commit-2", Status: types.BidStatusCommitted},
+			"reveal-no-reveal": {CommitHash: "commit-3", Status: types.BidStatusCommitted},
+			"revealed":         {CommitHash: "commit-4", Status: types.BidStatusRevealed},
+			"completed":        {CommitHash: "commit-5", Status: types.BidStatusCommitted},
+		},
+	}
+
+	require.NoError(t, store.Save("commit", "commit-1", []byte("reveal-1")))
+	require.NoError(t, store.Save("reveal", "commit-2", []byte("reveal-2")))
+	// The reveal of an earlier commit of the bid isn't used.
+	require.NoError(t, store.Save("reveal-no-reveal", "commit-0", []byte("reveal-0")))
+
+	pendingBids, err := getPendingBids(context.Background(), queryClient, store)
+	require.NoError(t, err)
+	require.Len(t, pendingBids, 3)
+
+	require.Equal(t, "commit", pendingBids[0].AuctionID)
+	require.False(t, pendingBids[0].Due)
+	require.True(t, pendingBids[0].HasReveal)
+
+	require.Equal(t, "reveal", pendingBids[1].AuctionID)
+	require.True(t, pendingBids[1].Due)
+	require.True(t, pendingBids[1].HasReveal)
+
+	require.Equal(t, "reveal-no-reveal", pendingBids[2].AuctionID)
+	require.True(t, pendingBids[2].Due)
+	require.False(t, pendingBids[2].HasReveal)
+
+	// Only the due bids with a stored reveal are revealed, the others are reported.
+	var errOut bytes.Buffer
+	msgs, err := getRevealMsgs(pendingBids, store, clientCtx.GetFromAddress(), &errOut)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+
+	msg := types.NewMsgRevealBid("reveal", hex.EncodeToString([]byte("reveal-2")), clientCtx.GetFromAddress())
+	require.Equal(t, &msg, msgs[0])
+	require.Contains(t, errOut.String(), "reveal-no-reveal")
+
+	_, err = getRevealMsgs(pendingBids[:1], store, clientCtx.GetFromAddress(), &errOut)
+	require.Error(t, err)
+}
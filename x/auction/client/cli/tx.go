@@ -2,6 +2,7 @@ package cli
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"time"
@@ -12,13 +13,24 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 
 	"github.com/tharsis/ethermint/x/auction/types"
 
 	wnsUtils "github.com/tharsis/ethermint/utils"
 )
 
+const (
+	FlagKind            = "kind"
+	FlagStartPrice      = "start-price"
+	FlagBidIncrement    = "bid-increment"
+	FlagExtensionWindow = "extension-window"
+	FlagNumWinners      = "num-winners"
+	FlagReservePrice    = "reserve-price"
+
+	// FlagRevealPassphrase is the passphrase encrypting the stored reveals of keys that can't sign, e.g. ledger keys.
+	FlagRevealPassphrase = "reveal-passphrase"
+)
+
 // GetTxCmd returns transaction commands for this module.
 func GetTxCmd() *cobra.Command {
 	auctionTxCmd := &cobra.Command{
@@ -33,6 +45,10 @@ func GetTxCmd() *cobra.Command {
 		GetCmdCreateAuction(),
 		GetCmdCommitBid(),
 		GetCmdRevealBid(),
+		GetCmdRevealAll(),
+		GetCmdPlaceBid(),
+		GetCmdCancelAuction(),
+		GetCmdRevealReservePrice(),
 	)
 
 	return auctionTxCmd
@@ -42,7 +58,11 @@ func GetCmdCreateAuction() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create [commits-duration] [reveals-duration] [commit-fee] [reveal-fee] [minimum-bid]",
 		Short: "Create auction.",
-		Args:  cobra.ExactArgs(5),
+		Long: `Create auction.
+
+Sealed-bid auctions (vickrey, uniform) have a commit and a reveal phase. Open auctions
+(english, dutch) have a single bidding phase of commits-duration, reveals-duration must be 0s.`,
+		Args: cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -82,6 +102,43 @@ func GetCmdCreateAuction() *cobra.Command {
 				MinimumBid:      minimumBid,
 			}
 			msg := types.NewMsgCreateAuction(params, clientCtx.GetFromAddress())
+
+			msg.Kind, err = cmd.Flags().GetString(FlagKind)
+			if err != nil {
+				return err
+			}
+
+			if startPrice, _ := cmd.Flags().GetString(FlagStartPrice); startPrice != "" {
+				msg.StartPrice, err = sdk.ParseCoinNormalized(startPrice)
+				if err != nil {
+					return err
+				}
+			}
+
+			if bidIncrement, _ := cmd.Flags().GetString(FlagBidIncrement); bidIncrement != "" {
+				msg.BidIncrement, err = sdk.ParseCoinNormalized(bidIncrement)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg.ExtensionWindow, err = cmd.Flags().GetDuration(FlagExtensionWindow)
+			if err != nil {
+				return err
+			}
+
+			msg.NumWinners, err = cmd.Flags().GetUint64(FlagNumWinners)
+			if err != nil {
+				return err
+			}
+
+			if reservePrice, _ := cmd.Flags().GetString(FlagReservePrice); reservePrice != "" {
+				msg.ReservePriceHash, err = saveReservePriceReveal(cmd, clientCtx, reservePrice)
+				if err != nil {
+					return err
+				}
+			}
+
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -91,7 +148,15 @@ func GetCmdCreateAuction() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagKind, types.AuctionKindVickrey, "Auction kind (vickrey|english|dutch|uniform).")
+	cmd.Flags().String(FlagStartPrice, "", "Opening price of a dutch auction.")
+	cmd.Flags().String(FlagBidIncrement, "", "Minimum raise over the highest bid in an english auction.")
+	cmd.Flags().Duration(FlagExtensionWindow, 0, "Anti-sniping window of an english auction.")
+	cmd.Flags().Uint64(FlagNumWinners, 1, "Number of units sold in a uniform price auction.")
+	cmd.Flags().String(FlagReservePrice, "", fmt.Sprintf("Hidden reserve price, the reveal is encrypted with the --from key and stored in the %s directory of the keyring home.", RevealsDirName))
+
 	flags.AddTxFlagsToCmd(cmd)
+	addRevealPassphraseFlag(cmd)
 	return cmd
 }
 
@@ -100,7 +165,10 @@ func GetCmdCommitBid() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "commit-bid [auction-id] [bid-amount]",
 		Short: "Commit sealed bid.",
-		Args:  cobra.ExactArgs(2),
+		Long: fmt.Sprintf(`Commit sealed bid.
+
+The bid reveal is encrypted with the --from key and stored in the %s directory of the keyring home.`, RevealsDirName),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -117,7 +185,7 @@ func GetCmdCommitBid() *cobra.Command {
 				return err
 			}
 
-			chainID := viper.GetString("chain-id")
+			chainID := clientCtx.ChainID
 			auctionID := args[0]
 
 			reveal := map[string]interface{}{
@@ -128,13 +196,27 @@ func GetCmdCommitBid() *cobra.Command {
 				"noise":         mnemonic,
 			}
 
-			commitHash, content, err := wnsUtils.GenerateHash(reveal)
+			_, content, err := wnsUtils.GenerateHash(reveal)
+			if err != nil {
+				return err
+			}
+
+			// The commit hash must match the CID computed on-chain when the bid is revealed.
+			commitHash, err := wnsUtils.CIDFromJSONBytes(content)
+			if err != nil {
+				return err
+			}
+
+			// Save the reveal before broadcasting, so that it can't be lost after the fees are paid.
+			store, err := newRevealStoreFromCmd(cmd, clientCtx)
 			if err != nil {
 				return err
 			}
 
-			// Save reveal file.
-			ioutil.WriteFile(fmt.Sprintf("%s-%s.json", clientCtx.GetFromName(), commitHash), content, 0600)
+			err = store.Save(auctionID, commitHash, content)
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgCommitBid(auctionID, commitHash, clientCtx.GetFromAddress())
 			err = msg.ValidateBasic()
@@ -147,6 +229,7 @@ func GetCmdCommitBid() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addRevealPassphraseFlag(cmd)
 
 	return cmd
 }
@@ -156,7 +239,10 @@ func GetCmdRevealBid() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "reveal-bid [auction-id] [reveal-file-path]",
 		Short: "Reveal bid.",
-		Args:  cobra.ExactArgs(2),
+		Long: `Reveal bid.
+
+The reveal is read from the reveal store of the --from key, unless a reveal file path is given.`,
+		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -164,9 +250,13 @@ func GetCmdRevealBid() *cobra.Command {
 			}
 
 			auctionID := args[0]
-			revealFilePath := args[1]
 
-			revealBytes, err := ioutil.ReadFile(revealFilePath)
+			var revealBytes []byte
+			if len(args) > 1 {
+				revealBytes, err = ioutil.ReadFile(args[1])
+			} else {
+				revealBytes, err = loadStoredReveal(cmd, clientCtx, auctionID)
+			}
 			if err != nil {
 				return err
 			}
@@ -182,6 +272,230 @@ func GetCmdRevealBid() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addRevealPassphraseFlag(cmd)
+
+	return cmd
+}
+
+// loadStoredReveal loads the stored reveal of the on-chain bid of the --from key.
+func loadStoredReveal(cmd *cobra.Command, clientCtx client.Context, auctionID string) ([]byte, error) {
+	store, err := newRevealStoreFromCmd(cmd, clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.GetBid(cmd.Context(), &types.BidRequest{AuctionId: auctionID, Bidder: store.bidder})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Bid == nil || res.Bid.CommitHash == "" {
+		return nil, fmt.Errorf("no bid found for auction %s", auctionID)
+	}
+
+	return store.Load(auctionID, res.Bid.CommitHash)
+}
+
+// GetCmdRevealAll is the CLI command for revealing all due bids.
+func GetCmdRevealAll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-all",
+		Short: "Reveal all bids of auctions in the reveal phase, in a single transaction.",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			store, err := newRevealStoreFromCmd(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			pendingBids, err := getPendingBids(cmd.Context(), types.NewQueryClient(clientCtx), store)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := getRevealMsgs(pendingBids, store, clientCtx.GetFromAddress(), cmd.ErrOrStderr())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addRevealPassphraseFlag(cmd)
+
+	return cmd
+}
+
+// GetCmdPlaceBid is the CLI command for placing an open bid.
+func GetCmdPlaceBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-bid [auction-id] [bid-amount]",
+		Short: "Place open bid in an english or dutch auction.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bidAmount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceBid(args[0], bidAmount, clientCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// saveReservePriceReveal generates the reserve price reveal, saves it to the reveal store and returns its hash.
+func saveReservePriceReveal(cmd *cobra.Command, clientCtx client.Context, reservePriceStr string) (string, error) {
+	reservePrice, err := sdk.ParseCoinNormalized(reservePriceStr)
+	if err != nil {
+		return "", err
+	}
+
+	mnemonic, err := wnsUtils.GenerateMnemonic()
+	if err != nil {
+		return "", err
+	}
+
+	reveal := map[string]interface{}{
+		"chainId":      clientCtx.ChainID,
+		"ownerAddress": clientCtx.GetFromAddress().String(),
+		"reservePrice": reservePrice.String(),
+		"noise":        mnemonic,
+	}
+
+	content, err := json.Marshal(reveal)
+	if err != nil {
+		return "", err
+	}
+
+	reservePriceHash, err := wnsUtils.CIDFromJSONBytes(content)
+	if err != nil {
+		return "", err
+	}
+
+	// Save the reveal before broadcasting, so that it can't be lost once the auction is created.
+	store, err := newRevealStoreFromCmd(cmd, clientCtx)
+	if err != nil {
+		return "", err
+	}
+
+	err = store.SaveReserve(reservePriceHash, content)
+	if err != nil {
+		return "", err
+	}
+
+	return reservePriceHash, nil
+}
+
+// GetCmdCancelAuction is the CLI command for cancelling an auction.
+func GetCmdCancelAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [auction-id]",
+		Short: "Cancel auction in the commit phase, refunding bid fees.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAuction(args[0], clientCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRevealReservePrice is the CLI command for revealing the reserve price of an auction.
+func GetCmdRevealReservePrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-reserve [auction-id] [reveal-file-path]",
+		Short: "Reveal auction reserve price.",
+		Long: `Reveal auction reserve price.
+
+Sealed-bid auctions reveal the reserve price in the reveal phase, english auctions before bidding closes.
+The reveal is read from the reveal store of the --from key, unless a reveal file path is given.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionID := args[0]
+
+			var revealBytes []byte
+			if len(args) > 1 {
+				revealBytes, err = ioutil.ReadFile(args[1])
+			} else {
+				revealBytes, err = loadStoredReserveReveal(cmd, clientCtx, auctionID)
+			}
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealReservePrice(auctionID, hex.EncodeToString(revealBytes), clientCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addRevealPassphraseFlag(cmd)
 
 	return cmd
 }
+
+// loadStoredReserveReveal loads the stored reserve price reveal of an auction owned by the --from key.
+func loadStoredReserveReveal(cmd *cobra.Command, clientCtx client.Context, auctionID string) ([]byte, error) {
+	store, err := newRevealStoreFromCmd(cmd, clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.GetAuction(cmd.Context(), &types.AuctionRequest{Id: auctionID})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Auction == nil || res.Auction.ReservePriceHash == "" {
+		return nil, fmt.Errorf("no reserve price found for auction %s", auctionID)
+	}
+
+	return store.LoadReserve(res.Auction.ReservePriceHash)
+}
@@ -22,10 +22,11 @@ type IntegrationTestSuite struct {
 }
 
 var (
-	ownerAccount  = "owner"
-	bidderAccount = "bidder"
-	ownerAddress  string
-	bidderAddress string
+	ownerAccount    = "owner"
+	bidderAccount   = "bidder"
+	revealerAccount = "revealer"
+	ownerAddress    string
+	bidderAddress   string
 )
 
 func NewIntegrationTestSuite(cfg network.Config) *IntegrationTestSuite {
@@ -1,6 +1,7 @@
 package testutil
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -111,6 +112,71 @@ func (suite *IntegrationTestSuite) TestTxCommitBid() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestTxRevealAll() {
+	val := suite.network.Validators[0]
+	sr := suite.Require()
+
+	var revealerAddress string
+	suite.createAccountWithBalance(revealerAccount, &revealerAddress)
+
+	auctionArgs := []string{
+		"10s", "60s",
+		fmt.Sprintf("10%s", suite.cfg.BondDenom),
+		fmt.Sprintf("10%s", suite.cfg.BondDenom),
+		fmt.Sprintf("100%s", suite.cfg.BondDenom),
+	}
+	resp, err := suite.executeTx(cli.GetCmdCreateAuction(), auctionArgs, revealerAccount)
+	sr.NoError(err)
+	sr.Zero(resp.Code)
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdAuctionsByOwner(), append([]string{revealerAddress}, queryJSONFlag...))
+	sr.NoError(err)
+	var auctionsResp types.AuctionsByOwnerResponse
+	err = val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &auctionsResp)
+	sr.NoError(err)
+	sr.Len(auctionsResp.Auctions.Auctions, 1)
+	auctionID := auctionsResp.Auctions.Auctions[0].Id
+
+	resp, err = suite.executeTx(cli.GetCmdCommitBid(), []string{auctionID, fmt.Sprintf("200%s", suite.cfg.BondDenom)}, revealerAccount)
+	sr.NoError(err)
+	sr.Zero(resp.Code)
+
+	// The auction is still in the commit phase.
+	_, err = suite.executeTx(cli.GetCmdRevealAll(), []string{}, revealerAccount)
+	sr.Error(err)
+
+	pendingBids := suite.pendingBids(revealerAccount)
+	sr.Len(pendingBids, 1)
+	sr.Equal(auctionID, pendingBids[0].AuctionID)
+	sr.True(pendingBids[0].HasReveal)
+
+	for i := 0; i < 30 && !pendingBids[0].Due; i++ {
+		sr.NoError(suite.network.WaitForNextBlock())
+		pendingBids = suite.pendingBids(revealerAccount)
+	}
+	sr.True(pendingBids[0].Due)
+
+	resp, err = suite.executeTx(cli.GetCmdRevealAll(), []string{}, revealerAccount)
+	sr.NoError(err)
+	sr.Zero(resp.Code)
+
+	sr.Empty(suite.pendingBids(revealerAccount))
+}
+
+func (suite *IntegrationTestSuite) pendingBids(caller string) []cli.PendingBid {
+	val := suite.network.Validators[0]
+	sr := suite.Require()
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdPendingBids(),
+		append([]string{fmt.Sprintf("--%s=%s", flags.FlagFrom, caller)}, queryJSONFlag...))
+	sr.NoError(err)
+
+	var pendingBids []cli.PendingBid
+	sr.NoError(json.Unmarshal(out.Bytes(), &pendingBids))
+
+	return pendingBids
+}
+
 func (suite *IntegrationTestSuite) executeTx(cmd *cobra.Command, args []string, caller string) (sdk.TxResponse, error) {
 	val := suite.network.Validators[0]
 	additionalArgs := []string{
@@ -20,6 +20,8 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState)
 		keeper.SaveAuction(ctx, auction)
 	}
 
+	keeper.SetRetainedAmount(ctx, data.RetainedAmount)
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -31,7 +33,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 	for _, auction := range auctions {
 		genesisAuctions = append(genesisAuctions, &auction)
 	}
-	return types.GenesisState{Params: params, Auctions: genesisAuctions}
+	return types.GenesisState{Params: params, Auctions: genesisAuctions, RetainedAmount: keeper.GetRetainedAmount(ctx)}
 }
 
 func ValidateGenesis(data types.GenesisState) error {
@@ -40,5 +42,5 @@ func ValidateGenesis(data types.GenesisState) error {
 		return err
 	}
 
-	return nil
+	return data.RetainedAmount.Validate()
 }
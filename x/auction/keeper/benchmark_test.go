@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tharsis/ethermint/app"
+	auctionkeeper "github.com/tharsis/ethermint/x/auction/keeper"
+	"github.com/tharsis/ethermint/x/auction/types"
+)
+
+// dueAuctionCount is the number of auctions due for a phase transition in each benchmarked block.
+const dueAuctionCount = 10
+
+// auctionsPerBlock is the number of auctions created per block during setup, to keep block changesets small.
+const auctionsPerBlock = 1000
+
+// setupAuctions stores count auctions that are far from their commits end time, plus dueAuctionCount
+// auctions that are due to move to the reveal phase at the returned context's block time.
+func setupAuctions(b *testing.B, count int) (sdk.Context, auctionkeeper.Keeper) {
+	testApp := app.Setup(b, false, func(ea *app.EthermintApp, genesis simapp.GenesisState) simapp.GenesisState {
+		return genesis
+	})
+
+	blockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: blockTime})
+	k := testApp.AuctionKeeper
+	owner := app.CreateRandomAccounts(1)[0].String()
+
+	saveAuction := func(id int, commitsEndTime time.Time) {
+		k.SaveAuction(ctx.WithBlockHeight(int64(id/auctionsPerBlock)+1), &types.Auction{
+			Id:             fmt.Sprintf("%064x", id),
+			Kind:           types.AuctionKindVickrey,
+			Status:         types.AuctionStatusCommitPhase,
+			OwnerAddress:   owner,
+			CreateTime:     blockTime.Add(-time.Hour),
+			CommitsEndTime: commitsEndTime,
+			RevealsEndTime: commitsEndTime.Add(time.Hour),
+		})
+	}
+
+	for i := 0; i < count; i++ {
+		saveAuction(i, blockTime.Add(time.Hour+time.Duration(i)*time.Second))
+	}
+
+	for i := 0; i < dueAuctionCount; i++ {
+		saveAuction(count+i, blockTime.Add(-time.Second))
+	}
+
+	// Commit, so that the benchmark iterates over persisted state rather than the write cache.
+	testApp.Commit()
+
+	// Process in the next block, so the auctions created above are not part of its changeset.
+	height := int64((count+dueAuctionCount)/auctionsPerBlock) + 2
+	return testApp.BaseApp.NewUncachedContext(false, tmproto.Header{Height: height, Time: blockTime}), k
+}
+
+func benchmarkEndBlockerProcessAuctions(b *testing.B, count int) {
+	ctx, k := setupAuctions(b, count)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cacheCtx, _ := ctx.CacheContext()
+		k.EndBlockerProcessAuctions(cacheCtx)
+	}
+}
+
+func BenchmarkEndBlockerProcessAuctions1k(b *testing.B) {
+	benchmarkEndBlockerProcessAuctions(b, 1_000)
+}
+
+func BenchmarkEndBlockerProcessAuctions10k(b *testing.B) {
+	benchmarkEndBlockerProcessAuctions(b, 10_000)
+}
+
+func BenchmarkEndBlockerProcessAuctions100k(b *testing.B) {
+	benchmarkEndBlockerProcessAuctions(b, 100_000)
+}
+
+// BenchmarkMatchAuctions100k measures a scan over all stored auctions, for comparison with the queue based EndBlocker.
+func BenchmarkMatchAuctions100k(b *testing.B) {
+	ctx, k := setupAuctions(b, 100_000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		k.MatchAuctions(ctx, func(_ *types.Auction) bool {
+			return true
+		})
+	}
+}
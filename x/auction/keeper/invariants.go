@@ -11,6 +11,7 @@ import (
 // RegisterInvariants registers all auction module invariants.
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
 }
 
 // ModuleAccountInvariant checks that the 'auction' module account balance is non-negative.
@@ -29,9 +30,36 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// ModuleBalanceInvariant checks that the 'auction' module account balance equals the sum of bid
+// amounts and fees locked by the bids of auctions that haven't completed yet, and of the minimum
+// bid of the winning prices kept by the module.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		locked := k.GetLockedAmount(ctx)
+		retained := k.GetRetainedAmount(ctx)
+		expected := locked.Add(retained...)
+		balance := k.GetAuctionModuleBalances(ctx)
+
+		if !balance.IsAllGTE(expected) || !expected.IsAllGTE(balance) {
+			return sdk.FormatInvariant(
+					types.ModuleName,
+					"module-balance",
+					fmt.Sprintf("Module account '%s' balance %s doesn't match locked bids and fees %s and retained minimum bids %s.", types.ModuleName, balance, locked, retained)),
+				true
+		}
+
+		return "", false
+	}
+}
+
 // AllInvariants runs all invariants of the auctions module.
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return ModuleAccountInvariant(k)(ctx)
+		res, stop := ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ModuleBalanceInvariant(k)(ctx)
 	}
 }
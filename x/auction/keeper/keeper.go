@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -35,10 +36,27 @@ var PrefixAuctionBidsIndex = []byte{0x02}
 // PrefixBidderToAuctionsIndex is the prefix for the Bidder -> [Auction] index in the KVStore.
 var PrefixBidderToAuctionsIndex = []byte{0x03}
 
+// PrefixCommitsEndTimeToAuctionsIndex is the prefix for the CommitsEndTime -> [Auction] queue.
+// Holds auctions in the commit (or open bidding) phase.
+var PrefixCommitsEndTimeToAuctionsIndex = []byte{0x04}
+
+// PrefixRevealsEndTimeToAuctionsIndex is the prefix for the RevealsEndTime -> [Auction] queue.
+// Holds auctions in the reveal phase and expired auctions waiting for a winner to be picked.
+var PrefixRevealsEndTimeToAuctionsIndex = []byte{0x05}
+
+// PrefixDeleteTimeToAuctionsIndex is the prefix for the Delete Time -> [Auction] queue.
+// Holds completed auctions until they are deleted.
+var PrefixDeleteTimeToAuctionsIndex = []byte{0x06}
+
+// PrefixDenomToRetainedAmount is the prefix for the Denom -> retained amount index in the KVStore.
+// Holds the minimum bid of the winning prices kept by the module account.
+var PrefixDenomToRetainedAmount = []byte{0x07}
+
 // Keeper maintains the link to storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
 	accountKeeper auth.AccountKeeper
 	bankKeeper    bank.Keeper
+	distrKeeper   types.DistributionKeeper
 
 	// Track auction usage in other cosmos-sdk modules (more like a usage tracker).
 	usageKeepers []types.AuctionUsageKeeper
@@ -58,7 +76,7 @@ type AuctionClientKeeper interface {
 }
 
 // NewKeeper creates new instances of the auction Keeper
-func NewKeeper(accountKeeper auth.AccountKeeper, bankKeeper bank.Keeper, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, ps params.Subspace) Keeper {
+func NewKeeper(accountKeeper auth.AccountKeeper, bankKeeper bank.Keeper, distrKeeper types.DistributionKeeper, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, ps params.Subspace) Keeper {
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
 	}
@@ -66,6 +84,7 @@ func NewKeeper(accountKeeper auth.AccountKeeper, bankKeeper bank.Keeper, storeKe
 	return Keeper{
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
 		storeKey:      storeKey,
 		cdc:           cdc,
 		paramSubspace: ps,
@@ -99,13 +118,49 @@ func GetBidIndexKey(auctionID string, bidder string) []byte {
 }
 
 func GetAuctionBidsIndexPrefix(auctionID string) []byte {
-	return append(append(PrefixAuctionBidsIndex, []byte(auctionID)...))
+	return append(PrefixAuctionBidsIndex, []byte(auctionID)...)
+}
+
+// getAuctionQueueTimeKey gets the prefix for the given auction queue and timestamp.
+func getAuctionQueueTimeKey(queuePrefix []byte, timestamp time.Time) []byte {
+	timeBytes := sdk.FormatTimeBytes(timestamp)
+	return append(append([]byte{}, queuePrefix...), timeBytes...)
+}
+
+// getAuctionQueueKey gets the key of the auction in the queue for its next phase transition, based on its status.
+func getAuctionQueueKey(auction *types.Auction) []byte {
+	var queueKey []byte
+
+	switch auction.Status {
+	case types.AuctionStatusCommitPhase, types.AuctionStatusOpenPhase:
+		queueKey = getAuctionQueueTimeKey(PrefixCommitsEndTimeToAuctionsIndex, auction.CommitsEndTime)
+	case types.AuctionStatusRevealPhase, types.AuctionStatusExpired:
+		queueKey = getAuctionQueueTimeKey(PrefixRevealsEndTimeToAuctionsIndex, auction.RevealsEndTime)
+	case types.AuctionStatusCompleted, types.AuctionStatusCancelled:
+		queueKey = getAuctionQueueTimeKey(PrefixDeleteTimeToAuctionsIndex, auction.RevealsEndTime.Add(CompletedAuctionDeleteTimeout))
+	default:
+		return nil
+	}
+
+	return append(queueKey, []byte(auction.Id)...)
 }
 
 // SaveAuction - saves a auction to the store.
 func (k Keeper) SaveAuction(ctx sdk.Context, auction *types.Auction) {
 	store := ctx.KVStore(k.storeKey)
 
+	// Remove the existing queue entry, the status or phase end times might have changed.
+	if existing := k.GetAuction(ctx, auction.Id); existing != nil {
+		if queueKey := getAuctionQueueKey(existing); queueKey != nil {
+			store.Delete(queueKey)
+		}
+	}
+
+	// Phase End Time -> [Auction] queue.
+	if queueKey := getAuctionQueueKey(auction); queueKey != nil {
+		store.Set(queueKey, []byte(auction.Id))
+	}
+
 	// Auction Id -> Auction index.
 	store.Set(GetAuctionIndexKey(auction.Id), k.cdc.MustMarshal(auction))
 
@@ -158,6 +213,10 @@ func (k Keeper) DeleteAuction(ctx sdk.Context, auction types.Auction) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(GetAuctionIndexKey(auction.Id))
 	store.Delete(GetOwnerToAuctionsIndexKey(auction.OwnerAddress, auction.Id))
+
+	if queueKey := getAuctionQueueKey(&auction); queueKey != nil {
+		store.Delete(queueKey)
+	}
 }
 
 // GetAuction - gets a record from the store.
@@ -310,21 +369,45 @@ func (k Keeper) CreateAuction(ctx sdk.Context, msg types.MsgCreateAuction) (*typ
 		Sequence: account.GetSequence(),
 	}.Generate()
 
+	kind := msg.Kind
+	if kind == "" {
+		kind = types.AuctionKindVickrey
+	}
+
 	// Compute timestamps.
 	now := ctx.BlockTime()
 	commitsEndTime := now.Add(time.Duration(msg.CommitsDuration))
 	revealsEndTime := now.Add(time.Duration(msg.CommitsDuration + msg.RevealsDuration))
 
+	// Open auctions have a single bidding phase, tracked by the commits end time.
+	status := types.AuctionStatusCommitPhase
+	if !types.IsSealedBidKind(kind) {
+		status = types.AuctionStatusOpenPhase
+		revealsEndTime = commitsEndTime
+	}
+
+	numWinners := msg.NumWinners
+	if numWinners == 0 {
+		numWinners = 1
+	}
+
 	auction := types.Auction{
-		Id:             auctionID,
-		Status:         types.AuctionStatusCommitPhase,
-		OwnerAddress:   signerAddress.String(),
-		CreateTime:     now,
-		CommitsEndTime: commitsEndTime,
-		RevealsEndTime: revealsEndTime,
-		CommitFee:      msg.CommitFee,
-		RevealFee:      msg.RevealFee,
-		MinimumBid:     msg.MinimumBid,
+		Id:               auctionID,
+		Kind:             kind,
+		Status:           status,
+		OwnerAddress:     signerAddress.String(),
+		CreateTime:       now,
+		CommitsEndTime:   commitsEndTime,
+		RevealsEndTime:   revealsEndTime,
+		CommitFee:        coinOrZero(msg.CommitFee, msg.MinimumBid.Denom),
+		RevealFee:        coinOrZero(msg.RevealFee, msg.MinimumBid.Denom),
+		MinimumBid:       msg.MinimumBid,
+		StartPrice:       coinOrZero(msg.StartPrice, msg.MinimumBid.Denom),
+		BidIncrement:     coinOrZero(msg.BidIncrement, msg.MinimumBid.Denom),
+		ExtensionWindow:  msg.ExtensionWindow,
+		NumWinners:       numWinners,
+		ReservePriceHash: msg.ReservePriceHash,
+		ReservePrice:     sdk.NewCoin(msg.MinimumBid.Denom, sdk.ZeroInt()),
 	}
 
 	// Save auction in store.
@@ -475,6 +558,264 @@ func (k Keeper) RevealBid(ctx sdk.Context, msg types.MsgRevealBid) (*types.Aucti
 	return auction, nil
 }
 
+// PlaceBid places an open bid in an english or dutch auction.
+func (k Keeper) PlaceBid(ctx sdk.Context, msg types.MsgPlaceBid) (*types.Auction, error) {
+	if !k.HasAuction(ctx, msg.AuctionId) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Auction not found.")
+	}
+
+	auction := k.GetAuction(ctx, msg.AuctionId)
+	if auction.Status != types.AuctionStatusOpenPhase {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Auction is not open for bids.")
+	}
+
+	signerAddress, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.BidAmount.Denom != auction.MinimumBid.Denom {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid bid denom.")
+	}
+
+	switch auction.Kind {
+	case types.AuctionKindEnglish:
+		return k.placeEnglishBid(ctx, auction, signerAddress, msg.BidAmount)
+	case types.AuctionKindDutch:
+		return k.placeDutchBid(ctx, auction, signerAddress, msg.BidAmount)
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Auction does not accept open bids.")
+	}
+}
+
+func (k Keeper) placeEnglishBid(ctx sdk.Context, auction *types.Auction, signerAddress sdk.AccAddress, bidAmount sdk.Coin) (*types.Auction, error) {
+	// During the bidding phase, the winner fields track the current highest bid.
+	if auction.WinnerAddress == "" {
+		if bidAmount.IsLT(auction.MinimumBid) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Bid is lower than minimum bid.")
+		}
+	} else if !auction.WinningBid.IsLT(bidAmount) || bidAmount.IsLT(auction.WinningBid.Add(auction.BidIncrement)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Bid does not exceed highest bid by the bid increment.")
+	}
+
+	bidder := signerAddress.String()
+	bid := types.Bid{
+		AuctionId:     auction.Id,
+		BidderAddress: bidder,
+		Status:        types.BidStatusPlaced,
+		CommitFee:     auction.CommitFee,
+		RevealFee:     sdk.NewCoin(auction.MinimumBid.Denom, sdk.ZeroInt()),
+	}
+
+	// Raising an earlier bid only locks the difference, the commit fee is only taken once.
+	toLock := sdk.NewCoins(bidAmount).Add(auction.CommitFee)
+	if k.HasBid(ctx, auction.Id, bidder) {
+		bid = k.GetBid(ctx, auction.Id, bidder)
+		toLock = sdk.NewCoins(bidAmount.Sub(bid.BidAmount))
+	}
+
+	sdkErr := k.bankKeeper.SendCoinsFromAccountToModule(ctx, signerAddress, types.ModuleName, toLock)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bid.BidAmount = bidAmount
+	bid.CommitTime = ctx.BlockTime()
+	k.SaveBid(ctx, &bid)
+
+	auction.WinnerAddress = bidder
+	auction.WinningBid = bidAmount
+
+	// Anti-sniping, bids close to the end extend the bidding phase.
+	if auction.ExtensionWindow > 0 && auction.CommitsEndTime.Sub(ctx.BlockTime()) < auction.ExtensionWindow {
+		auction.CommitsEndTime = ctx.BlockTime().Add(auction.ExtensionWindow)
+		auction.RevealsEndTime = auction.CommitsEndTime
+		ctx.Logger().Info(fmt.Sprintf("Extended auction %s to %s.", auction.Id, auction.GetCommitsEndTime()))
+	}
+
+	k.SaveAuction(ctx, auction)
+
+	return auction, nil
+}
+
+func (k Keeper) placeDutchBid(ctx sdk.Context, auction *types.Auction, signerAddress sdk.AccAddress, bidAmount sdk.Coin) (*types.Auction, error) {
+	price := auction.GetDutchPrice(ctx.BlockTime())
+	if bidAmount.IsLT(price) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Bid is lower than current price.")
+	}
+
+	// The first acceptable bid wins at the current price.
+	sdkErr := k.bankKeeper.SendCoinsFromAccountToModule(ctx, signerAddress, types.ModuleName, sdk.NewCoins(price))
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bid := types.Bid{
+		AuctionId:     auction.Id,
+		BidderAddress: signerAddress.String(),
+		Status:        types.BidStatusPlaced,
+		CommitTime:    ctx.BlockTime(),
+		CommitFee:     sdk.NewCoin(auction.MinimumBid.Denom, sdk.ZeroInt()),
+		RevealFee:     sdk.NewCoin(auction.MinimumBid.Denom, sdk.ZeroInt()),
+		BidAmount:     price,
+	}
+	k.SaveBid(ctx, &bid)
+
+	k.pickAuctionWinner(ctx, auction)
+
+	return auction, nil
+}
+
+// isPositiveCoin returns true if the coin is set and has a positive amount.
+func isPositiveCoin(coin sdk.Coin) bool {
+	return coin.Denom != "" && !coin.Amount.IsNil() && coin.IsPositive()
+}
+
+// CancelAuction cancels an auction in the commit phase, refunding the fees of all committed bids.
+func (k Keeper) CancelAuction(ctx sdk.Context, msg types.MsgCancelAuction) (*types.Auction, error) {
+	if !k.HasAuction(ctx, msg.AuctionId) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Auction not found.")
+	}
+
+	auction := k.GetAuction(ctx, msg.AuctionId)
+	if auction.OwnerAddress != msg.Signer {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Auction owner mismatch.")
+	}
+
+	if auction.Status != types.AuctionStatusCommitPhase {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Auction is not in commit phase.")
+	}
+
+	// Auctions used by other modules (e.g. authority auctions) must run to completion.
+	for _, keeper := range k.usageKeepers {
+		if keeper.UsesAuction(ctx, auction.Id) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Auction is in use by module %s.", keeper.ModuleName()))
+		}
+	}
+
+	bids := k.GetBids(ctx, auction.Id)
+	for _, bid := range bids {
+		if bid.Status == types.BidStatusRevealed {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Auction has revealed bids.")
+		}
+	}
+
+	// Refund commit and reveal fees of all bids.
+	store := ctx.KVStore(k.storeKey)
+	for _, bid := range bids {
+		bidderAddress, err := sdk.AccAddressFromBech32(bid.BidderAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		refunded := bid.GetLockedAmount()
+		if !refunded.IsZero() {
+			sdkErr := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidderAddress, refunded)
+			if sdkErr != nil {
+				return nil, sdkErr
+			}
+		}
+
+		bid.Settlement = &types.BidSettlement{
+			Refunded:  refunded,
+			Forfeited: sdk.NewCoins(),
+			Paid:      sdk.NewCoin(auction.MinimumBid.Denom, sdk.ZeroInt()),
+		}
+		store.Set(GetBidIndexKey(bid.AuctionId, bid.BidderAddress), k.cdc.MustMarshal(bid))
+	}
+
+	auction.Status = types.AuctionStatusCancelled
+	k.SaveAuction(ctx, auction)
+
+	ctx.Logger().Info(fmt.Sprintf("Cancelled auction %s, refunded %d bids.", auction.Id, len(bids)))
+
+	return auction, nil
+}
+
+// RevealReservePrice reveals the hidden reserve price committed at auction creation.
+func (k Keeper) RevealReservePrice(ctx sdk.Context, msg types.MsgRevealReservePrice) (*types.Auction, error) {
+	if !k.HasAuction(ctx, msg.AuctionId) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Auction not found.")
+	}
+
+	auction := k.GetAuction(ctx, msg.AuctionId)
+	if auction.OwnerAddress != msg.Signer {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Auction owner mismatch.")
+	}
+
+	if auction.ReservePriceHash == "" {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Auction has no reserve price.")
+	}
+
+	if isPositiveCoin(auction.ReservePrice) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Reserve price already revealed.")
+	}
+
+	// Sealed-bid auctions reveal the reserve price after the commit phase. English auctions have no reveal
+	// phase, the winner is picked as soon as bidding closes, so the reserve price is revealed while bidding.
+	if auction.Kind == types.AuctionKindEnglish {
+		if auction.Status != types.AuctionStatusOpenPhase || !ctx.BlockTime().Before(auction.CommitsEndTime) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Auction bidding has closed.")
+		}
+	} else if auction.Status != types.AuctionStatusRevealPhase {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Auction is not in reveal phase.")
+	}
+
+	revealBytes, err := hex.DecodeString(msg.Reveal)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid reveal string.")
+	}
+
+	cid, err := wnsUtils.CIDFromJSONBytes(revealBytes)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid reveal JSON.")
+	}
+
+	if auction.ReservePriceHash != cid {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Reserve price hash mismatch.")
+	}
+
+	var reveal map[string]interface{}
+	err = json.Unmarshal(revealBytes, &reveal)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Reveal JSON unmarshal error.")
+	}
+
+	chainID, err := wnsUtils.GetAttributeAsString(reveal, "chainId")
+	if err != nil || chainID != ctx.ChainID() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid reveal chainID.")
+	}
+
+	ownerAddress, err := wnsUtils.GetAttributeAsString(reveal, "ownerAddress")
+	if err != nil || ownerAddress != auction.OwnerAddress {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Reveal owner address mismatch.")
+	}
+
+	reservePriceStr, err := wnsUtils.GetAttributeAsString(reveal, "reservePrice")
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid reveal reserve price.")
+	}
+
+	reservePrice, err := sdk.ParseCoinNormalized(reservePriceStr)
+	if err != nil || !reservePrice.IsPositive() || reservePrice.Denom != auction.MinimumBid.Denom {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid reveal reserve price.")
+	}
+
+	auction.ReservePrice = reservePrice
+	k.SaveAuction(ctx, auction)
+
+	return auction, nil
+}
+
+// coinOrZero returns the coin, or a zero coin of the given denom if it wasn't set.
+func coinOrZero(coin sdk.Coin, denom string) sdk.Coin {
+	if coin.Amount.IsNil() || coin.IsZero() {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	return coin
+}
+
 // GetAuctionModuleBalances gets the auction module account(s) balances.
 func (k Keeper) GetAuctionModuleBalances(ctx sdk.Context) sdk.Coins {
 	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
@@ -482,6 +823,64 @@ func (k Keeper) GetAuctionModuleBalances(ctx sdk.Context) sdk.Coins {
 	return balances
 }
 
+// GetLockedAmount gets the sum of bid amounts and fees locked by the bids of auctions that haven't completed (or been cancelled) yet.
+func (k Keeper) GetLockedAmount(ctx sdk.Context) sdk.Coins {
+	locked := sdk.NewCoins()
+
+	auctions := k.MatchAuctions(ctx, func(auction *types.Auction) bool {
+		return auction.Status != types.AuctionStatusCompleted && auction.Status != types.AuctionStatusCancelled
+	})
+
+	for _, auction := range auctions {
+		for _, bid := range k.GetBids(ctx, auction.Id) {
+			locked = locked.Add(bid.GetLockedAmount()...)
+		}
+	}
+
+	return locked
+}
+
+func getRetainedAmountKey(denom string) []byte {
+	return append(PrefixDenomToRetainedAmount, []byte(denom)...)
+}
+
+// GetRetainedAmount gets the minimum bid of the winning prices kept by the module account.
+func (k Keeper) GetRetainedAmount(ctx sdk.Context) sdk.Coins {
+	retained := sdk.NewCoins()
+
+	store := ctx.KVStore(k.storeKey)
+	itr := sdk.KVStorePrefixIterator(store, PrefixDenomToRetainedAmount)
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(itr.Value()); err != nil {
+			panic(err)
+		}
+
+		retained = retained.Add(sdk.NewCoin(string(itr.Key()[len(PrefixDenomToRetainedAmount):]), amount))
+	}
+
+	return retained
+}
+
+// SetRetainedAmount sets the minimum bid of the winning prices kept by the module account.
+func (k Keeper) SetRetainedAmount(ctx sdk.Context, retained sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range k.GetRetainedAmount(ctx) {
+		store.Delete(getRetainedAmountKey(coin.Denom))
+	}
+
+	for _, coin := range retained {
+		bz, err := coin.Amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+
+		store.Set(getRetainedAmountKey(coin.Denom), bz)
+	}
+}
+
 func (k Keeper) EndBlockerProcessAuctions(ctx sdk.Context) {
 	// Transition auction state (commit, reveal, expired, completed).
 	k.processAuctionPhases(ctx)
@@ -490,21 +889,67 @@ func (k Keeper) EndBlockerProcessAuctions(ctx sdk.Context) {
 	k.deleteCompletedAuctions(ctx)
 }
 
+// AuctionQueueIterator returns the entries of the given auction queue with a time before endTime.
+func (k Keeper) AuctionQueueIterator(ctx sdk.Context, queuePrefix []byte, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(queuePrefix, getAuctionQueueTimeKey(queuePrefix, endTime))
+}
+
+// GetDueAuctions returns the IDs of auctions in the given queue that are due to transition at the current block time.
+func (k Keeper) GetDueAuctions(ctx sdk.Context, queuePrefix []byte) (auctionIDs []string) {
+	itr := k.AuctionQueueIterator(ctx, queuePrefix, ctx.BlockTime())
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		auctionIDs = append(auctionIDs, string(itr.Value()))
+	}
+
+	return auctionIDs
+}
+
+// RebuildAuctionQueues re-creates the phase queue entries of all stored auctions.
+func (k Keeper) RebuildAuctionQueues(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, auction := range k.MatchAuctions(ctx, func(_ *types.Auction) bool { return true }) {
+		if queueKey := getAuctionQueueKey(auction); queueKey != nil {
+			store.Set(queueKey, []byte(auction.Id))
+		}
+	}
+}
+
 func (k Keeper) processAuctionPhases(ctx sdk.Context) {
-	auctions := k.MatchAuctions(ctx, func(_ *types.Auction) bool {
-		return true
-	})
+	// Auctions whose commit (or open bidding) phase has ended.
+	for _, id := range k.GetDueAuctions(ctx, PrefixCommitsEndTimeToAuctionsIndex) {
+		auction := k.GetAuction(ctx, id)
+		if auction == nil {
+			continue
+		}
+
+		// Open -> Expired state (english and dutch auctions).
+		if auction.Status == types.AuctionStatusOpenPhase {
+			auction.Status = types.AuctionStatusExpired
+			k.SaveAuction(ctx, auction)
+			ctx.Logger().Info(fmt.Sprintf("Moved auction %s to expired state.", auction.Id))
+		}
 
-	for _, auction := range auctions {
 		// Commit -> Reveal state.
-		if auction.Status == types.AuctionStatusCommitPhase && ctx.BlockTime().After(auction.CommitsEndTime) {
+		if auction.Status == types.AuctionStatusCommitPhase {
 			auction.Status = types.AuctionStatusRevealPhase
 			k.SaveAuction(ctx, auction)
 			ctx.Logger().Info(fmt.Sprintf("Moved auction %s to reveal phase.", auction.Id))
 		}
+	}
+
+	// Auctions whose reveal phase has ended, or that have expired.
+	for _, id := range k.GetDueAuctions(ctx, PrefixRevealsEndTimeToAuctionsIndex) {
+		auction := k.GetAuction(ctx, id)
+		if auction == nil {
+			continue
+		}
 
 		// Reveal -> Expired state.
-		if auction.Status == types.AuctionStatusRevealPhase && ctx.BlockTime().After(auction.RevealsEndTime) {
+		if auction.Status == types.AuctionStatusRevealPhase {
 			auction.Status = types.AuctionStatusExpired
 			k.SaveAuction(ctx, auction)
 			ctx.Logger().Info(fmt.Sprintf("Moved auction %s to expired state.", auction.Id))
@@ -519,24 +964,22 @@ func (k Keeper) processAuctionPhases(ctx sdk.Context) {
 
 // Delete completed stale auctions.
 func (k Keeper) deleteCompletedAuctions(ctx sdk.Context) {
-	auctions := k.MatchAuctions(ctx, func(auction *types.Auction) bool {
-		deleteTime := auction.RevealsEndTime.Add(CompletedAuctionDeleteTimeout)
-		return auction.Status == types.AuctionStatusCompleted && ctx.BlockTime().After(deleteTime)
-	})
+	for _, id := range k.GetDueAuctions(ctx, PrefixDeleteTimeToAuctionsIndex) {
+		auction := k.GetAuction(ctx, id)
+		if auction == nil {
+			continue
+		}
 
-	for _, auction := range auctions {
 		ctx.Logger().Info(fmt.Sprintf("Deleting completed auction %s after timeout.", auction.Id))
 		k.DeleteAuction(ctx, *auction)
 	}
 }
 
-func (k Keeper) pickAuctionWinner(ctx sdk.Context, auction *types.Auction) {
-	ctx.Logger().Info(fmt.Sprintf("Picking auction %s winner.", auction.Id))
-
+// pickVickreyWinner selects the highest revealed bid, which pays the second highest bid price.
+func pickVickreyWinner(ctx sdk.Context, auction *types.Auction, bids []*types.Bid) {
 	var highestBid *types.Bid
 	var secondHighestBid *types.Bid
 
-	bids := k.GetBids(ctx, auction.Id)
 	for _, bid := range bids {
 		ctx.Logger().Info(fmt.Sprintf("Processing bid %s %s", bid.BidderAddress, bid.BidAmount.String()))
 
@@ -573,8 +1016,6 @@ func (k Keeper) pickAuctionWinner(ctx sdk.Context, auction *types.Auction) {
 	}
 
 	// Highest bid is the winner, but pays second highest bid price.
-	auction.Status = types.AuctionStatusCompleted
-
 	if highestBid != nil {
 		auction.WinnerAddress = highestBid.BidderAddress
 		auction.WinningBid = highestBid.BidAmount
@@ -592,9 +1033,40 @@ func (k Keeper) pickAuctionWinner(ctx sdk.Context, auction *types.Auction) {
 	} else {
 		ctx.Logger().Info(fmt.Sprintf("Auction %s has no valid revealed bids (no winner).", auction.Id))
 	}
+}
+
+func (k Keeper) pickAuctionWinner(ctx sdk.Context, auction *types.Auction) {
+	ctx.Logger().Info(fmt.Sprintf("Picking auction %s winner.", auction.Id))
+
+	bids := k.GetBids(ctx, auction.Id)
+
+	switch auction.Kind {
+	case types.AuctionKindEnglish, types.AuctionKindDutch:
+		pickOpenAuctionWinner(ctx, auction, bids)
+	case types.AuctionKindUniformPrice:
+		pickUniformPriceWinners(ctx, auction, bids)
+	default:
+		pickVickreyWinner(ctx, auction, bids)
+	}
+
+	auction.Status = types.AuctionStatusCompleted
+	if auction.WinnerAddress != "" && len(auction.WinnerAddresses) == 0 {
+		auction.WinnerAddresses = []string{auction.WinnerAddress}
+		auction.WinningBids = []sdk.Coin{auction.WinningBid}
+	}
+
+	applyReservePrice(ctx, auction)
 
 	k.SaveAuction(ctx, auction)
 
+	winners := make(map[string]bool)
+	for _, winner := range auction.WinnerAddresses {
+		winners[winner] = true
+	}
+
+	forfeitedFeeDestination := k.GetParams(ctx).ForfeitedFeeDestination
+	forfeitedFees := sdk.NewCoins()
+
 	for _, bid := range bids {
 		bidderAddress, err := sdk.AccAddressFromBech32(bid.BidderAddress)
 		if err != nil {
@@ -602,32 +1074,39 @@ func (k Keeper) pickAuctionWinner(ctx sdk.Context, auction *types.Auction) {
 			panic("Invalid bidder address.")
 		}
 
-		if bid.Status == types.BidStatusRevealed {
-			// Send reveal fee back to bidders that've revealed the bid.
-			sdkErr := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidderAddress, sdk.NewCoins(bid.RevealFee))
+		settlement := settleBid(auction, bid, forfeitedFeeDestination)
+		if winners[bid.BidderAddress] {
+			settlement.Paid = auction.WinningPrice
+		}
+
+		// Send back locked bid amount to all bidders, and reveal fee to bidders that've revealed the bid.
+		if !settlement.Refunded.IsZero() {
+			sdkErr := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidderAddress, settlement.Refunded)
 			if sdkErr != nil {
-				ctx.Logger().Error(fmt.Sprintf("Auction error returning reveal fee: %v", sdkErr))
+				ctx.Logger().Error(fmt.Sprintf("Auction error returning bid amount: %v", sdkErr))
 				panic(sdkErr)
 			}
 		}
 
-		// Send back locked bid amount to all bidders.
-		sdkErr := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidderAddress, sdk.NewCoins(bid.BidAmount))
-		if sdkErr != nil {
-			ctx.Logger().Error(fmt.Sprintf("Auction error returning bid amount: %v", sdkErr))
-			panic(sdkErr)
-		}
+		forfeitedFees = forfeitedFees.Add(settlement.Forfeited...)
+
+		// Record the settlement breakdown on the bid.
+		bid.Settlement = &settlement
+		store := ctx.KVStore(k.storeKey)
+		store.Set(GetBidIndexKey(bid.AuctionId, bid.BidderAddress), k.cdc.MustMarshal(bid))
 	}
 
-	// Process winner account (if nobody bids, there won't be a winner).
-	if auction.WinnerAddress != "" {
-		winnerAddress, err := sdk.AccAddressFromBech32(auction.WinnerAddress)
+	k.sendForfeitedFees(ctx, auction, forfeitedFeeDestination, forfeitedFees)
+
+	// Process winner accounts (if nobody bids, there won't be a winner).
+	for _, winner := range auction.WinnerAddresses {
+		winnerAddress, err := sdk.AccAddressFromBech32(winner)
 		if err != nil {
 			ctx.Logger().Error(fmt.Sprintf("Invalid winner address. %v", err))
 			panic("Invalid winner address.")
 		}
 
-		// Take 2nd price from winner.
+		// Take winning price (2nd price for vickrey auctions) from winner.
 		sdkErr := k.bankKeeper.SendCoinsFromAccountToModule(ctx, winnerAddress, types.ModuleName, sdk.NewCoins(auction.WinningPrice))
 		if sdkErr != nil {
 			ctx.Logger().Error(fmt.Sprintf("Auction error taking funds from winner: %v", sdkErr))
@@ -647,6 +1126,11 @@ func (k Keeper) pickAuctionWinner(ctx sdk.Context, auction *types.Auction) {
 			ctx.Logger().Error(fmt.Sprintf("Auction error burning coins: %v", sdkErr))
 			panic(sdkErr)
 		}
+
+		// The min. bid amount is kept by the module account.
+		if isPositiveCoin(auction.MinimumBid) {
+			k.SetRetainedAmount(ctx, k.GetRetainedAmount(ctx).Add(auction.MinimumBid))
+		}
 	}
 
 	// Notify other modules (hook).
@@ -656,3 +1140,171 @@ func (k Keeper) pickAuctionWinner(ctx sdk.Context, auction *types.Auction) {
 		keeper.OnAuctionWinnerSelected(ctx, auction.Id)
 	}
 }
+
+// applyReservePrice drops winners whose bid is below the reserve price of the auction, and raises the
+// winning price to the reserve price. A reserve price that wasn't revealed by the owner is never met.
+func applyReservePrice(ctx sdk.Context, auction *types.Auction) {
+	if auction.ReservePriceHash == "" || len(auction.WinnerAddresses) == 0 {
+		return
+	}
+
+	var winnerAddresses []string
+	var winningBids []sdk.Coin
+	if isPositiveCoin(auction.ReservePrice) {
+		for i, winningBid := range auction.WinningBids {
+			if !winningBid.IsLT(auction.ReservePrice) {
+				winnerAddresses = append(winnerAddresses, auction.WinnerAddresses[i])
+				winningBids = append(winningBids, winningBid)
+			}
+		}
+	}
+
+	if len(winnerAddresses) == 0 {
+		ctx.Logger().Info(fmt.Sprintf("Auction %s reserve price not met (no winner).", auction.Id))
+
+		auction.WinnerAddress = ""
+		auction.WinningBid = sdk.Coin{}
+		auction.WinningPrice = sdk.Coin{}
+		auction.WinnerAddresses = nil
+		auction.WinningBids = nil
+
+		return
+	}
+
+	auction.WinnerAddresses = winnerAddresses
+	auction.WinningBids = winningBids
+	auction.WinnerAddress = winnerAddresses[0]
+	auction.WinningBid = winningBids[0]
+
+	if auction.WinningPrice.IsLT(auction.ReservePrice) {
+		auction.WinningPrice = auction.ReservePrice
+	}
+}
+
+// settleBid computes the refunded and forfeited funds of a bid when its auction completes.
+// The commit fee is always forfeited, the reveal fee only if the bid wasn't revealed.
+func settleBid(auction *types.Auction, bid *types.Bid, forfeitedFeeDestination string) types.BidSettlement {
+	settlement := types.BidSettlement{
+		Refunded:    sdk.NewCoins(),
+		Forfeited:   sdk.NewCoins(),
+		ForfeitedTo: forfeitedFeeDestination,
+		Paid:        sdk.NewCoin(auction.MinimumBid.Denom, sdk.ZeroInt()),
+	}
+
+	if isPositiveCoin(bid.BidAmount) {
+		settlement.Refunded = settlement.Refunded.Add(bid.BidAmount)
+	}
+
+	if isPositiveCoin(bid.CommitFee) {
+		settlement.Forfeited = settlement.Forfeited.Add(bid.CommitFee)
+	}
+
+	if isPositiveCoin(bid.RevealFee) {
+		if bid.Status == types.BidStatusCommitted {
+			settlement.Forfeited = settlement.Forfeited.Add(bid.RevealFee)
+		} else {
+			settlement.Refunded = settlement.Refunded.Add(bid.RevealFee)
+		}
+	}
+
+	return settlement
+}
+
+// sendForfeitedFees sends the forfeited commit and reveal fees of an auction to the given destination.
+func (k Keeper) sendForfeitedFees(ctx sdk.Context, auction *types.Auction, destination string, fees sdk.Coins) {
+	if fees.IsZero() {
+		return
+	}
+
+	var sdkErr error
+	switch destination {
+	case types.ForfeitedFeeDestinationCommunity:
+		sdkErr = k.distrKeeper.FundCommunityPool(ctx, fees, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	case types.ForfeitedFeeDestinationOwner:
+		ownerAddress, err := sdk.AccAddressFromBech32(auction.OwnerAddress)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("Invalid owner address. %v", err))
+			panic("Invalid owner address.")
+		}
+
+		sdkErr = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, ownerAddress, fees)
+	default:
+		// Use auction burn module account instead of actually burning coins to better keep track of supply.
+		sdkErr = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.AuctionBurnModuleAccountName, fees)
+	}
+
+	if sdkErr != nil {
+		ctx.Logger().Error(fmt.Sprintf("Auction error sending forfeited fees: %v", sdkErr))
+		panic(sdkErr)
+	}
+
+	ctx.Logger().Info(fmt.Sprintf("Auction %s forfeited fees %s sent to %s.", auction.Id, fees.String(), destination))
+}
+
+// pickOpenAuctionWinner selects the highest placed bid, which pays its own bid price.
+func pickOpenAuctionWinner(ctx sdk.Context, auction *types.Auction, bids []*types.Bid) {
+	var highestBid *types.Bid
+	for _, bid := range bids {
+		if bid.Status != types.BidStatusPlaced {
+			continue
+		}
+
+		if highestBid == nil || highestBid.BidAmount.IsLT(bid.BidAmount) {
+			highestBid = bid
+		}
+	}
+
+	if highestBid == nil {
+		auction.WinnerAddress = ""
+		ctx.Logger().Info(fmt.Sprintf("Auction %s has no bids (no winner).", auction.Id))
+		return
+	}
+
+	auction.WinnerAddress = highestBid.BidderAddress
+	auction.WinningBid = highestBid.BidAmount
+	auction.WinningPrice = highestBid.BidAmount
+
+	ctx.Logger().Info(fmt.Sprintf("Auction %s winner %s.", auction.Id, auction.WinnerAddress))
+	ctx.Logger().Info(fmt.Sprintf("Auction %s winner price %s.", auction.Id, auction.WinningPrice.String()))
+}
+
+// pickUniformPriceWinners selects the highest revealed bids, one per unit on sale.
+// All winners pay the highest losing bid, or the minimum bid if there is no losing bid.
+func pickUniformPriceWinners(ctx sdk.Context, auction *types.Auction, bids []*types.Bid) {
+	var revealedBids []*types.Bid
+	for _, bid := range bids {
+		if bid.Status == types.BidStatusRevealed {
+			revealedBids = append(revealedBids, bid)
+		}
+	}
+
+	sort.SliceStable(revealedBids, func(i, j int) bool {
+		return revealedBids[j].BidAmount.IsLT(revealedBids[i].BidAmount)
+	})
+
+	numWinners := int(auction.NumWinners)
+	if numWinners > len(revealedBids) {
+		numWinners = len(revealedBids)
+	}
+
+	if numWinners == 0 {
+		ctx.Logger().Info(fmt.Sprintf("Auction %s has no valid revealed bids (no winner).", auction.Id))
+		return
+	}
+
+	auction.WinningPrice = auction.MinimumBid
+	if len(revealedBids) > numWinners {
+		auction.WinningPrice = revealedBids[numWinners].BidAmount
+	}
+
+	for _, bid := range revealedBids[:numWinners] {
+		auction.WinnerAddresses = append(auction.WinnerAddresses, bid.BidderAddress)
+		auction.WinningBids = append(auction.WinningBids, bid.BidAmount)
+	}
+
+	auction.WinnerAddress = auction.WinnerAddresses[0]
+	auction.WinningBid = auction.WinningBids[0]
+
+	ctx.Logger().Info(fmt.Sprintf("Auction %s has %d winners.", auction.Id, numWinners))
+	ctx.Logger().Info(fmt.Sprintf("Auction %s winner price %s.", auction.Id, auction.WinningPrice.String()))
+}
@@ -1,15 +1,21 @@
 package keeper_test
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"github.com/tharsis/ethermint/app"
+	wnsUtils "github.com/tharsis/ethermint/utils"
 	auctionkeeper "github.com/tharsis/ethermint/x/auction/keeper"
 	"github.com/tharsis/ethermint/x/auction/types"
 )
@@ -54,3 +60,457 @@ func TestParams(t *testing.T) {
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+func (suite *KeeperTestSuite) TestEnglishAuction() {
+	ctx, k := suite.ctx, suite.app.AuctionKeeper
+	accounts := suite.fundedAccounts(3)
+
+	msg := types.NewMsgCreateAuction(k.GetParams(ctx), accounts[0])
+	msg.Kind = types.AuctionKindEnglish
+	msg.RevealsDuration = 0
+	msg.BidIncrement = sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	msg.ExtensionWindow = time.Minute
+	auction, err := k.CreateAuction(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.AuctionStatusOpenPhase, auction.Status)
+
+	_, err = k.PlaceBid(ctx, types.NewMsgPlaceBid(auction.Id, sdk.NewInt64Coin(sdk.DefaultBondDenom, 999), accounts[1]))
+	suite.Require().Error(err, "bid below minimum bid")
+
+	_, err = k.PlaceBid(ctx, types.NewMsgPlaceBid(auction.Id, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), accounts[1]))
+	suite.Require().NoError(err)
+
+	_, err = k.PlaceBid(ctx, types.NewMsgPlaceBid(auction.Id, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1050), accounts[2]))
+	suite.Require().Error(err, "bid below bid increment")
+
+	// Bid within the extension window pushes the end of the auction.
+	ctx = ctx.WithBlockTime(auction.CommitsEndTime.Add(-time.Second))
+	auction, err = k.PlaceBid(ctx, types.NewMsgPlaceBid(auction.Id, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1100), accounts[2]))
+	suite.Require().NoError(err)
+	suite.Require().Equal(ctx.BlockTime().Add(time.Minute), auction.CommitsEndTime)
+
+	ctx = ctx.WithBlockTime(auction.CommitsEndTime.Add(time.Second))
+	k.EndBlockerProcessAuctions(ctx)
+
+	auction = k.GetAuction(ctx, auction.Id)
+	suite.Require().Equal(types.AuctionStatusCompleted, auction.Status)
+	suite.Require().Equal(accounts[2].String(), auction.WinnerAddress)
+	suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1100), auction.WinningPrice)
+
+	_, broken := auctionkeeper.AllInvariants(k)(ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestDutchAuction() {
+	ctx, k := suite.ctx, suite.app.AuctionKeeper
+	accounts := suite.fundedAccounts(3)
+
+	msg := types.NewMsgCreateAuction(k.GetParams(ctx), accounts[0])
+	msg.Kind = types.AuctionKindDutch
+	msg.RevealsDuration = 0
+	msg.StartPrice = sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000)
+	auction, err := k.CreateAuction(ctx, msg)
+	suite.Require().NoError(err)
+
+	// Half way through the auction the price is half way between start price and minimum bid.
+	ctx = ctx.WithBlockTime(auction.CreateTime.Add(msg.CommitsDuration / 2))
+	suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1500), auction.GetDutchPrice(ctx.BlockTime()))
+
+	_, err = k.PlaceBid(ctx, types.NewMsgPlaceBid(auction.Id, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1400), accounts[1]))
+	suite.Require().Error(err, "bid below current price")
+
+	auction, err = k.PlaceBid(ctx, types.NewMsgPlaceBid(auction.Id, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1600), accounts[1]))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.AuctionStatusCompleted, auction.Status)
+	suite.Require().Equal(accounts[1].String(), auction.WinnerAddress)
+	suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1500), auction.WinningPrice)
+
+	_, err = k.PlaceBid(ctx, types.NewMsgPlaceBid(auction.Id, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1600), accounts[2]))
+	suite.Require().Error(err, "auction already completed")
+
+	_, broken := auctionkeeper.AllInvariants(k)(ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestUniformPriceAuction() {
+	ctx, k := suite.ctx, suite.app.AuctionKeeper
+	accounts := suite.fundedAccounts(4)
+
+	msg := types.NewMsgCreateAuction(k.GetParams(ctx), accounts[0])
+	msg.Kind = types.AuctionKindUniformPrice
+	msg.NumWinners = 2
+	auction, err := k.CreateAuction(ctx, msg)
+	suite.Require().NoError(err)
+
+	reveals := suite.commitBids(ctx, auction, accounts[1:], []int64{3000, 2000, 1500})
+
+	ctx = ctx.WithBlockTime(auction.CommitsEndTime.Add(time.Second))
+	k.EndBlockerProcessAuctions(ctx)
+
+	for i, content := range reveals {
+		_, err = k.RevealBid(ctx, types.NewMsgRevealBid(auction.Id, hex.EncodeToString(content), accounts[i+1]))
+		suite.Require().NoError(err)
+	}
+
+	ctx = ctx.WithBlockTime(auction.RevealsEndTime.Add(time.Second))
+	k.EndBlockerProcessAuctions(ctx)
+
+	auction = k.GetAuction(ctx, auction.Id)
+	suite.Require().Equal(types.AuctionStatusCompleted, auction.Status)
+	suite.Require().Equal([]string{accounts[1].String(), accounts[2].String()}, auction.WinnerAddresses)
+	suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1500), auction.WinningPrice)
+
+	_, broken := auctionkeeper.AllInvariants(k)(ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestForfeitedFees() {
+	ctx, k := suite.ctx, suite.app.AuctionKeeper
+	accounts := suite.fundedAccounts(4)
+
+	params := k.GetParams(ctx)
+	params.ForfeitedFeeDestination = types.ForfeitedFeeDestinationOwner
+	k.SetParams(ctx, params)
+
+	auction, err := k.CreateAuction(ctx, types.NewMsgCreateAuction(params, accounts[0]))
+	suite.Require().NoError(err)
+
+	reveals := suite.commitBids(ctx, auction, accounts[1:], []int64{3000, 2000, 1500})
+
+	ctx = ctx.WithBlockTime(auction.CommitsEndTime.Add(time.Second))
+	k.EndBlockerProcessAuctions(ctx)
+
+	// The last bidder doesn't reveal.
+	for i, content := range reveals[:2] {
+		_, err = k.RevealBid(ctx, types.NewMsgRevealBid(auction.Id, hex.EncodeToString(content), accounts[i+1]))
+		suite.Require().NoError(err)
+	}
+
+	_, broken := auctionkeeper.AllInvariants(k)(ctx)
+	suite.Require().False(broken)
+
+	ownerBalance := suite.app.BankKeeper.GetBalance(ctx, accounts[0], sdk.DefaultBondDenom)
+
+	ctx = ctx.WithBlockTime(auction.RevealsEndTime.Add(time.Second))
+	k.EndBlockerProcessAuctions(ctx)
+
+	_, broken = auctionkeeper.AllInvariants(k)(ctx)
+	suite.Require().False(broken)
+
+	// Three commit fees and one reveal fee are forfeited to the owner.
+	forfeited := params.CommitFee.Amount.MulRaw(3).Add(params.RevealFee.Amount)
+	suite.Require().Equal(ownerBalance.Amount.Add(forfeited), suite.app.BankKeeper.GetBalance(ctx, accounts[0], sdk.DefaultBondDenom).Amount)
+
+	resp, err := suite.queryClient.GetBids(sdk.WrapSDKContext(ctx), &types.BidsRequest{AuctionId: auction.Id})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Bids, 3)
+
+	for _, bid := range resp.Bids {
+		suite.Require().NotNil(bid.Settlement)
+		suite.Require().Equal(types.ForfeitedFeeDestinationOwner, bid.Settlement.ForfeitedTo)
+
+		switch bid.BidderAddress {
+		case accounts[1].String():
+			suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000), bid.Settlement.Paid)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3010)), bid.Settlement.Refunded)
+			suite.Require().Equal(sdk.NewCoins(params.CommitFee), bid.Settlement.Forfeited)
+		case accounts[3].String():
+			suite.Require().True(bid.Settlement.Paid.IsZero())
+			suite.Require().True(bid.Settlement.Refunded.IsZero())
+			suite.Require().Equal(sdk.NewCoins(params.CommitFee.Add(params.RevealFee)), bid.Settlement.Forfeited)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestModuleBalanceInvariant() {
+	ctx, k := suite.ctx, suite.app.AuctionKeeper
+	accounts := suite.fundedAccounts(3)
+
+	params := k.GetParams(ctx)
+	auction, err := k.CreateAuction(ctx, types.NewMsgCreateAuction(params, accounts[0]))
+	suite.Require().NoError(err)
+
+	reveals := suite.commitBids(ctx, auction, accounts[1:], []int64{3000, 2000})
+
+	ctx = ctx.WithBlockTime(auction.CommitsEndTime.Add(time.Second))
+	k.EndBlockerProcessAuctions(ctx)
+
+	for i, content := range reveals {
+		_, err = k.RevealBid(ctx, types.NewMsgRevealBid(auction.Id, hex.EncodeToString(content), accounts[i+1]))
+		suite.Require().NoError(err)
+	}
+
+	ctx = ctx.WithBlockTime(auction.RevealsEndTime.Add(time.Second))
+	k.EndBlockerProcessAuctions(ctx)
+
+	// The module keeps the minimum bid of the winning price.
+	suite.Require().Equal(types.AuctionStatusCompleted, k.GetAuction(ctx, auction.Id).Status)
+	suite.Require().Equal(sdk.NewCoins(params.MinimumBid), k.GetRetainedAmount(ctx))
+	suite.Require().Equal(k.GetRetainedAmount(ctx), k.GetAuctionModuleBalances(ctx))
+
+	_, broken := auctionkeeper.ModuleBalanceInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// Funds in excess of the locked bids and retained minimum bids break the invariant.
+	leaked := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	suite.Require().NoError(testutil.FundModuleAccount(suite.app.BankKeeper, ctx, types.ModuleName, leaked))
+
+	msg, broken := auctionkeeper.ModuleBalanceInvariant(k)(ctx)
+	suite.Require().True(broken, msg)
+
+	// So do missing funds.
+	k.SetRetainedAmount(ctx, sdk.NewCoins(params.MinimumBid.Add(leaked[0]).Add(leaked[0])))
+
+	_, broken = auctionkeeper.ModuleBalanceInvariant(k)(ctx)
+	suite.Require().True(broken)
+
+	k.SetRetainedAmount(ctx, sdk.NewCoins(params.MinimumBid.Add(leaked[0])))
+
+	_, broken = auctionkeeper.AllInvariants(k)(ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestAuctionPhaseQueues() {
+	ctx, k := suite.ctx, suite.app.AuctionKeeper
+	accounts := suite.fundedAccounts(1)
+
+	auction, err := k.CreateAuction(ctx, types.NewMsgCreateAuction(k.GetParams(ctx), accounts[0]))
+	suite.Require().NoError(err)
+
+	k.EndBlockerProcessAuctions(ctx.WithBlockTime(auction.CommitsEndTime))
+	suite.Require().Equal(types.AuctionStatusCommitPhase, k.GetAuction(ctx, auction.Id).Status)
+
+	k.EndBlockerProcessAuctions(ctx.WithBlockTime(auction.CommitsEndTime.Add(time.Second)))
+	suite.Require().Equal(types.AuctionStatusRevealPhase, k.GetAuction(ctx, auction.Id).Status)
+
+	k.EndBlockerProcessAuctions(ctx.WithBlockTime(auction.RevealsEndTime.Add(time.Second)))
+	suite.Require().Equal(types.AuctionStatusCompleted, k.GetAuction(ctx, auction.Id).Status)
+
+	k.EndBlockerProcessAuctions(ctx.WithBlockTime(auction.RevealsEndTime.Add(auctionkeeper.CompletedAuctionDeleteTimeout + time.Second)))
+	suite.Require().Nil(k.GetAuction(ctx, auction.Id))
+	suite.Require().Empty(k.GetDueAuctions(ctx.WithBlockTime(auction.RevealsEndTime.Add(2*auctionkeeper.CompletedAuctionDeleteTimeout)), auctionkeeper.PrefixDeleteTimeToAuctionsIndex))
+}
+
+func (suite *KeeperTestSuite) TestCancelAuction() {
+	ctx, k := suite.ctx, suite.app.AuctionKeeper
+	accounts := suite.fundedAccounts(3)
+
+	auction, err := k.CreateAuction(ctx, types.NewMsgCreateAuction(k.GetParams(ctx), accounts[0]))
+	suite.Require().NoError(err)
+
+	suite.commitBids(ctx, auction, accounts[1:], []int64{3000, 2000})
+
+	_, err = k.CancelAuction(ctx, types.NewMsgCancelAuction(auction.Id, accounts[1]))
+	suite.Require().Error(err)
+
+	auction, err = k.CancelAuction(ctx, types.NewMsgCancelAuction(auction.Id, accounts[0]))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.AuctionStatusCancelled, auction.Status)
+
+	_, broken := auctionkeeper.AllInvariants(k)(ctx)
+	suite.Require().False(broken)
+
+	// Bidders get their commit and reveal fees back.
+	for _, bidder := range accounts[1:] {
+		suite.Require().Equal(sdk.NewInt(10000), suite.app.BankKeeper.GetBalance(ctx, bidder, sdk.DefaultBondDenom).Amount)
+
+		bid := k.GetBid(ctx, auction.Id, bidder.String())
+		suite.Require().NotNil(bid.Settlement)
+		suite.Require().True(bid.Settlement.Forfeited.IsZero())
+	}
+
+	_, err = k.CommitBid(ctx, types.NewMsgCommitBid(auction.Id, "commit-hash", accounts[1]))
+	suite.Require().Error(err)
+
+	_, err = k.CancelAuction(ctx, types.NewMsgCancelAuction(auction.Id, accounts[0]))
+	suite.Require().Error(err)
+
+	// Cancelled auctions are deleted after the timeout, like completed ones.
+	k.EndBlockerProcessAuctions(ctx.WithBlockTime(auction.RevealsEndTime.Add(time.Second)))
+	suite.Require().Equal(types.AuctionStatusCancelled, k.GetAuction(ctx, auction.Id).Status)
+
+	k.EndBlockerProcessAuctions(ctx.WithBlockTime(auction.RevealsEndTime.Add(auctionkeeper.CompletedAuctionDeleteTimeout + time.Second)))
+	suite.Require().Nil(k.GetAuction(ctx, auction.Id))
+}
+
+func (suite *KeeperTestSuite) TestReservePrice() {
+	testCases := []struct {
+		msg           string
+		revealReserve bool
+		expWinner     bool
+	}{
+		{"reserve price revealed", true, true},
+		{"reserve price not revealed", false, false},
+	}
+
+	for _, test := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", test.msg), func() {
+			suite.SetupTest()
+			ctx, k := suite.ctx, suite.app.AuctionKeeper
+			accounts := suite.fundedAccounts(3)
+
+			reserveReveal, err := json.Marshal(map[string]interface{}{
+				"chainId":      ctx.ChainID(),
+				"ownerAddress": accounts[0].String(),
+				"reservePrice": sdk.NewInt64Coin(sdk.DefaultBondDenom, 2500).String(),
+				"noise":        "noise",
+			})
+			suite.Require().NoError(err)
+
+			msg := types.NewMsgCreateAuction(k.GetParams(ctx), accounts[0])
+			msg.ReservePriceHash, err = wnsUtils.CIDFromJSONBytes(reserveReveal)
+			suite.Require().NoError(err)
+
+			auction, err := k.CreateAuction(ctx, msg)
+			suite.Require().NoError(err)
+
+			reveals := suite.commitBids(ctx, auction, accounts[1:], []int64{3000, 2000})
+
+			// The reserve price can't be revealed during the commit phase.
+			_, err = k.RevealReservePrice(ctx, types.NewMsgRevealReservePrice(auction.Id, hex.EncodeToString(reserveReveal), accounts[0]))
+			suite.Require().Error(err)
+
+			ctx = ctx.WithBlockTime(auction.CommitsEndTime.Add(time.Second))
+			k.EndBlockerProcessAuctions(ctx)
+
+			for i, content := range reveals {
+				_, err = k.RevealBid(ctx, types.NewMsgRevealBid(auction.Id, hex.EncodeToString(content), accounts[i+1]))
+				suite.Require().NoError(err)
+			}
+
+			_, err = k.RevealReservePrice(ctx, types.NewMsgRevealReservePrice(auction.Id, hex.EncodeToString(reserveReveal), accounts[1]))
+			suite.Require().Error(err)
+
+			if test.revealReserve {
+				_, err = k.RevealReservePrice(ctx, types.NewMsgRevealReservePrice(auction.Id, hex.EncodeToString(reserveReveal), accounts[0]))
+				suite.Require().NoError(err)
+			}
+
+			ctx = ctx.WithBlockTime(auction.RevealsEndTime.Add(time.Second))
+			k.EndBlockerProcessAuctions(ctx)
+
+			_, broken := auctionkeeper.AllInvariants(k)(ctx)
+			suite.Require().False(broken)
+
+			auction = k.GetAuction(ctx, auction.Id)
+			suite.Require().Equal(types.AuctionStatusCompleted, auction.Status)
+
+			if test.expWinner {
+				// The second price is below the reserve, so the winner pays the reserve price.
+				suite.Require().Equal(accounts[1].String(), auction.WinnerAddress)
+				suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2500), auction.WinningPrice)
+			} else {
+				suite.Require().Empty(auction.WinnerAddress)
+				suite.Require().Empty(auction.WinnerAddresses)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEnglishAuctionReservePrice() {
+	testCases := []struct {
+		msg           string
+		revealClosing bool
+		expWinner     bool
+	}{
+		{"reserve price revealed while bidding", false, true},
+		{"reserve price revealed after bidding closes", true, false},
+	}
+
+	for _, test := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", test.msg), func() {
+			suite.SetupTest()
+			ctx, k := suite.ctx, suite.app.AuctionKeeper
+			accounts := suite.fundedAccounts(3)
+
+			reserveReveal, err := json.Marshal(map[string]interface{}{
+				"chainId":      ctx.ChainID(),
+				"ownerAddress": accounts[0].String(),
+				"reservePrice": sdk.NewInt64Coin(sdk.DefaultBondDenom, 2500).String(),
+				"noise":        "noise",
+			})
+			suite.Require().NoError(err)
+
+			msg := types.NewMsgCreateAuction(k.GetParams(ctx), accounts[0])
+			msg.Kind = types.AuctionKindEnglish
+			msg.RevealsDuration = 0
+			msg.ReservePriceHash, err = wnsUtils.CIDFromJSONBytes(reserveReveal)
+			suite.Require().NoError(err)
+
+			auction, err := k.CreateAuction(ctx, msg)
+			suite.Require().NoError(err)
+
+			_, err = k.PlaceBid(ctx, types.NewMsgPlaceBid(auction.Id, sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000), accounts[1]))
+			suite.Require().NoError(err)
+			_, err = k.PlaceBid(ctx, types.NewMsgPlaceBid(auction.Id, sdk.NewInt64Coin(sdk.DefaultBondDenom, 3000), accounts[2]))
+			suite.Require().NoError(err)
+
+			revealMsg := types.NewMsgRevealReservePrice(auction.Id, hex.EncodeToString(reserveReveal), accounts[0])
+			if test.revealClosing {
+				// The auction is still open until the EndBlocker picks the winner, but bidding has closed.
+				_, err = k.RevealReservePrice(ctx.WithBlockTime(auction.CommitsEndTime), revealMsg)
+				suite.Require().Error(err)
+			} else {
+				_, err = k.RevealReservePrice(ctx, revealMsg)
+				suite.Require().NoError(err)
+			}
+
+			// The winner is picked in the EndBlocker that closes bidding.
+			ctx = ctx.WithBlockTime(auction.CommitsEndTime.Add(time.Second))
+			k.EndBlockerProcessAuctions(ctx)
+
+			auction = k.GetAuction(ctx, auction.Id)
+			suite.Require().Equal(types.AuctionStatusCompleted, auction.Status)
+
+			_, err = k.RevealReservePrice(ctx, revealMsg)
+			suite.Require().Error(err)
+
+			if test.expWinner {
+				suite.Require().Equal(accounts[2].String(), auction.WinnerAddress)
+				suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3000), auction.WinningPrice)
+			} else {
+				suite.Require().Empty(auction.WinnerAddress)
+				suite.Require().Empty(auction.WinnerAddresses)
+			}
+
+			_, broken := auctionkeeper.AllInvariants(k)(ctx)
+			suite.Require().False(broken)
+		})
+	}
+}
+
+// commitBids commits a bid per bidder and returns the bid reveals.
+func (suite *KeeperTestSuite) commitBids(ctx sdk.Context, auction *types.Auction, bidders []sdk.AccAddress, amounts []int64) [][]byte {
+	reveals := make([][]byte, len(amounts))
+	for i, amount := range amounts {
+		_, content, err := wnsUtils.GenerateHash(map[string]interface{}{
+			"chainId":       ctx.ChainID(),
+			"auctionId":     auction.Id,
+			"bidderAddress": bidders[i].String(),
+			"bidAmount":     sdk.NewInt64Coin(sdk.DefaultBondDenom, amount).String(),
+			"noise":         fmt.Sprintf("noise-%d", i),
+		})
+		suite.Require().NoError(err)
+		reveals[i] = content
+
+		commitHash, err := wnsUtils.CIDFromJSONBytes(content)
+		suite.Require().NoError(err)
+
+		_, err = suite.app.AuctionKeeper.CommitBid(ctx, types.NewMsgCommitBid(auction.Id, commitHash, bidders[i]))
+		suite.Require().NoError(err)
+	}
+
+	return reveals
+}
+
+func (suite *KeeperTestSuite) fundedAccounts(count int) []sdk.AccAddress {
+	accounts := app.CreateRandomAccounts(count)
+	for _, account := range accounts {
+		err := testutil.FundAccount(suite.app.BankKeeper, suite.ctx, account, sdk.NewCoins(
+			sdk.Coin{Amount: sdk.NewInt(10000), Denom: sdk.DefaultBondDenom},
+		))
+		suite.Require().NoError(err)
+	}
+
+	return accounts
+}
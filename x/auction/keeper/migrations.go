@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/ethermint/x/auction/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates the store from consensus version v1 to v2 by indexing
+// existing auctions in the phase queues.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.RebuildAuctionQueues(ctx)
+	return nil
+}
+
+// Migrate2to3 migrates the store from consensus version v2 to v3. It sets the forfeited fee
+// destination param, and records the funds kept by the module account for completed auctions
+// as the retained minimum bids.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramSubspace.Set(ctx, types.ParamStoreKeyForfeitedFeeDestination, types.DefaultForfeitedFeeDestination)
+
+	retained, hasNeg := m.keeper.GetAuctionModuleBalances(ctx).SafeSub(m.keeper.GetLockedAmount(ctx)...)
+	if !hasNeg {
+		m.keeper.SetRetainedAmount(ctx, retained)
+	}
+
+	return nil
+}
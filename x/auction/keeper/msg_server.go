@@ -34,6 +34,7 @@ func (s msgServer) CreateAuction(c context.Context, msg *types.MsgCreateAuction)
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateAuction,
+			sdk.NewAttribute(types.AttributeKeyKind, resp.Kind),
 			sdk.NewAttribute(types.AttributeKeyCommitsDuration, msg.CommitsDuration.String()),
 			sdk.NewAttribute(types.AttributeKeyCommitFee, msg.CommitFee.String()),
 			sdk.NewAttribute(types.AttributeKeyRevealFee, msg.RevealFee.String()),
@@ -108,3 +109,92 @@ func (s msgServer) RevealBid(c context.Context, msg *types.MsgRevealBid) (*types
 
 	return &types.MsgRevealBidResponse{Auction: resp}, nil
 }
+
+// PlaceBid is the command for placing an open bid
+func (s msgServer) PlaceBid(c context.Context, msg *types.MsgPlaceBid) (*types.MsgPlaceBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signerAddress, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Keeper.PlaceBid(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePlaceBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, msg.AuctionId),
+			sdk.NewAttribute(types.AttributeKeyBidAmount, msg.BidAmount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySigner, signerAddress.String()),
+		),
+	})
+
+	return &types.MsgPlaceBidResponse{Auction: resp}, nil
+}
+
+// CancelAuction is the command for cancelling an auction
+func (s msgServer) CancelAuction(c context.Context, msg *types.MsgCancelAuction) (*types.MsgCancelAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signerAddress, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Keeper.CancelAuction(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, msg.AuctionId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySigner, signerAddress.String()),
+		),
+	})
+
+	return &types.MsgCancelAuctionResponse{Auction: resp}, nil
+}
+
+// RevealReservePrice is the command for revealing the reserve price of an auction
+func (s msgServer) RevealReservePrice(c context.Context, msg *types.MsgRevealReservePrice) (*types.MsgRevealReservePriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signerAddress, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Keeper.RevealReservePrice(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevealReservePrice,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, msg.AuctionId),
+			sdk.NewAttribute(types.AttributeKeyReveal, msg.Reveal),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySigner, signerAddress.String()),
+		),
+	})
+
+	return &types.MsgRevealReservePriceResponse{Auction: resp}, nil
+}
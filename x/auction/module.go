@@ -117,10 +117,21 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 
 	msgServer := keeper.NewMsgServer(am.keeper)
 	types.RegisterMsgServer(cfg.MsgServer(), msgServer)
+
+	m := keeper.NewMigrator(am.keeper)
+	err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2)
+	if err != nil {
+		panic(err)
+	}
+
+	err = cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	if err != nil {
+		panic(err)
+	}
 }
 
 func (am AppModule) ConsensusVersion() uint64 {
-	return 1
+	return 3
 }
 
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
@@ -14,6 +14,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateAuction{}, "auction/MsgCreateAuction", nil)
 	cdc.RegisterConcrete(&MsgCommitBid{}, "auction/MsgCommitBid", nil)
 	cdc.RegisterConcrete(&MsgRevealBid{}, "auction/MsgRevealBid", nil)
+	cdc.RegisterConcrete(&MsgPlaceBid{}, "auction/MsgPlaceBid", nil)
+	cdc.RegisterConcrete(&MsgCancelAuction{}, "auction/MsgCancelAuction", nil)
+	cdc.RegisterConcrete(&MsgRevealReservePrice{}, "auction/MsgRevealReservePrice", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -21,6 +24,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCreateAuction{},
 		&MsgCommitBid{},
 		&MsgRevealBid{},
+		&MsgPlaceBid{},
+		&MsgCancelAuction{},
+		&MsgRevealReservePrice{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -4,6 +4,10 @@ const (
 	EventTypeCreateAuction = "create-auction"
 	EventTypeCommitBid     = "commit-bid"
 	EventTypeRevealBid     = "reveal-bid"
+	EventTypePlaceBid      = "place-bid"
+
+	EventTypeCancelAuction      = "cancel-auction"
+	EventTypeRevealReservePrice = "reveal-reserve-price"
 
 	AttributeKeyCommitsDuration = "commits-duration"
 	AttributeKeyRevealsDuration = "reveals-duration"
@@ -14,6 +18,8 @@ const (
 	AttributeKeyAuctionID       = "auction-id"
 	AttributeKeyCommitHash      = "commit-hash"
 	AttributeKeyReveal          = "reveal"
+	AttributeKeyKind            = "kind"
+	AttributeKeyBidAmount       = "bid-amount"
 
 	AttributeValueCategory = ModuleName
 )
@@ -14,3 +14,8 @@ type AuctionUsageKeeper interface {
 	OnAuctionBid(ctx sdk.Context, auctionID string, bidderAddress string)
 	OnAuctionWinnerSelected(ctx sdk.Context, auctionID string)
 }
+
+// DistributionKeeper defines the distribution keeper functionality used to fund the community pool.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -27,6 +29,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params   Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	Auctions []*Auction `protobuf:"bytes,2,rep,name=auctions,proto3" json:"auctions,omitempty" json:"bonds" yaml:"bonds"`
+	// retained_amount is the minimum bid of the winning prices kept by the module account
+	RetainedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=retained_amount,json=retainedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"retained_amount"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +80,13 @@ func (m *GenesisState) GetAuctions() []*Auction {
 	return nil
 }
 
+func (m *GenesisState) GetRetainedAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RetainedAmount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "vulcanize.auction.v1beta1.GenesisState")
 }
@@ -85,24 +96,29 @@ func init() {
 }
 
 var fileDescriptor_23ebfbd3a1e67fe6 = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2f, 0x2b, 0xcd, 0x49,
-	0x4e, 0xcc, 0xcb, 0xac, 0x4a, 0xd5, 0x4f, 0x2c, 0x4d, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33,
-	0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0x84, 0x2b, 0xd4, 0x83, 0x2a, 0xd4, 0x83, 0x2a, 0x94, 0x12, 0x49,
-	0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd2, 0x07, 0xb1, 0x20, 0x1a, 0xa4, 0x54, 0x71, 0x9b, 0x5c, 0x52,
-	0x59, 0x90, 0x0a, 0x35, 0x57, 0x69, 0x1d, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xa6, 0xe0, 0x92, 0xc4,
-	0x92, 0x54, 0x21, 0x7b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46,
-	0x0d, 0x6e, 0x23, 0x45, 0x3d, 0x9c, 0x36, 0xeb, 0x05, 0x80, 0x15, 0x3a, 0xb1, 0x9c, 0xb8, 0x27,
-	0xcf, 0x10, 0x04, 0xd5, 0x26, 0x14, 0xcb, 0xc5, 0x01, 0x55, 0x57, 0x2c, 0xc1, 0xa4, 0xc0, 0xac,
-	0xc1, 0x6d, 0xa4, 0x84, 0xc7, 0x08, 0x47, 0x08, 0xdf, 0x49, 0xf6, 0xd3, 0x3d, 0x79, 0xc9, 0xac,
-	0xe2, 0xfc, 0x3c, 0x2b, 0xa5, 0xa4, 0xfc, 0xbc, 0x94, 0x62, 0x25, 0x85, 0xca, 0xc4, 0xdc, 0x1c,
-	0x18, 0x27, 0x08, 0x6e, 0xa4, 0x93, 0xdb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e,
-	0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31,
-	0x44, 0xe9, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x97, 0x64, 0x24,
-	0x16, 0x15, 0x67, 0x16, 0xeb, 0xa7, 0x96, 0x64, 0xa4, 0x16, 0xe5, 0x66, 0xe6, 0x95, 0xe8, 0x57,
-	0xc0, 0x83, 0x01, 0xec, 0xfd, 0x24, 0x36, 0xb0, 0xff, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x40, 0x0a, 0x31, 0x1a, 0x82, 0x01, 0x00, 0x00,
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x41, 0x4a, 0xc3, 0x40,
+	0x14, 0x86, 0x93, 0x56, 0x8a, 0xa4, 0xa2, 0x10, 0x5c, 0xb4, 0x05, 0xd3, 0x1a, 0x10, 0xbb, 0xd0,
+	0x19, 0x5b, 0x77, 0x6e, 0xa4, 0x11, 0x74, 0x2b, 0x75, 0x27, 0x88, 0x4c, 0xd2, 0x21, 0x1d, 0x6d,
+	0x66, 0x4a, 0xde, 0x4b, 0xb1, 0x9e, 0xc2, 0x43, 0xb8, 0xf2, 0x24, 0x5d, 0x76, 0xe9, 0xaa, 0x4a,
+	0x7b, 0x03, 0x4f, 0x20, 0x49, 0xa6, 0xc1, 0x4d, 0x5d, 0x25, 0x8f, 0xf9, 0xdf, 0xf7, 0x3f, 0x3e,
+	0xeb, 0x78, 0x92, 0x8c, 0x02, 0x26, 0xc5, 0x2b, 0xa7, 0x2c, 0x09, 0x50, 0x28, 0x49, 0x27, 0x1d,
+	0x9f, 0x23, 0xeb, 0xd0, 0x90, 0x4b, 0x0e, 0x02, 0xc8, 0x38, 0x56, 0xa8, 0xec, 0x7a, 0x11, 0x24,
+	0x3a, 0x48, 0x74, 0xb0, 0xb1, 0x1f, 0xaa, 0x50, 0x65, 0x29, 0x9a, 0xfe, 0xe5, 0x0b, 0x0d, 0x27,
+	0x50, 0x10, 0x29, 0xa0, 0x3e, 0x03, 0x5e, 0x30, 0x03, 0x25, 0xa4, 0x7e, 0x3f, 0xda, 0xdc, 0x8c,
+	0xd3, 0x31, 0xd7, 0xbd, 0xee, 0x7b, 0xc9, 0xda, 0xb9, 0xc9, 0x2f, 0xb9, 0x43, 0x86, 0xdc, 0xbe,
+	0xb4, 0x2a, 0x63, 0x16, 0xb3, 0x08, 0x6a, 0x66, 0xcb, 0x6c, 0x57, 0xbb, 0x87, 0x64, 0xe3, 0x65,
+	0xe4, 0x36, 0x0b, 0x7a, 0x5b, 0xb3, 0x45, 0xd3, 0xe8, 0xeb, 0x35, 0xfb, 0xc1, 0xda, 0xd6, 0x39,
+	0xa8, 0x95, 0x5a, 0xe5, 0x76, 0xb5, 0xeb, 0xfe, 0x83, 0xe8, 0xe5, 0xb3, 0x77, 0xf0, 0xb3, 0x68,
+	0xd6, 0x9f, 0x40, 0xc9, 0x0b, 0xd7, 0x57, 0x72, 0x00, 0x6e, 0x6b, 0xca, 0xa2, 0xd1, 0x7a, 0xe8,
+	0x17, 0x48, 0x1b, 0xad, 0xbd, 0x98, 0x23, 0x13, 0x92, 0x0f, 0x1e, 0x59, 0xa4, 0x12, 0x89, 0xb5,
+	0x72, 0xd6, 0x52, 0x27, 0xb9, 0x11, 0x92, 0x1a, 0x29, 0xf8, 0x57, 0x4a, 0x48, 0xef, 0x2c, 0x3d,
+	0xf0, 0xe3, 0xab, 0xd9, 0x0e, 0x05, 0x0e, 0x13, 0x9f, 0x04, 0x2a, 0xa2, 0x5a, 0x5f, 0xfe, 0x39,
+	0x85, 0xc1, 0xb3, 0xd6, 0x92, 0x2e, 0x40, 0x7f, 0x77, 0xdd, 0xd1, 0xcb, 0x2a, 0xbc, 0xeb, 0xd9,
+	0xd2, 0x31, 0xe7, 0x4b, 0xc7, 0xfc, 0x5e, 0x3a, 0xe6, 0xdb, 0xca, 0x31, 0xe6, 0x2b, 0xc7, 0xf8,
+	0x5c, 0x39, 0xc6, 0xfd, 0xc9, 0x1f, 0x26, 0x0e, 0x59, 0x0c, 0x02, 0x28, 0xc7, 0x21, 0x8f, 0x23,
+	0x21, 0x91, 0xbe, 0x14, 0xf2, 0x33, 0xba, 0x5f, 0xc9, 0xac, 0x9f, 0xff, 0x0e, 0x00, 0xec, 0x85,
+	0x52, 0x7b, 0x18, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +141,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RetainedAmount) > 0 {
+		for iNdEx := len(m.RetainedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetainedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Auctions) > 0 {
 		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,6 +207,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RetainedAmount) > 0 {
+		for _, e := range m.RetainedAmount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -282,6 +318,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetainedAmount = append(m.RetainedAmount, types.Coin{})
+			if err := m.RetainedAmount[len(m.RetainedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -9,6 +9,9 @@ var (
 	_ sdk.Msg = &MsgCreateAuction{}
 	_ sdk.Msg = &MsgCommitBid{}
 	_ sdk.Msg = &MsgRevealBid{}
+	_ sdk.Msg = &MsgPlaceBid{}
+	_ sdk.Msg = &MsgCancelAuction{}
+	_ sdk.Msg = &MsgRevealReservePrice{}
 )
 
 // NewMsgCreateAuction is the constructor function for MsgCreateAuction.
@@ -39,14 +42,45 @@ func (msg MsgCreateAuction) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commit phase duration invalid.")
 	}
 
-	if msg.RevealsDuration <= 0 {
+	if !IsValidAuctionKind(msg.Kind) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid auction kind.")
+	}
+
+	if IsSealedBidKind(msg.Kind) && msg.RevealsDuration <= 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "reveal phase duration invalid.")
 	}
 
-	if !msg.MinimumBid.IsPositive() {
+	if !IsSealedBidKind(msg.Kind) && msg.RevealsDuration != 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "open auctions have no reveal phase.")
+	}
+
+	if msg.MinimumBid.Amount.IsNil() || !msg.MinimumBid.IsPositive() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "minimum bid should be greater than zero.")
 	}
 
+	switch msg.Kind {
+	case AuctionKindDutch:
+		if msg.StartPrice.Amount.IsNil() || msg.StartPrice.Denom != msg.MinimumBid.Denom || !msg.MinimumBid.IsLT(msg.StartPrice) {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "start price should be greater than minimum bid.")
+		}
+
+		if msg.ReservePriceHash != "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "dutch auctions settle immediately, use the minimum bid as reserve price.")
+		}
+	case AuctionKindEnglish:
+		if !msg.BidIncrement.Amount.IsNil() && !msg.BidIncrement.IsZero() && msg.BidIncrement.Denom != msg.MinimumBid.Denom {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bid increment denom should match minimum bid.")
+		}
+
+		if msg.ExtensionWindow < 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "extension window cannot be negative.")
+		}
+	case AuctionKindUniformPrice:
+		if msg.NumWinners == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "number of winners should be greater than zero.")
+		}
+	}
+
 	return nil
 }
 
@@ -148,3 +182,128 @@ func (msg MsgRevealBid) GetSigners() []sdk.AccAddress {
 	accAddr, _ := sdk.AccAddressFromBech32(msg.Signer)
 	return []sdk.AccAddress{accAddr}
 }
+
+// NewMsgPlaceBid is the constructor function for MsgPlaceBid.
+func NewMsgPlaceBid(auctionID string, bidAmount sdk.Coin, signer sdk.AccAddress) MsgPlaceBid {
+
+	return MsgPlaceBid{
+		AuctionId: auctionID,
+		BidAmount: bidAmount,
+		Signer:    signer.String(),
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgPlaceBid) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgPlaceBid) Type() string { return "place" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgPlaceBid) ValidateBasic() error {
+	if msg.Signer == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer address.")
+	}
+
+	if msg.AuctionId == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid auction ID.")
+	}
+
+	if msg.BidAmount.Amount.IsNil() || !msg.BidAmount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bid amount should be greater than zero.")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgPlaceBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgPlaceBid) GetSigners() []sdk.AccAddress {
+	accAddr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgCancelAuction is the constructor function for MsgCancelAuction.
+func NewMsgCancelAuction(auctionID string, signer sdk.AccAddress) MsgCancelAuction {
+	return MsgCancelAuction{
+		AuctionId: auctionID,
+		Signer:    signer.String(),
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCancelAuction) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCancelAuction) Type() string { return "cancel" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelAuction) ValidateBasic() error {
+	if msg.Signer == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer address.")
+	}
+
+	if msg.AuctionId == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid auction ID.")
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the sign bytes for the msg MsgCancelAuction
+func (msg MsgCancelAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelAuction) GetSigners() []sdk.AccAddress {
+	accAddr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgRevealReservePrice is the constructor function for MsgRevealReservePrice.
+func NewMsgRevealReservePrice(auctionID string, reveal string, signer sdk.AccAddress) MsgRevealReservePrice {
+	return MsgRevealReservePrice{
+		AuctionId: auctionID,
+		Reveal:    reveal,
+		Signer:    signer.String(),
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRevealReservePrice) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRevealReservePrice) Type() string { return "reveal-reserve" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevealReservePrice) ValidateBasic() error {
+	if msg.Signer == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer address.")
+	}
+
+	if msg.AuctionId == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid auction ID.")
+	}
+
+	if msg.Reveal == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid reveal data.")
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the sign bytes for the msg MsgRevealReservePrice
+func (msg MsgRevealReservePrice) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevealReservePrice) GetSigners() []sdk.AccAddress {
+	accAddr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{accAddr}
+}
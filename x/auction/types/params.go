@@ -16,6 +16,18 @@ const (
 	DefaultParamspace = ModuleName
 )
 
+// Destinations of forfeited commit and reveal fees.
+const (
+	// ForfeitedFeeDestinationBurn sends forfeited fees to the auction burn module account.
+	ForfeitedFeeDestinationBurn = "burn"
+
+	// ForfeitedFeeDestinationCommunity sends forfeited fees to the community pool.
+	ForfeitedFeeDestinationCommunity = "community"
+
+	// ForfeitedFeeDestinationOwner sends forfeited fees to the auction owner.
+	ForfeitedFeeDestinationOwner = "owner"
+)
+
 var (
 	DefaultCommitsDuration = 5 * time.Minute
 	DefaultRevealsDuration = 5 * time.Minute
@@ -23,11 +35,15 @@ var (
 	DefaultRevealFee       = sdk.Coin{Amount: sdk.NewInt(10), Denom: sdk.DefaultBondDenom}
 	DefaultMinimumBid      = sdk.Coin{Amount: sdk.NewInt(1000), Denom: sdk.DefaultBondDenom}
 
+	DefaultForfeitedFeeDestination = ForfeitedFeeDestinationBurn
+
 	ParamStoreKeyCommitsDuration = []byte("CommitsDuration")
 	ParamStoreKeyRevealsDuration = []byte("RevealsDuration")
 	ParamStoreKeyCommitFee       = []byte("CommitFee")
 	ParamStoreKeyRevealFee       = []byte("RevealFee")
 	ParamStoreKeyMinimumBid      = []byte("MinimumBid")
+
+	ParamStoreKeyForfeitedFeeDestination = []byte("ForfeitedFeeDestination")
 )
 
 var _ types.ParamSet = &Params{}
@@ -49,6 +65,7 @@ func (p *Params) ParamSetPairs() types.ParamSetPairs {
 		types.NewParamSetPair(ParamStoreKeyCommitFee, &p.CommitFee, validateCommitFee),
 		types.NewParamSetPair(ParamStoreKeyRevealFee, &p.RevealFee, validateRevealFee),
 		types.NewParamSetPair(ParamStoreKeyMinimumBid, &p.MinimumBid, validateMinimumBid),
+		types.NewParamSetPair(ParamStoreKeyForfeitedFeeDestination, &p.ForfeitedFeeDestination, validateForfeitedFeeDestination),
 	}
 }
 
@@ -67,6 +84,8 @@ func DefaultParams() Params {
 		CommitFee:       DefaultCommitFee,
 		RevealFee:       DefaultRevealFee,
 		MinimumBid:      DefaultMinimumBid,
+
+		ForfeitedFeeDestination: DefaultForfeitedFeeDestination,
 	}
 }
 
@@ -79,6 +98,7 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("CommitFee: %s\n", p.CommitFee.String()))
 	sb.WriteString(fmt.Sprintf("RevealFee: %s\n", p.RevealFee.String()))
 	sb.WriteString(fmt.Sprintf("MinimumBid: %s\n", p.MinimumBid.String()))
+	sb.WriteString(fmt.Sprintf("ForfeitedFeeDestination: %s\n", p.ForfeitedFeeDestination))
 	return sb.String()
 }
 
@@ -147,6 +167,20 @@ func validateMinimumBid(i interface{}) error {
 	return nil
 }
 
+func validateForfeitedFeeDestination(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch v {
+	case ForfeitedFeeDestinationBurn, ForfeitedFeeDestinationCommunity, ForfeitedFeeDestinationOwner:
+		return nil
+	default:
+		return fmt.Errorf("invalid forfeited fee destination: %s", v)
+	}
+}
+
 // Validate a set of params.
 func (p Params) Validate() error {
 	if err := validateCommitsDuration(p.CommitsDuration); err != nil {
@@ -169,5 +203,9 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateForfeitedFeeDestination(p.ForfeitedFeeDestination); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -23,10 +24,37 @@ const (
 	AuctionStatusCompleted = "completed"
 )
 
+// Auction kinds.
+const (
+	// Sealed-bid second price auction, the highest bidder pays the second highest bid.
+	AuctionKindVickrey = "vickrey"
+
+	// Ascending open auction, bids near the end extend the bidding phase.
+	AuctionKindEnglish = "english"
+
+	// Descending price auction, the first bid at or above the current price wins.
+	AuctionKindDutch = "dutch"
+
+	// Sealed-bid multi-unit auction, the highest bidders all pay the highest losing bid.
+	AuctionKindUniformPrice = "uniform"
+)
+
+// Auction status values.
+const (
+	// Auction accepts open bids (english and dutch auctions).
+	AuctionStatusOpenPhase = "open"
+
+	// Auction was cancelled by its owner (fees refunded).
+	AuctionStatusCancelled = "cancelled"
+)
+
 // Bid status values.
 const (
 	BidStatusCommitted = "commit"
 	BidStatusRevealed  = "reveal"
+
+	// Open bid placed in an english or dutch auction.
+	BidStatusPlaced = "placed"
 )
 
 // AuctionID simplifies generation of auction IDs.
@@ -44,6 +72,44 @@ func (auctionID AuctionID) Generate() string {
 	return hex.EncodeToString(hasher.Sum(nil))
 }
 
+// IsSealedBid returns true if the auction uses the commit/reveal bidding flow.
+func (auction Auction) IsSealedBid() bool {
+	return IsSealedBidKind(auction.Kind)
+}
+
+// IsSealedBidKind returns true if auctions of the given kind use the commit/reveal bidding flow.
+func IsSealedBidKind(kind string) bool {
+	return kind == "" || kind == AuctionKindVickrey || kind == AuctionKindUniformPrice
+}
+
+// IsValidAuctionKind returns true if the kind is a known auction mechanism.
+func IsValidAuctionKind(kind string) bool {
+	switch kind {
+	case "", AuctionKindVickrey, AuctionKindEnglish, AuctionKindDutch, AuctionKindUniformPrice:
+		return true
+	}
+
+	return false
+}
+
+// GetDutchPrice returns the asking price of a dutch auction at the given time.
+// The price decreases linearly from the start price to the minimum bid over the bidding phase.
+func (auction Auction) GetDutchPrice(now time.Time) sdk.Coin {
+	if !now.After(auction.CreateTime) {
+		return auction.StartPrice
+	}
+
+	if !now.Before(auction.CommitsEndTime) {
+		return auction.MinimumBid
+	}
+
+	elapsed := sdk.NewInt(int64(now.Sub(auction.CreateTime)))
+	total := sdk.NewInt(int64(auction.CommitsEndTime.Sub(auction.CreateTime)))
+	drop := auction.StartPrice.Amount.Sub(auction.MinimumBid.Amount).Mul(elapsed).Quo(total)
+
+	return sdk.NewCoin(auction.StartPrice.Denom, auction.StartPrice.Amount.Sub(drop))
+}
+
 func (auction Auction) GetCreateTime() string {
 	return string(sdk.FormatTimeBytes(auction.CreateTime))
 }
@@ -63,3 +129,15 @@ func (bid Bid) GetCommitTime() string {
 func (bid Bid) GetRevealTime() string {
 	return string(sdk.FormatTimeBytes(bid.RevealTime))
 }
+
+// GetLockedAmount returns the bid amount and fees held by the auction module for an unsettled bid.
+func (bid Bid) GetLockedAmount() sdk.Coins {
+	locked := sdk.NewCoins()
+	for _, coin := range []sdk.Coin{bid.CommitFee, bid.RevealFee, bid.BidAmount} {
+		if coin.Denom != "" && !coin.Amount.IsNil() && coin.IsPositive() {
+			locked = locked.Add(coin)
+		}
+	}
+
+	return locked
+}
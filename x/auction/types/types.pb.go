@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -41,6 +42,8 @@ type Params struct {
 	RevealFee types.Coin `protobuf:"bytes,4,opt,name=reveal_fee,json=revealFee,proto3" json:"reveal_fee" json:"reveal_fee" yaml:"reveal_fee"`
 	// Minimum acceptable bid amount
 	MinimumBid types.Coin `protobuf:"bytes,5,opt,name=minimum_bid,json=minimumBid,proto3" json:"minimum_bid" json:"minimum_bid" yaml:"minimum_bid"`
+	// Destination of forfeited commit and reveal fees (burn, community or owner)
+	ForfeitedFeeDestination string `protobuf:"bytes,6,opt,name=forfeited_fee_destination,json=forfeitedFeeDestination,proto3" json:"forfeited_fee_destination,omitempty" json:"forfeited_fee_destination" yaml:"forfeited_fee_destination"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -110,6 +113,13 @@ func (m *Params) GetMinimumBid() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetForfeitedFeeDestination() string {
+	if m != nil {
+		return m.ForfeitedFeeDestination
+	}
+	return ""
+}
+
 // Auction represents a sealed-bid on-chain auction
 type Auction struct {
 	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -134,6 +144,24 @@ type Auction struct {
 	WinningBid types.Coin `protobuf:"bytes,11,opt,name=winning_bid,json=winningBid,proto3" json:"winning_bid" json:"winning_bid" yaml:"winning_bid"`
 	// Amount the winner pays, i.e. the second highest auction
 	WinningPrice types.Coin `protobuf:"bytes,12,opt,name=winning_price,json=winningPrice,proto3" json:"winning_price" json:"winning_price" yaml:"winning_price"`
+	// Auction mechanism (vickrey, english, dutch or uniform)
+	Kind string `protobuf:"bytes,13,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Opening price of a dutch auction, decreasing to the minimum bid
+	StartPrice types.Coin `protobuf:"bytes,14,opt,name=start_price,json=startPrice,proto3" json:"start_price" json:"start_price" yaml:"start_price"`
+	// Minimum raise over the current highest bid in an english auction
+	BidIncrement types.Coin `protobuf:"bytes,15,opt,name=bid_increment,json=bidIncrement,proto3" json:"bid_increment" json:"bid_increment" yaml:"bid_increment"`
+	// Bids placed this close to the end of an english auction extend it by the same duration
+	ExtensionWindow time.Duration `protobuf:"bytes,16,opt,name=extension_window,json=extensionWindow,proto3,stdduration" json:"extension_window" json:"extension_window" yaml:"extension_window"`
+	// Number of units sold in a uniform price auction
+	NumWinners uint64 `protobuf:"varint,17,opt,name=num_winners,json=numWinners,proto3" json:"num_winners,omitempty" json:"num_winners" yaml:"num_winners"`
+	// Addresses of all winners, highest bid first
+	WinnerAddresses []string `protobuf:"bytes,18,rep,name=winner_addresses,json=winnerAddresses,proto3" json:"winner_addresses,omitempty" json:"winner_addresses" yaml:"winner_addresses"`
+	// Winning bids, in the same order as the winner addresses
+	WinningBids []types.Coin `protobuf:"bytes,19,rep,name=winning_bids,json=winningBids,proto3" json:"winning_bids" json:"winning_bids" yaml:"winning_bids"`
+	// Hash of the hidden reserve price reveal
+	ReservePriceHash string `protobuf:"bytes,20,opt,name=reserve_price_hash,json=reservePriceHash,proto3" json:"reserve_price_hash,omitempty" json:"reserve_price_hash" yaml:"reserve_price_hash"`
+	// Reserve price, set once revealed by the owner
+	ReservePrice types.Coin `protobuf:"bytes,21,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price" json:"reserve_price" yaml:"reserve_price"`
 }
 
 func (m *Auction) Reset()         { *m = Auction{} }
@@ -217,6 +245,8 @@ type Bid struct {
 	RevealTime    time.Time  `protobuf:"bytes,7,opt,name=reveal_time,json=revealTime,proto3,stdtime" json:"reveal_time" json:"reveal_time" yaml:"reveal_time"`
 	RevealFee     types.Coin `protobuf:"bytes,8,opt,name=reveal_fee,json=revealFee,proto3" json:"reveal_fee" json:"reveal_fee" yaml:"reveal_fee"`
 	BidAmount     types.Coin `protobuf:"bytes,9,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount" json:"bid_amount" yaml:"bid_amount"`
+	// Settlement of the bid, set when the auction completes
+	Settlement *BidSettlement `protobuf:"bytes,10,opt,name=settlement,proto3" json:"settlement,omitempty" json:"settlement" yaml:"settlement"`
 }
 
 func (m *Bid) Reset()         { *m = Bid{} }
@@ -252,11 +282,57 @@ func (m *Bid) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Bid proto.InternalMessageInfo
 
+// BidSettlement is the breakdown of the funds of a bid when its auction completes
+type BidSettlement struct {
+	// Locked bid amount and fees returned to the bidder
+	Refunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded" json:"refunded" yaml:"refunded"`
+	// Commit and reveal fees forfeited by the bidder
+	Forfeited github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=forfeited,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"forfeited" json:"forfeited" yaml:"forfeited"`
+	// Destination of the forfeited fees
+	ForfeitedTo string `protobuf:"bytes,3,opt,name=forfeited_to,json=forfeitedTo,proto3" json:"forfeited_to,omitempty" json:"forfeited_to" yaml:"forfeited_to"`
+	// Price paid by a winning bidder
+	Paid types.Coin `protobuf:"bytes,4,opt,name=paid,proto3" json:"paid" json:"paid" yaml:"paid"`
+}
+
+func (m *BidSettlement) Reset()         { *m = BidSettlement{} }
+func (m *BidSettlement) String() string { return proto.CompactTextString(m) }
+func (*BidSettlement) ProtoMessage()    {}
+func (*BidSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ef8053ad65dd4d3, []int{4}
+}
+func (m *BidSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidSettlement.Merge(m, src)
+}
+func (m *BidSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidSettlement proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "vulcanize.auction.v1beta1.Params")
 	proto.RegisterType((*Auction)(nil), "vulcanize.auction.v1beta1.Auction")
 	proto.RegisterType((*Auctions)(nil), "vulcanize.auction.v1beta1.Auctions")
 	proto.RegisterType((*Bid)(nil), "vulcanize.auction.v1beta1.Bid")
+	proto.RegisterType((*BidSettlement)(nil), "vulcanize.auction.v1beta1.BidSettlement")
 }
 
 func init() {
@@ -264,58 +340,86 @@ func init() {
 }
 
 var fileDescriptor_4ef8053ad65dd4d3 = []byte{
-	// 809 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x4f, 0xdb, 0x4a,
-	0x14, 0x8d, 0x49, 0x08, 0xf1, 0x98, 0xf0, 0x9e, 0xac, 0xa7, 0xa7, 0x80, 0xd4, 0x04, 0x05, 0x21,
-	0x51, 0xb5, 0xb5, 0x05, 0xdd, 0xd1, 0x15, 0x29, 0x45, 0xed, 0x0e, 0x59, 0x55, 0x17, 0xdd, 0x44,
-	0x63, 0x7b, 0x48, 0xa6, 0x8a, 0x3d, 0xa9, 0x67, 0x0c, 0xa5, 0xbb, 0xee, 0xba, 0x44, 0x5d, 0xb1,
-	0x6b, 0x7f, 0x0e, 0xbb, 0xb2, 0xec, 0x8a, 0x56, 0xf0, 0x0f, 0xfa, 0x0b, 0x2a, 0xcf, 0x87, 0x33,
-	0x36, 0xa0, 0x34, 0x0b, 0xd8, 0x65, 0xce, 0xcc, 0xbd, 0xe7, 0xdc, 0x99, 0x7b, 0x6e, 0x0c, 0xd6,
-	0x0f, 0xd3, 0x51, 0x00, 0x63, 0xfc, 0x11, 0xb9, 0x30, 0x0d, 0x18, 0x26, 0xb1, 0x7b, 0xb8, 0xe9,
-	0x23, 0x06, 0x37, 0x5d, 0x76, 0x3c, 0x46, 0xd4, 0x19, 0x27, 0x84, 0x11, 0x7b, 0x39, 0x3f, 0xe6,
-	0xc8, 0x63, 0x8e, 0x3c, 0xb6, 0xf2, 0xdf, 0x80, 0x0c, 0x08, 0x3f, 0xe5, 0x66, 0xbf, 0x44, 0xc0,
-	0x4a, 0x7b, 0x40, 0xc8, 0x60, 0x84, 0x5c, 0xbe, 0xf2, 0xd3, 0x03, 0x37, 0x4c, 0x13, 0xc8, 0xe3,
-	0xc4, 0x7e, 0xa7, 0xbc, 0xcf, 0x70, 0x84, 0x28, 0x83, 0xd1, 0x58, 0x25, 0x08, 0x08, 0x8d, 0x08,
-	0x75, 0x7d, 0x48, 0x51, 0x2e, 0x29, 0x20, 0x58, 0x26, 0xe8, 0x7e, 0xaf, 0x81, 0xfa, 0x3e, 0x4c,
-	0x60, 0x44, 0xed, 0x4f, 0x06, 0xf8, 0x37, 0x20, 0x51, 0x84, 0x19, 0xed, 0x2b, 0x9a, 0x96, 0xb1,
-	0x6a, 0x6c, 0x58, 0x5b, 0xcb, 0x8e, 0xe0, 0x71, 0x14, 0x8f, 0xb3, 0x2b, 0x0f, 0xf4, 0x9e, 0x9d,
-	0x5d, 0x74, 0x2a, 0xbf, 0x2f, 0x3a, 0xee, 0x3b, 0x4a, 0xe2, 0xed, 0x6e, 0x39, 0x41, 0x77, 0xf5,
-	0x18, 0x46, 0xa3, 0x1b, 0xf0, 0xd3, 0x9f, 0x1d, 0xc3, 0xfb, 0x47, 0xc2, 0x2a, 0x1b, 0xd7, 0x90,
-	0xa0, 0x43, 0x04, 0x47, 0x9a, 0x86, 0xb9, 0x19, 0x35, 0x94, 0x13, 0x28, 0x0d, 0xd7, 0x70, 0xa1,
-	0x41, 0xc2, 0xb9, 0x06, 0x04, 0x80, 0x90, 0xd5, 0x3f, 0x40, 0xa8, 0x55, 0x95, 0xe4, 0xe2, 0x1e,
-	0x9d, 0xec, 0x1e, 0xd5, 0x9b, 0x39, 0xcf, 0x09, 0x8e, 0x7b, 0x8f, 0x24, 0xf9, 0x9a, 0x7e, 0x01,
-	0x59, 0x68, 0xb1, 0x74, 0x8e, 0x78, 0xa6, 0x58, 0xec, 0x21, 0x94, 0xd1, 0x08, 0x66, 0x4e, 0x53,
-	0x9b, 0x91, 0x66, 0x12, 0x5a, 0xac, 0x4e, 0xd2, 0x88, 0x45, 0x46, 0x83, 0x81, 0x15, 0xe1, 0x18,
-	0x47, 0x69, 0xd4, 0xf7, 0x71, 0xd8, 0x9a, 0x9f, 0xc6, 0xf3, 0x44, 0xf2, 0xac, 0x0b, 0x1e, 0x2d,
-	0x56, 0x11, 0xe9, 0x90, 0x07, 0xe4, 0xaa, 0x87, 0xc3, 0xed, 0xda, 0xe9, 0xb7, 0x4e, 0xa5, 0xfb,
-	0xa5, 0x01, 0x16, 0x76, 0x44, 0x73, 0xdb, 0x4b, 0x60, 0x0e, 0x87, 0xbc, 0x87, 0x4c, 0x6f, 0x0e,
-	0x87, 0xf6, 0xff, 0xa0, 0x4e, 0x19, 0x64, 0x29, 0xe5, 0x6f, 0x6a, 0x7a, 0x72, 0x65, 0xaf, 0x81,
-	0x26, 0x39, 0x8a, 0x51, 0xd2, 0x87, 0x61, 0x98, 0x20, 0x4a, 0xf9, 0xad, 0x9b, 0xde, 0x22, 0x07,
-	0x77, 0x04, 0x66, 0xc7, 0xc0, 0x0a, 0x12, 0x04, 0x19, 0xea, 0x67, 0x4d, 0x2e, 0x6f, 0x6c, 0xe5,
-	0x5a, 0x57, 0xbc, 0x56, 0x0e, 0xe8, 0x6d, 0x16, 0x4b, 0xd1, 0x82, 0xf3, 0xa7, 0xd1, 0xa0, 0x93,
-	0xac, 0x19, 0x80, 0x40, 0xb2, 0x1c, 0x05, 0x3f, 0xa0, 0x38, 0x14, 0xac, 0xf3, 0x53, 0x59, 0x6f,
-	0x31, 0x84, 0xca, 0x50, 0x36, 0x44, 0x8e, 0x73, 0xfe, 0x25, 0x09, 0xbf, 0x88, 0xc3, 0x5c, 0x83,
-	0x6a, 0xdb, 0x5c, 0x43, 0x7d, 0x56, 0x0d, 0xe5, 0x0c, 0x65, 0x43, 0x94, 0x34, 0x48, 0x58, 0x69,
-	0x28, 0xfa, 0x61, 0xe1, 0x7e, 0xfc, 0xd0, 0xb8, 0x27, 0x3f, 0x98, 0x77, 0xe7, 0x07, 0x7b, 0x1d,
-	0x2c, 0x1d, 0xe1, 0x58, 0x6f, 0x6b, 0xc0, 0xdb, 0xba, 0x29, 0x50, 0xd5, 0xd7, 0x18, 0x58, 0x19,
-	0x80, 0xe3, 0x01, 0x57, 0x64, 0xcd, 0xa8, 0x48, 0x8b, 0x55, 0x8a, 0x74, 0xc8, 0x03, 0x72, 0x95,
-	0x29, 0x7a, 0x0f, 0x9a, 0x6a, 0x6f, 0x9c, 0xe0, 0x00, 0xb5, 0x16, 0xa7, 0x91, 0x29, 0x0f, 0x3d,
-	0x2c, 0x92, 0xf1, 0xe8, 0x32, 0x9d, 0x00, 0xbd, 0x45, 0xb9, 0xde, 0xcf, 0x96, 0xdb, 0xb5, 0xcf,
-	0xd9, 0x50, 0x78, 0x03, 0x1a, 0x72, 0x26, 0x50, 0x7b, 0x17, 0x34, 0xe4, 0x9f, 0x1f, 0x6d, 0x19,
-	0xab, 0xd5, 0x0d, 0x6b, 0xab, 0xeb, 0xdc, 0xfa, 0xbf, 0xe8, 0xc8, 0xb0, 0x5e, 0x2d, 0x13, 0xe2,
-	0xe5, 0x91, 0x32, 0xef, 0xd7, 0x79, 0x50, 0xcd, 0x0a, 0x7b, 0x00, 0x80, 0xdc, 0xe9, 0xe7, 0x03,
-	0xc7, 0x94, 0xc8, 0x2b, 0xfe, 0x12, 0x3e, 0x0e, 0x43, 0xed, 0x25, 0xc4, 0xfc, 0x69, 0x0a, 0x54,
-	0xbd, 0xc4, 0x64, 0x3c, 0x55, 0x0b, 0xe3, 0xa9, 0x03, 0x2c, 0xd9, 0xb4, 0x43, 0x48, 0x87, 0x7c,
-	0xf2, 0x98, 0x9e, 0x34, 0xc5, 0x4b, 0x48, 0x87, 0x7c, 0x34, 0x89, 0x03, 0x7f, 0x39, 0x24, 0xca,
-	0xa3, 0x69, 0x12, 0x5c, 0x72, 0x89, 0x3e, 0x9a, 0x38, 0x72, 0x83, 0x25, 0xeb, 0x77, 0x65, 0xc9,
-	0x18, 0x58, 0xd2, 0x45, 0xbc, 0xac, 0x85, 0x59, 0xcb, 0xd2, 0x82, 0x4b, 0xae, 0xd4, 0xca, 0x12,
-	0x88, 0x2a, 0xeb, 0x3e, 0x46, 0x00, 0x02, 0xc0, 0xc7, 0x61, 0x1f, 0x46, 0x24, 0x8d, 0xd9, 0xf4,
-	0x09, 0x50, 0xa2, 0x99, 0x84, 0x2a, 0x1a, 0x0d, 0xf1, 0x4c, 0x1f, 0x87, 0x3b, 0xfc, 0xb7, 0xe8,
-	0xd0, 0xde, 0xde, 0xd9, 0x65, 0xdb, 0x38, 0xbf, 0x6c, 0x1b, 0xbf, 0x2e, 0xdb, 0xc6, 0xc9, 0x55,
-	0xbb, 0x72, 0x7e, 0xd5, 0xae, 0xfc, 0xb8, 0x6a, 0x57, 0xde, 0x3e, 0x1e, 0x60, 0x36, 0x4c, 0x7d,
-	0x27, 0x20, 0x91, 0xcb, 0x86, 0x30, 0xa1, 0x98, 0xba, 0x88, 0x0d, 0x51, 0x12, 0xe1, 0x98, 0xb9,
-	0x1f, 0xf2, 0x0f, 0x49, 0xfe, 0x01, 0xe9, 0xd7, 0xf9, 0x75, 0x3f, 0xfd, 0x13, 0x00, 0x00, 0xff,
-	0xff, 0xb9, 0xce, 0x8c, 0x23, 0x6a, 0x0a, 0x00, 0x00,
+	// 1254 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x36, 0x2d, 0x59, 0xb6, 0x4e, 0x92, 0xed, 0xb2, 0x69, 0x43, 0x1b, 0xa8, 0xa4, 0x2a, 0x30,
+	0xa2, 0x20, 0x8d, 0x08, 0xd7, 0x43, 0x01, 0x77, 0x32, 0xeb, 0x18, 0x4d, 0x87, 0x20, 0x60, 0xd2,
+	0x06, 0xe8, 0x50, 0x82, 0xd2, 0x9d, 0xa5, 0x6b, 0xcc, 0xa3, 0xcd, 0x3b, 0xd9, 0x49, 0xb7, 0x6e,
+	0x45, 0x87, 0x22, 0xe8, 0x94, 0xb1, 0x73, 0xff, 0x92, 0x8c, 0x01, 0xb2, 0x74, 0x72, 0x0a, 0xfb,
+	0x3f, 0xf0, 0x5e, 0xa0, 0xb8, 0x5f, 0xe4, 0x91, 0xf2, 0x8f, 0x68, 0x88, 0x27, 0xeb, 0xbe, 0xbb,
+	0xf7, 0xbe, 0xef, 0xdd, 0xbd, 0x77, 0xef, 0x68, 0xb0, 0x76, 0x38, 0xde, 0x1b, 0x84, 0x04, 0xff,
+	0x82, 0xdc, 0x70, 0x3c, 0x60, 0x38, 0x26, 0xee, 0xe1, 0x7a, 0x1f, 0xb1, 0x70, 0xdd, 0x65, 0x2f,
+	0xf6, 0x11, 0xed, 0xed, 0x27, 0x31, 0x8b, 0xed, 0x95, 0x74, 0x59, 0x4f, 0x2d, 0xeb, 0xa9, 0x65,
+	0xab, 0x37, 0x86, 0xf1, 0x30, 0x16, 0xab, 0x5c, 0xfe, 0x4b, 0x1a, 0xac, 0x36, 0x87, 0x71, 0x3c,
+	0xdc, 0x43, 0xae, 0x18, 0xf5, 0xc7, 0xbb, 0x2e, 0x1c, 0x27, 0xa1, 0xb0, 0x93, 0xf3, 0xad, 0xe2,
+	0x3c, 0xc3, 0x11, 0xa2, 0x2c, 0x8c, 0xf6, 0xb5, 0x83, 0x41, 0x4c, 0xa3, 0x98, 0xba, 0xfd, 0x90,
+	0xa2, 0x54, 0xd2, 0x20, 0xc6, 0xca, 0x41, 0xe7, 0xed, 0x1c, 0xa8, 0x3c, 0x0a, 0x93, 0x30, 0xa2,
+	0xf6, 0xaf, 0x16, 0x58, 0x1e, 0xc4, 0x51, 0x84, 0x19, 0x0d, 0x34, 0x8d, 0x63, 0xb5, 0xad, 0x6e,
+	0xed, 0xcb, 0x95, 0x9e, 0xe4, 0xe9, 0x69, 0x9e, 0xde, 0xb6, 0x5a, 0xe0, 0x7d, 0xfd, 0xfa, 0xb8,
+	0x35, 0x73, 0x76, 0xdc, 0x72, 0x7f, 0xa6, 0x31, 0xd9, 0xec, 0x14, 0x1d, 0x74, 0xda, 0x2f, 0xc2,
+	0x68, 0xef, 0x1c, 0xfc, 0xd5, 0xbb, 0x96, 0xe5, 0x2f, 0x29, 0x58, 0x7b, 0x13, 0x1a, 0x12, 0x74,
+	0x88, 0xc2, 0x3d, 0x43, 0xc3, 0xec, 0x94, 0x1a, 0x8a, 0x0e, 0xb4, 0x86, 0x09, 0x5c, 0x6a, 0x50,
+	0x70, 0xaa, 0x01, 0x01, 0x20, 0x65, 0x05, 0xbb, 0x08, 0x39, 0x25, 0x45, 0x2e, 0xf7, 0xb1, 0xc7,
+	0xf7, 0x51, 0x9f, 0x59, 0xef, 0x9b, 0x18, 0x13, 0xef, 0xae, 0x22, 0xbf, 0x65, 0x6e, 0x00, 0x37,
+	0xcd, 0x87, 0x2e, 0x10, 0xbf, 0x2a, 0x07, 0x3b, 0x08, 0x71, 0x1a, 0xc9, 0x2c, 0x68, 0xca, 0x53,
+	0xd2, 0x64, 0xa6, 0xf9, 0xe8, 0x14, 0x8d, 0x1c, 0x70, 0x1a, 0x0c, 0x6a, 0x11, 0x26, 0x38, 0x1a,
+	0x47, 0x41, 0x1f, 0x43, 0x67, 0xee, 0x2a, 0x9e, 0x7b, 0x8a, 0x67, 0x4d, 0xf2, 0x18, 0xb6, 0x9a,
+	0xc8, 0x84, 0x7c, 0xa0, 0x46, 0x1e, 0x86, 0xfc, 0xf0, 0x56, 0x76, 0xe3, 0x64, 0x17, 0x61, 0x86,
+	0x20, 0x17, 0x12, 0x40, 0x44, 0x19, 0x26, 0xf2, 0x14, 0x2b, 0x6d, 0xab, 0x5b, 0xf5, 0xee, 0x9f,
+	0x1d, 0xb7, 0xb6, 0xa4, 0xeb, 0x0b, 0x97, 0x6a, 0xa2, 0x8b, 0x17, 0xf8, 0x37, 0xd3, 0xb9, 0x1d,
+	0x84, 0xb6, 0xb3, 0x99, 0xcd, 0xf2, 0xab, 0xbf, 0x5a, 0x33, 0x9d, 0xff, 0x16, 0xc1, 0xfc, 0x96,
+	0x2c, 0x30, 0x7b, 0x11, 0xcc, 0x62, 0x28, 0xf2, 0xb8, 0xea, 0xcf, 0x62, 0x68, 0x7f, 0x0a, 0x2a,
+	0x94, 0x85, 0x6c, 0x4c, 0x45, 0x5e, 0x55, 0x7d, 0x35, 0xb2, 0x6f, 0x81, 0x46, 0x7c, 0x44, 0x50,
+	0x12, 0x84, 0x10, 0x26, 0x88, 0x52, 0x71, 0xf2, 0x55, 0xbf, 0x2e, 0xc0, 0x2d, 0x89, 0xd9, 0x04,
+	0xd4, 0x06, 0x09, 0x0a, 0x19, 0x0a, 0x78, 0xa1, 0xa9, 0x53, 0x5b, 0x9d, 0xc8, 0xcc, 0x27, 0xba,
+	0x0a, 0xbd, 0xf5, 0xfc, 0x76, 0x1a, 0xc6, 0x69, 0x7a, 0x18, 0xd0, 0x4b, 0x9e, 0x90, 0x40, 0x22,
+	0xdc, 0x47, 0xae, 0x26, 0x11, 0x81, 0x92, 0x75, 0xee, 0x4a, 0xd6, 0x0b, 0x8a, 0x52, 0x7b, 0x28,
+	0x16, 0x65, 0x8a, 0x0b, 0xfe, 0x45, 0x05, 0xdf, 0x27, 0x30, 0xd5, 0xa0, 0x4b, 0x27, 0xd5, 0x50,
+	0x99, 0x56, 0x43, 0xd1, 0x43, 0xb1, 0x28, 0x0b, 0x1a, 0x14, 0xac, 0x35, 0xe4, 0x6b, 0x72, 0xfe,
+	0x7a, 0x6a, 0x72, 0xe1, 0x9a, 0x6a, 0xb2, 0xfa, 0x01, 0x6b, 0x72, 0x0d, 0x2c, 0x1e, 0x61, 0x62,
+	0xa6, 0x35, 0x10, 0x69, 0xdd, 0x90, 0xa8, 0xce, 0x6b, 0x0c, 0x6a, 0x1c, 0xc0, 0x64, 0x28, 0x14,
+	0xd5, 0xa6, 0x54, 0x64, 0xd8, 0x6a, 0x45, 0x26, 0xe4, 0x03, 0x35, 0xe2, 0x8a, 0x0e, 0x40, 0x43,
+	0xcf, 0xed, 0x27, 0x78, 0x80, 0x9c, 0xfa, 0x55, 0x64, 0xba, 0x86, 0xee, 0xe4, 0xc9, 0x84, 0x75,
+	0x91, 0x4e, 0x82, 0x7e, 0x5d, 0x8d, 0x1f, 0xf1, 0xa1, 0x6d, 0x83, 0xf2, 0x33, 0x4c, 0xa0, 0xd3,
+	0x10, 0xa1, 0x8b, 0xdf, 0x3c, 0x62, 0xca, 0xc2, 0x84, 0x29, 0x11, 0x8b, 0x53, 0x46, 0x6c, 0xd8,
+	0x6a, 0x09, 0x26, 0xe4, 0x03, 0x31, 0x92, 0xf4, 0x07, 0xa0, 0xd1, 0xc7, 0x30, 0xc0, 0x64, 0x90,
+	0xa0, 0x08, 0x11, 0xe6, 0x2c, 0x4d, 0x19, 0x71, 0xce, 0x5a, 0xd3, 0xe5, 0x41, 0xbf, 0xde, 0xc7,
+	0xf0, 0x81, 0x1e, 0x8a, 0x9a, 0x45, 0xcf, 0x19, 0x22, 0x14, 0xc7, 0x24, 0x38, 0xc2, 0x04, 0xc6,
+	0x47, 0xce, 0xf2, 0x94, 0x7d, 0xb4, 0xe8, 0x40, 0x33, 0x4f, 0xe0, 0xb2, 0x8f, 0xa6, 0xf0, 0x53,
+	0x81, 0xda, 0xdf, 0x81, 0x1a, 0x19, 0x47, 0x81, 0x4c, 0x34, 0xea, 0x7c, 0xd4, 0xb6, 0xba, 0x65,
+	0xef, 0x4e, 0xb6, 0x85, 0xc6, 0xa4, 0xf6, 0x6c, 0x42, 0x3e, 0x20, 0xe3, 0xe8, 0xa9, 0x1c, 0xd8,
+	0x3f, 0x81, 0xe5, 0x7c, 0x1a, 0x23, 0xea, 0xd8, 0xed, 0x52, 0xb7, 0xea, 0x6d, 0x64, 0x7a, 0x8b,
+	0x2b, 0xcc, 0xdc, 0xc8, 0xe1, 0xfe, 0x52, 0x2e, 0xfb, 0x11, 0xb5, 0x23, 0x50, 0x37, 0x12, 0x96,
+	0x3a, 0x1f, 0xb7, 0x4b, 0x97, 0x9f, 0x90, 0xab, 0xb6, 0xea, 0xf6, 0x44, 0x01, 0xd0, 0x73, 0x2a,
+	0x80, 0x76, 0xfc, 0x5a, 0x56, 0x02, 0xd4, 0x46, 0xc0, 0x4e, 0x10, 0x45, 0xc9, 0x21, 0x92, 0xf9,
+	0x12, 0x8c, 0x42, 0x3a, 0x72, 0x6e, 0x88, 0x0e, 0xf9, 0xd5, 0xd9, 0x71, 0x6b, 0x43, 0x5f, 0x28,
+	0xc5, 0x35, 0xd9, 0xc5, 0x32, 0x31, 0xe3, 0x2f, 0x2b, 0x50, 0x24, 0xdd, 0xb7, 0x21, 0x1d, 0xf1,
+	0xc4, 0xcb, 0x2d, 0x74, 0x3e, 0x99, 0x32, 0xf1, 0x72, 0xd6, 0xe7, 0x72, 0x77, 0xfc, 0xba, 0x49,
+	0xbb, 0x59, 0xfe, 0x8d, 0xf7, 0xdf, 0x1f, 0xc0, 0x82, 0x6a, 0xbf, 0xd4, 0xde, 0x06, 0x0b, 0xea,
+	0xad, 0x4b, 0x1d, 0x4b, 0x6c, 0x6b, 0xa7, 0x77, 0xe1, 0x33, 0xb8, 0xa7, 0xcc, 0xbc, 0x32, 0x17,
+	0xe2, 0xa7, 0x96, 0xca, 0xef, 0x9f, 0x15, 0x50, 0xe2, 0x77, 0xc8, 0x67, 0x00, 0xa8, 0x99, 0x20,
+	0xed, 0xed, 0x55, 0x85, 0x3c, 0x10, 0x97, 0x5e, 0x1f, 0x43, 0x68, 0x5c, 0x7a, 0xb2, 0xd5, 0x37,
+	0x24, 0xaa, 0x2f, 0xbd, 0xec, 0x25, 0x50, 0xca, 0xbd, 0x04, 0x5a, 0xa0, 0xa6, 0xfa, 0x83, 0x38,
+	0x96, 0xb2, 0x98, 0x54, 0xfd, 0x47, 0xec, 0x2b, 0x49, 0x17, 0xbc, 0x67, 0x3f, 0x2e, 0xbe, 0x02,
+	0x32, 0xe3, 0x42, 0x43, 0x32, 0x5f, 0x01, 0x02, 0x39, 0xa7, 0xfb, 0x55, 0x3e, 0x54, 0xf7, 0x23,
+	0xa0, 0xa6, 0x1a, 0x96, 0x08, 0x6b, 0x7e, 0xda, 0xb0, 0x0c, 0xe3, 0x42, 0x03, 0x34, 0xc2, 0x92,
+	0x88, 0x0e, 0xeb, 0x3a, 0xba, 0x2d, 0x02, 0x80, 0xdf, 0x95, 0x61, 0x14, 0x8f, 0x09, 0x73, 0xaa,
+	0x53, 0xd2, 0x64, 0xa6, 0xe6, 0xc5, 0xab, 0x10, 0xbf, 0xda, 0xc7, 0x70, 0x4b, 0xfc, 0xb6, 0x0f,
+	0x00, 0xa0, 0x88, 0xb1, 0x3d, 0x79, 0xc5, 0x03, 0x41, 0xd3, 0xbd, 0x24, 0xd3, 0x3d, 0x0c, 0x1f,
+	0xa7, 0xeb, 0xbd, 0xdb, 0x19, 0x63, 0xe6, 0x45, 0x33, 0x1a, 0x88, 0x6f, 0x90, 0xa8, 0xa2, 0x78,
+	0x5b, 0x02, 0x8d, 0x9c, 0x33, 0xfb, 0x77, 0x0b, 0x2c, 0x24, 0x68, 0x77, 0x4c, 0x20, 0x82, 0xaa,
+	0xe6, 0x2e, 0x09, 0xf8, 0xb1, 0x0a, 0xb8, 0xa5, 0xf7, 0x55, 0x1a, 0x66, 0xbb, 0xaa, 0xc6, 0x7f,
+	0xbf, 0x6b, 0x75, 0x87, 0x98, 0x8d, 0xc6, 0xfd, 0xde, 0x20, 0x8e, 0x5c, 0xf5, 0x61, 0x29, 0xff,
+	0xdc, 0xa3, 0xf0, 0x99, 0xfa, 0xd2, 0xe5, 0x3e, 0xa9, 0x9f, 0xf2, 0xdb, 0x7f, 0x58, 0xa0, 0x9a,
+	0xbe, 0xd6, 0x9d, 0xd9, 0xab, 0xd4, 0x7c, 0xaf, 0xd4, 0x7c, 0x5e, 0xf8, 0x48, 0x98, 0xf8, 0x28,
+	0x98, 0x4e, 0x4f, 0x26, 0xc1, 0x7e, 0x08, 0xea, 0xe9, 0x20, 0x60, 0xb1, 0x2c, 0x7e, 0xef, 0x6e,
+	0x76, 0x99, 0x9b, 0xb3, 0x13, 0xb4, 0x1c, 0xf3, 0x6b, 0xe9, 0xf0, 0x49, 0x6c, 0x3f, 0x04, 0xe5,
+	0xfd, 0x10, 0xc3, 0xab, 0x3f, 0xe1, 0x5a, 0x2a, 0xb4, 0x9b, 0x92, 0x86, 0x1b, 0x69, 0xf7, 0xe2,
+	0xb7, 0x2f, 0xfc, 0xc8, 0x53, 0xf5, 0x76, 0x5e, 0x9f, 0x34, 0xad, 0x37, 0x27, 0x4d, 0xeb, 0xdf,
+	0x93, 0xa6, 0xf5, 0xf2, 0xb4, 0x39, 0xf3, 0xe6, 0xb4, 0x39, 0xf3, 0xcf, 0x69, 0x73, 0xe6, 0xc7,
+	0x2f, 0x8c, 0xa0, 0xd9, 0x28, 0x4c, 0x28, 0xa6, 0x2e, 0x62, 0x23, 0x94, 0x44, 0x98, 0x30, 0xf7,
+	0x79, 0xfa, 0x0f, 0x08, 0x11, 0x7e, 0xbf, 0x22, 0xea, 0x76, 0xe3, 0xff, 0x01, 0x00, 0xdb, 0x6e,
+	0xb0, 0x98, 0xa2, 0x10, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -338,6 +442,13 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ForfeitedFeeDestination) > 0 {
+		i -= len(m.ForfeitedFeeDestination)
+		copy(dAtA[i:], m.ForfeitedFeeDestination)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ForfeitedFeeDestination)))
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		size, err := m.MinimumBid.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -407,6 +518,98 @@ func (m *Auction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ReservePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if len(m.ReservePriceHash) > 0 {
+		i -= len(m.ReservePriceHash)
+		copy(dAtA[i:], m.ReservePriceHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ReservePriceHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.WinningBids) > 0 {
+		for iNdEx := len(m.WinningBids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WinningBids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.WinnerAddresses) > 0 {
+		for iNdEx := len(m.WinnerAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WinnerAddresses[iNdEx])
+			copy(dAtA[i:], m.WinnerAddresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.WinnerAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.NumWinners != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NumWinners))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExtensionWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExtensionWindow):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size, err := m.BidIncrement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.StartPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	{
 		size, err := m.WinningPrice.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -464,28 +667,28 @@ func (m *Auction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x3a
-	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RevealsEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RevealsEndTime):])
-	if err11 != nil {
-		return 0, err11
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RevealsEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RevealsEndTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n11
-	i = encodeVarintTypes(dAtA, i, uint64(n11))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x32
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CommitsEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CommitsEndTime):])
-	if err12 != nil {
-		return 0, err12
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CommitsEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CommitsEndTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n16
+	i = encodeVarintTypes(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x2a
-	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime):])
-	if err13 != nil {
-		return 0, err13
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n17
+	i = encodeVarintTypes(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x22
 	if len(m.OwnerAddress) > 0 {
@@ -569,6 +772,18 @@ func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Settlement != nil {
+		{
+			size, err := m.Settlement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	{
 		size, err := m.BidAmount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -589,12 +804,12 @@ func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x42
-	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RevealTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RevealTime):])
-	if err16 != nil {
-		return 0, err16
+	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RevealTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RevealTime):])
+	if err21 != nil {
+		return 0, err21
 	}
-	i -= n16
-	i = encodeVarintTypes(dAtA, i, uint64(n16))
+	i -= n21
+	i = encodeVarintTypes(dAtA, i, uint64(n21))
 	i--
 	dAtA[i] = 0x3a
 	{
@@ -607,12 +822,12 @@ func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x32
-	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CommitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CommitTime):])
-	if err18 != nil {
-		return 0, err18
+	n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CommitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CommitTime):])
+	if err23 != nil {
+		return 0, err23
 	}
-	i -= n18
-	i = encodeVarintTypes(dAtA, i, uint64(n18))
+	i -= n23
+	i = encodeVarintTypes(dAtA, i, uint64(n23))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.CommitHash) > 0 {
@@ -646,6 +861,74 @@ func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BidSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Paid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ForfeitedTo) > 0 {
+		i -= len(m.ForfeitedTo)
+		copy(dAtA[i:], m.ForfeitedTo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ForfeitedTo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Forfeited) > 0 {
+		for iNdEx := len(m.Forfeited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Forfeited[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -673,6 +956,10 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = m.MinimumBid.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.ForfeitedFeeDestination)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -714,6 +1001,37 @@ func (m *Auction) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = m.WinningPrice.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.StartPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.BidIncrement.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExtensionWindow)
+	n += 2 + l + sovTypes(uint64(l))
+	if m.NumWinners != 0 {
+		n += 2 + sovTypes(uint64(m.NumWinners))
+	}
+	if len(m.WinnerAddresses) > 0 {
+		for _, s := range m.WinnerAddresses {
+			l = len(s)
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.WinningBids) > 0 {
+		for _, e := range m.WinningBids {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.ReservePriceHash)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	l = m.ReservePrice.Size()
+	n += 2 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -764,6 +1082,37 @@ func (m *Bid) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = m.BidAmount.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	if m.Settlement != nil {
+		l = m.Settlement.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *BidSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Forfeited) > 0 {
+		for _, e := range m.Forfeited {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.ForfeitedTo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Paid.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -967,6 +1316,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedFeeDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForfeitedFeeDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1409,31 +1790,312 @@ func (m *Auction) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Auctions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidIncrement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BidIncrement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExtensionWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumWinners", wireType)
+			}
+			m.NumWinners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumWinners |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinnerAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WinnerAddresses = append(m.WinnerAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningBids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WinningBids = append(m.WinningBids, types.Coin{})
+			if err := m.WinningBids[len(m.WinningBids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePriceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservePriceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Auctions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -1836,6 +2498,225 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Settlement == nil {
+				m.Settlement = &BidSettlement{}
+			}
+			if err := m.Settlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidSettlement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidSettlement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunded = append(m.Refunded, types.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forfeited", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Forfeited = append(m.Forfeited, types.Coin{})
+			if err := m.Forfeited[len(m.Forfeited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForfeitedTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -31,6 +31,8 @@ type Keeper struct {
 	// Track bond usage in other cosmos-sdk modules (more like a usage tracker).
 	usageKeepers []types.BondUsageKeeper
 
+	hooks types.BondHooks
+
 	storeKey storetypes.StoreKey
 
 	cdc codec.BinaryCodec
@@ -55,6 +57,17 @@ func NewKeeper(cdc codec.BinaryCodec, accountKeeper auth.AccountKeeper, bankKeep
 	}
 }
 
+// SetHooks sets the hooks notified of the bond changes.
+// It should be called only once during initialization, it panics if called more than once.
+func (k *Keeper) SetHooks(hooks types.BondHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set bond hooks twice")
+	}
+
+	k.hooks = hooks
+	return k
+}
+
 // Generates Bond ID -> Bond index key.
 func getBondIndexKey(id string) []byte {
 	return append(prefixIDToBondIndex, []byte(id)...)
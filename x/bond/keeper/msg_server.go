@@ -51,11 +51,17 @@ func (k msgServer) RefillBond(c context.Context, msg *types.MsgRefillBond) (*typ
 		return nil, err
 	}
 
-	_, err = k.Keeper.RefillBond(ctx, msg.Id, signerAddress, msg.Coins)
+	bond, err := k.Keeper.RefillBond(ctx, msg.Id, signerAddress, msg.Coins)
 	if err != nil {
 		return nil, err
 	}
 
+	if k.hooks != nil {
+		if err := k.hooks.AfterBondRefilled(ctx, signerAddress, *bond, msg.Coins); err != nil {
+			return nil, err
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeRefillBond,
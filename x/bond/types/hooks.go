@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BondHooks are notified of the bond changes made by the module messages.
+type BondHooks interface {
+	AfterBondRefilled(ctx sdk.Context, signer sdk.AccAddress, bond Bond, coins sdk.Coins) error
+}
@@ -60,7 +60,8 @@ func NewRawTxCmd() *cobra.Command {
 				return err
 			}
 
-			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), rsp.Params.EvmDenom)
+			// the fees are paid by the --fee-granter account if set
+			tx, err := msg.BuildTxWithFeePayer(clientCtx.TxConfig.NewTxBuilder(), rsp.Params.EvmDenom, clientCtx.FeeGranter)
 			if err != nil {
 				return err
 			}
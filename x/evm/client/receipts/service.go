@@ -0,0 +1,65 @@
+// Package receipts implements the evm node query service, which serves the receipts of the ethereum
+// transactions from the node blocks, like the Tendermint service of the SDK.
+package receipts
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rpctypes "github.com/tharsis/ethermint/rpc/ethereum/types"
+	ethermint "github.com/tharsis/ethermint/types"
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+var _ types.ServiceServer = server{}
+
+// server implements the evm node query service with the node client of the client context.
+type server struct {
+	clientCtx client.Context
+}
+
+// RegisterService registers the evm node query service on the gRPC router.
+func RegisterService(clientCtx client.Context, router gogogrpc.Server) {
+	types.RegisterServiceServer(router, server{clientCtx: clientCtx})
+}
+
+// BlockReceipts implements the Service/BlockReceipts gRPC method
+func (s server) BlockReceipts(ctx context.Context, req *types.QueryBlockReceiptsRequest) (*types.QueryBlockReceiptsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d", req.Height)
+	}
+
+	var height *int64
+	if req.Height > 0 {
+		height = &req.Height
+	}
+
+	resBlock, err := s.clientCtx.Client.Block(ctx, height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	blockRes, err := s.clientCtx.Client.BlockResults(ctx, &resBlock.Block.Height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	chainID, err := ethermint.ParseChainID(resBlock.Block.ChainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	receipts, err := rpctypes.BlockReceipts(s.clientCtx, resBlock, blockRes, chainID, rpctypes.BaseFeeFromEvents(blockRes.BeginBlockEvents))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBlockReceiptsResponse{Receipts: receipts}, nil
+}
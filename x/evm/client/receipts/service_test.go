@@ -0,0 +1,74 @@
+package receipts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/tharsis/ethermint/encoding"
+	"github.com/tharsis/ethermint/x/evm"
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// mockClient is a Tendermint client serving a single block.
+type mockClient struct {
+	tmrpcclient.Client
+
+	block    *tmrpctypes.ResultBlock
+	blockRes *tmrpctypes.ResultBlockResults
+}
+
+func (c mockClient) Block(_ context.Context, _ *int64) (*tmrpctypes.ResultBlock, error) {
+	return c.block, nil
+}
+
+func (c mockClient) BlockResults(_ context.Context, _ *int64) (*tmrpctypes.ResultBlockResults, error) {
+	return c.blockRes, nil
+}
+
+func TestBlockReceipts(t *testing.T) {
+	encodingConfig := encoding.MakeConfig(module.NewBasicManager(evm.AppModuleBasic{}))
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	// a block with a failed cosmos tx
+	resBlock := &tmrpctypes.ResultBlock{Block: &tmtypes.Block{
+		Header: tmtypes.Header{ChainID: "ethermint_9000-1", Height: 10},
+		Data:   tmtypes.Data{Txs: []tmtypes.Tx{[]byte("tx")}},
+	}}
+	blockRes := &tmrpctypes.ResultBlockResults{
+		Height:     10,
+		TxsResults: []*abci.ResponseDeliverTx{{Code: 11, GasUsed: 1000}},
+	}
+	s := server{clientCtx: clientCtx.WithClient(mockClient{block: resBlock, blockRes: blockRes})}
+
+	res, err := s.BlockReceipts(context.Background(), &types.QueryBlockReceiptsRequest{Height: 10})
+	require.NoError(t, err)
+	require.Empty(t, res.Receipts)
+
+	// latest block
+	res, err = s.BlockReceipts(context.Background(), &types.QueryBlockReceiptsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Receipts)
+
+	_, err = s.BlockReceipts(context.Background(), &types.QueryBlockReceiptsRequest{Height: -1})
+	require.Error(t, err)
+
+	_, err = s.BlockReceipts(context.Background(), nil)
+	require.Error(t, err)
+
+	// the block results are missing a tx result
+	blockRes.TxsResults = nil
+	_, err = s.BlockReceipts(context.Background(), &types.QueryBlockReceiptsRequest{Height: 10})
+	require.Error(t, err)
+
+	resBlock.Block.ChainID = "invalid"
+	_, err = s.BlockReceipts(context.Background(), &types.QueryBlockReceiptsRequest{Height: 10})
+	require.Error(t, err)
+}
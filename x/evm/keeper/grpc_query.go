@@ -15,7 +15,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -26,6 +29,7 @@ import (
 
 	ethermint "github.com/tharsis/ethermint/types"
 	"github.com/tharsis/ethermint/x/evm/statedb"
+	evmtracers "github.com/tharsis/ethermint/x/evm/tracers"
 	"github.com/tharsis/ethermint/x/evm/types"
 )
 
@@ -33,6 +37,8 @@ var _ types.QueryServer = Keeper{}
 
 const (
 	defaultTraceTimeout = 5 * time.Second
+	maxAccountRange     = 256
+	maxStorageRange     = 256
 )
 
 // Account implements the Query/Account gRPC method
@@ -222,13 +228,13 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	cfg, err := k.EVMConfig(ctx)
+	ctx, cfg, err := k.callConfig(ctx, req)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.callNonce(ctx, cfg, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
@@ -293,13 +299,13 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	}
 	cap = hi
 
-	cfg, err := k.EVMConfig(ctx)
+	ctx, cfg, err := k.callConfig(ctx, req)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to load evm config")
+		return nil, err
 	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.callNonce(ctx, cfg, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
@@ -350,6 +356,43 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// callConfig returns the context and the EVM config of a call, with the state and block overrides of the request.
+func (k Keeper) callConfig(ctx sdk.Context, req *types.EthCallRequest) (sdk.Context, *types.EVMConfig, error) {
+	cfg, err := k.EVMConfig(ctx)
+	if err != nil {
+		return ctx, nil, status.Error(codes.Internal, "failed to load evm config")
+	}
+
+	if len(req.Overrides) > 0 {
+		if err := json.Unmarshal(req.Overrides, &cfg.StateOverrides); err != nil {
+			return ctx, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := cfg.StateOverrides.Validate(); err != nil {
+			return ctx, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if len(req.BlockOverrides) > 0 {
+		var blockOverrides types.BlockOverrides
+		if err := json.Unmarshal(req.BlockOverrides, &blockOverrides); err != nil {
+			return ctx, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if ctx, err = blockOverrides.Apply(ctx, cfg); err != nil {
+			return ctx, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	return ctx, cfg, nil
+}
+
+// callNonce returns the nonce of the sender of a call, including the state overrides.
+func (k Keeper) callNonce(ctx sdk.Context, cfg *types.EVMConfig, from common.Address) uint64 {
+	if account, ok := cfg.StateOverrides[from]; ok && account.Nonce != nil {
+		return uint64(*account.Nonce)
+	}
+	return k.GetNonce(ctx, from)
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -373,21 +416,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	}
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
 
-	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
-	for i, tx := range req.Predecessors {
-		ethTx := tx.AsTransaction()
-		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
-		if err != nil {
-			continue
-		}
-		txConfig.TxHash = ethTx.Hash()
-		txConfig.TxIndex = uint(i)
-		rsp, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
-		if err != nil {
-			continue
-		}
-		txConfig.LogIndex += uint(len(rsp.Logs))
-	}
+	txConfig := k.applyPredecessors(ctx, cfg, signer, req.Predecessors)
 
 	tx := req.Msg.AsTransaction()
 	txConfig.TxHash = tx.Hash()
@@ -408,6 +437,59 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call in the provided environment, with the state and block overrides
+// of the call. The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil || req.Call == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockHeight(req.BlockNumber)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Call.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx, cfg, err := k.callConfig(ctx, req.Call)
+	if err != nil {
+		return nil, err
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.callNonce(ctx, cfg, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.Call.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, msg, req.TraceConfig, false)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // TraceBlock configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment for all the transactions in the queried block.
 // The return value will be tracer dependent.
@@ -434,19 +516,23 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	results := make([]*types.TxTraceResult, 0, txsLength)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
-	for i, tx := range req.Txs {
+	roots, err := replayWithRoots(ctx, req.Txs, func(ctx sdk.Context, i int, ethTx *ethtypes.Transaction) {
 		result := types.TxTraceResult{}
-		ethTx := tx.AsTransaction()
 		txConfig.TxHash = ethTx.Hash()
 		txConfig.TxIndex = uint(i)
 		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true)
 		if err != nil {
+			// the result is kept, so that the results match the transactions
 			result.Error = err.Error()
-			continue
+			results = append(results, &result)
+			return
 		}
 		txConfig.LogIndex = logIndex
 		result.Result = traceResult
 		results = append(results, &result)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	resultData, err := json.Marshal(results)
@@ -455,10 +541,205 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	}
 
 	return &types.QueryTraceBlockResponse{
-		Data: resultData,
+		Data:              resultData,
+		IntermediateRoots: roots,
 	}, nil
 }
 
+// IntermediateRoots executes the transactions of the queried block and returns the intermediate root after
+// each transaction, see replayWithRoots.
+func (k Keeper) IntermediateRoots(c context.Context, req *types.QueryTraceBlockRequest) (*types.QueryIntermediateRootsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockHeight(req.BlockNumber)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+
+	cfg, err := k.EVMConfig(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load evm config")
+	}
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+	roots, err := replayWithRoots(ctx, req.Txs, func(ctx sdk.Context, i int, ethTx *ethtypes.Transaction) {
+		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		if err != nil {
+			return
+		}
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = uint(i)
+		rsp, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
+		if err != nil {
+			return
+		}
+		txConfig.LogIndex += uint(len(rsp.Logs))
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIntermediateRootsResponse{
+		Roots: roots,
+	}, nil
+}
+
+// StorageRange returns the storage of a contract from the given key, in the state after the execution of the
+// predecessor transactions of the queried block. At most maxStorageRange entries are returned.
+func (k Keeper) StorageRange(c context.Context, req *types.QueryStorageRangeRequest) (*types.QueryStorageRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ethermint.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockHeight(req.BlockNumber)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+
+	cfg, err := k.EVMConfig(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+
+	k.applyPredecessors(ctx, cfg, signer, req.Predecessors)
+
+	maxResult := req.MaxResult
+	if maxResult == 0 || maxResult > maxStorageRange {
+		maxResult = maxStorageRange
+	}
+
+	storage, nextKey := k.storageRange(ctx, common.HexToAddress(req.Address), common.HexToHash(req.KeyStart), maxResult)
+
+	return &types.QueryStorageRangeResponse{
+		Storage: storage,
+		NextKey: nextKey,
+	}, nil
+}
+
+// storageRange returns at most maxResult storage entries of a contract from the given key, and the hex key
+// of the next entry, empty at the end of the storage.
+func (k Keeper) storageRange(
+	ctx sdk.Context, address common.Address, start common.Hash, maxResult uint64,
+) (storage []types.State, nextKey string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(address))
+	iterator := store.Iterator(start.Bytes(), nil)
+	defer iterator.Close()
+
+	storage = []types.State{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := common.BytesToHash(iterator.Key())
+		if uint64(len(storage)) == maxResult {
+			return storage, key.Hex()
+		}
+		storage = append(storage, types.NewState(key, common.BytesToHash(iterator.Value())))
+	}
+
+	return storage, ""
+}
+
+// AccountRange returns the EVM accounts from the given address, in the address order of the auth store.
+// At most maxAccountRange accounts are returned, with at most maxStorageRange storage entries each.
+func (k Keeper) AccountRange(c context.Context, req *types.QueryAccountRangeRequest) (*types.QueryAccountRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	maxResults := req.MaxResults
+	if maxResults == 0 || maxResults > maxAccountRange {
+		maxResults = maxAccountRange
+	}
+
+	rsp := &types.QueryAccountRangeResponse{
+		Accounts: []types.RangeAccount{},
+	}
+
+	// the auth store is iterated from the start address, by pages of the accounts left to return
+	pageKey := common.HexToAddress(req.Start).Bytes()
+	for pageKey != nil {
+		res, err := k.accountKeeper.Accounts(sdk.WrapSDKContext(ctx), &authtypes.QueryAccountsRequest{
+			Pagination: &query.PageRequest{Key: pageKey, Limit: maxResults - uint64(len(rsp.Accounts)) + 1},
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		pageKey = res.Pagination.NextKey
+
+		for _, accAny := range res.Accounts {
+			acc, ok := accAny.GetCachedValue().(authtypes.AccountI)
+			if !ok || len(acc.GetAddress()) != common.AddressLength {
+				continue
+			}
+
+			address := common.BytesToAddress(acc.GetAddress())
+			if uint64(len(rsp.Accounts)) == maxResults {
+				rsp.Next = address.Hex()
+				return rsp, nil
+			}
+
+			account := k.GetAccount(ctx, address)
+			if account == nil {
+				continue
+			}
+
+			rangeAccount := types.RangeAccount{
+				Address:  address.Hex(),
+				Balance:  account.Balance.String(),
+				Nonce:    account.Nonce,
+				CodeHash: common.BytesToHash(account.CodeHash).Hex(),
+			}
+			if !req.NoCode && account.IsContract() {
+				rangeAccount.Code = k.GetCode(ctx, common.BytesToHash(account.CodeHash))
+			}
+			if !req.NoStorage {
+				rangeAccount.Storage, rangeAccount.StorageNextKey = k.storageRange(ctx, address, common.Hash{}, maxStorageRange)
+			}
+
+			rsp.Accounts = append(rsp.Accounts, rangeAccount)
+		}
+	}
+
+	return rsp, nil
+}
+
+// applyPredecessors executes the predecessor transactions of a traced transaction and returns the
+// transaction config of the next one.
+func (k *Keeper) applyPredecessors(
+	ctx sdk.Context,
+	cfg *types.EVMConfig,
+	signer ethtypes.Signer,
+	predecessors []*types.MsgEthereumTx,
+) statedb.TxConfig {
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+	for i, tx := range predecessors {
+		ethTx := tx.AsTransaction()
+		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		if err != nil {
+			continue
+		}
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = uint(i)
+		rsp, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
+		if err != nil {
+			continue
+		}
+		txConfig.LogIndex += uint(len(rsp.Logs))
+	}
+	return txConfig
+}
+
 // traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
 func (k *Keeper) traceTx(
 	ctx sdk.Context,
@@ -469,18 +750,30 @@ func (k *Keeper) traceTx(
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 ) (*interface{}, uint, error) {
-	// Assemble the structured logger or the JavaScript tracer
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return k.traceMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage)
+}
+
+// traceMsg do trace on one message, it returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *types.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg core.Message,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+) (*interface{}, uint, error) {
+	// Assemble the structured logger, the native tracer or the JavaScript tracer
 	var (
 		tracer    vm.EVMLogger
 		overrides *ethparams.ChainConfig
 		err       error
 	)
 
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
-
 	if traceConfig != nil && traceConfig.Overrides != nil {
 		overrides = traceConfig.Overrides.EthereumConfig(cfg.ChainConfig.ChainID)
 	}
@@ -503,8 +796,9 @@ func (k *Keeper) traceTx(
 			TxHash:    txConfig.TxHash,
 		}
 
-		// Construct the JavaScript tracer to execute with
-		if tracer, err = tracers.New(traceConfig.Tracer, tCtx); err != nil {
+		// Construct the native or JavaScript tracer to execute with
+		tracerConfig := json.RawMessage(traceConfig.TracerJsonConfig)
+		if tracer, err = evmtracers.New(traceConfig.Tracer, tCtx, tracerConfig); err != nil {
 			return nil, 0, status.Error(codes.Internal, err.Error())
 		}
 
@@ -1,22 +1,30 @@
 package keeper_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/big"
+	"sort"
+	"strings"
 
 	"cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/tharsis/ethermint/x/evm/statedb"
 
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2alpha1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
 	"github.com/tharsis/ethermint/server/config"
 	ethermint "github.com/tharsis/ethermint/types"
+	evmtracers "github.com/tharsis/ethermint/x/evm/tracers"
 	"github.com/tharsis/ethermint/x/evm/types"
 )
 
@@ -908,3 +916,495 @@ func (suite *KeeperTestSuite) TestNonceInQuery() {
 	})
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestEthCallOverrides() {
+	suite.SetupTest()
+	supply := big.NewInt(1000)
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, supply)
+
+	balanceOf := func(overrides types.StateOverride, blockOverrides *types.BlockOverrides) (*big.Int, error) {
+		data, err := types.ERC20Contract.ABI.Pack("balanceOf", suite.address)
+		suite.Require().NoError(err)
+		args, err := json.Marshal(&types.TransactionArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&data)})
+		suite.Require().NoError(err)
+
+		req := &types.EthCallRequest{Args: args, GasCap: uint64(config.DefaultGasCap)}
+		if overrides != nil {
+			req.Overrides, err = json.Marshal(overrides)
+			suite.Require().NoError(err)
+		}
+		if blockOverrides != nil {
+			req.BlockOverrides, err = json.Marshal(blockOverrides)
+			suite.Require().NoError(err)
+		}
+
+		rsp, err := suite.queryClient.EthCall(sdk.WrapSDKContext(suite.ctx), req)
+		if err != nil {
+			return nil, err
+		}
+		suite.Require().Empty(rsp.VmError)
+		return new(big.Int).SetBytes(rsp.Ret), nil
+	}
+
+	// the balances mapping is the first slot of the contract
+	balanceSlot := crypto.Keccak256Hash(common.LeftPadBytes(suite.address.Bytes(), 32), common.LeftPadBytes(nil, 32))
+	diff := map[common.Hash]common.Hash{balanceSlot: common.BigToHash(big.NewInt(42))}
+	empty := map[common.Hash]common.Hash{}
+
+	testCases := []struct {
+		msg            string
+		overrides      types.StateOverride
+		blockOverrides *types.BlockOverrides
+		expBalance     *big.Int
+		expPass        bool
+	}{
+		{"no overrides", nil, nil, supply, true},
+		{"state diff", types.StateOverride{contractAddr: {StateDiff: &diff}}, nil, big.NewInt(42), true},
+		{"empty state", types.StateOverride{contractAddr: {State: &empty}}, nil, big.NewInt(0), true},
+		{"no code", types.StateOverride{contractAddr: {Code: &hexutil.Bytes{}}}, nil, big.NewInt(0), true},
+		{"state and state diff", types.StateOverride{contractAddr: {State: &empty, StateDiff: &diff}}, nil, nil, false},
+		{
+			"block overrides",
+			nil,
+			&types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(100)), Coinbase: &suite.address},
+			supply,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			balance, err := balanceOf(tc.overrides, tc.blockOverrides)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expBalance.String(), balance.String())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+
+	// the overrides aren't committed
+	balance, err := balanceOf(nil, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(supply, balance)
+}
+
+func (suite *KeeperTestSuite) TestIntermediateRoots() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, math.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	txs := []*types.MsgEthereumTx{
+		suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, math.NewIntWithDecimal(1, 18).BigInt()),
+		suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, math.NewIntWithDecimal(1, 18).BigInt()),
+	}
+	suite.Commit()
+
+	// the replays write the block state, they're executed in branches of the same state
+	req := &types.QueryTraceBlockRequest{Txs: txs}
+	ctx, _ := suite.ctx.CacheContext()
+	res, err := suite.app.EvmKeeper.IntermediateRoots(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Roots, len(txs))
+	suite.Require().NotEqual(res.Roots[0], res.Roots[1])
+
+	ctx, _ = suite.ctx.CacheContext()
+	traceRes, err := suite.app.EvmKeeper.TraceBlock(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.Roots, traceRes.IntermediateRoots)
+
+	// each root is the hash of the previous root, the block hash for the first one, and of the store writes
+	// of the transaction
+	ctx, _ = suite.ctx.CacheContext()
+	ctx = ctx.WithBlockHeight(req.BlockNumber).WithBlockTime(req.BlockTime).WithHeaderHash(nil)
+	cfg, err := suite.app.EvmKeeper.EVMConfig(ctx)
+	suite.Require().NoError(err)
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+
+	root := common.Hash{}
+	txConfig := statedb.NewEmptyTxConfig(root)
+	for i, tx := range txs {
+		branch := &recordingBranch{source: ctx.MultiStore(), stores: map[string]storetypes.CacheKVStore{}, writes: [][]byte{}}
+
+		msg, err := tx.AsTransaction().AsMessage(signer, cfg.BaseFee)
+		suite.Require().NoError(err)
+		txConfig.TxHash = tx.AsTransaction().Hash()
+		txConfig.TxIndex = uint(i)
+		rsp, err := suite.app.EvmKeeper.ApplyMessageWithConfig(ctx.WithMultiStore(branch), msg, types.NewNoOpTracer(), true, cfg, txConfig)
+		suite.Require().NoError(err)
+		suite.Require().False(rsp.Failed(), rsp.VmError)
+		txConfig.LogIndex += uint(len(rsp.Logs))
+		branch.Write()
+		suite.Require().NotEmpty(branch.writes)
+
+		root = crypto.Keccak256Hash(append([][]byte{root.Bytes()}, branch.writes...)...)
+		suite.Require().Equal(root.Bytes(), res.Roots[i])
+	}
+}
+
+// recordingBranch is a branch of a multistore which records the hashes of the writes to the source stores.
+type recordingBranch struct {
+	source storetypes.MultiStore
+	stores map[string]storetypes.CacheKVStore
+	writes [][]byte
+}
+
+func (b *recordingBranch) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, ok := b.stores[key.Name()]
+	if !ok {
+		var parent storetypes.KVStore = b.source.GetKVStore(key)
+		if b.writes != nil {
+			parent = recordingStore{KVStore: parent, branch: b}
+		}
+		store = cachekv.NewStore(parent)
+		b.stores[key.Name()] = store
+	}
+	return store
+}
+
+func (b *recordingBranch) CacheWrap() storetypes.CacheMultiStore {
+	return &recordingBranch{source: b, stores: map[string]storetypes.CacheKVStore{}}
+}
+
+func (b *recordingBranch) Write() {
+	names := make([]string, 0, len(b.stores))
+	for name := range b.stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b.stores[name].Write()
+	}
+}
+
+func (b *recordingBranch) TracingEnabled() bool                                         { return false }
+func (b *recordingBranch) SetTracer(io.Writer)                                          {}
+func (b *recordingBranch) SetTracingContext(storetypes.TraceContext)                    {}
+func (b *recordingBranch) ListeningEnabled(storetypes.StoreKey) bool                    { return false }
+func (b *recordingBranch) AddListeners(storetypes.StoreKey, []storetypes.WriteListener) {}
+
+type recordingStore struct {
+	storetypes.KVStore
+	branch *recordingBranch
+}
+
+func (s recordingStore) Set(key, value []byte) {
+	s.branch.writes = append(s.branch.writes, crypto.Keccak256([]byte("write")), crypto.Keccak256(key), crypto.Keccak256(value))
+	s.KVStore.Set(key, value)
+}
+
+func (s recordingStore) Delete(key []byte) {
+	s.branch.writes = append(s.branch.writes, crypto.Keccak256([]byte("delete")), crypto.Keccak256(key), crypto.Keccak256(nil))
+	s.KVStore.Delete(key)
+}
+
+func (suite *KeeperTestSuite) TestStorageRange() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, math.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+
+	var storage []types.State
+	suite.app.EvmKeeper.ForEachStorage(suite.ctx, contractAddr, func(key, value common.Hash) bool {
+		storage = append(storage, types.NewState(key, value))
+		return true
+	})
+	suite.Require().Greater(len(storage), 1)
+
+	res, err := suite.queryClient.StorageRange(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageRangeRequest{
+		Address:   contractAddr.Hex(),
+		MaxResult: 1,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(storage[:1], res.Storage)
+	suite.Require().Equal(storage[1].Key, res.NextKey)
+
+	res, err = suite.queryClient.StorageRange(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageRangeRequest{
+		Address:   contractAddr.Hex(),
+		KeyStart:  res.NextKey,
+		MaxResult: uint64(len(storage)),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(storage[1:], res.Storage)
+	suite.Require().Empty(res.NextKey)
+
+	// the max result defaults to the limit
+	res, err = suite.queryClient.StorageRange(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageRangeRequest{
+		Address: contractAddr.Hex(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(storage, res.Storage)
+	suite.Require().Empty(res.NextKey)
+
+	_, err = suite.queryClient.StorageRange(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageRangeRequest{
+		Address: "invalid",
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestAccountRange() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, math.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+
+	res, err := suite.queryClient.AccountRange(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountRangeRequest{
+		Start:      contractAddr.Hex(),
+		MaxResults: 1,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+
+	account := res.Accounts[0]
+	suite.Require().Equal(contractAddr.Hex(), account.Address)
+	suite.Require().NotEmpty(account.Code)
+	suite.Require().NotEmpty(account.Storage)
+
+	res, err = suite.queryClient.AccountRange(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountRangeRequest{
+		Start:      contractAddr.Hex(),
+		MaxResults: 1,
+		NoCode:     true,
+		NoStorage:  true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+	suite.Require().Empty(res.Accounts[0].Code)
+	suite.Require().Empty(res.Accounts[0].Storage)
+
+	res, err = suite.queryClient.AccountRange(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountRangeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(res.Accounts)
+	suite.Require().Empty(res.Next)
+	for i := 1; i < len(res.Accounts); i++ {
+		suite.Require().Negative(bytes.Compare(
+			common.HexToAddress(res.Accounts[i-1].Address).Bytes(),
+			common.HexToAddress(res.Accounts[i].Address).Bytes(),
+		))
+	}
+	accounts := res.Accounts
+
+	// the accounts are paged from the start address
+	var paged []types.RangeAccount
+	for start := ""; ; {
+		res, err = suite.queryClient.AccountRange(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountRangeRequest{
+			Start:      start,
+			MaxResults: 1,
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.Accounts, 1)
+		paged = append(paged, res.Accounts...)
+		if res.Next == "" {
+			break
+		}
+		start = res.Next
+	}
+	suite.Require().Equal(accounts, paged)
+
+	after := new(big.Int).Add(new(big.Int).SetBytes(contractAddr.Bytes()), big.NewInt(1))
+	res, err = suite.queryClient.AccountRange(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountRangeRequest{
+		Start: common.BigToAddress(after).Hex(),
+	})
+	suite.Require().NoError(err)
+	for _, account := range res.Accounts {
+		suite.Require().Positive(bytes.Compare(common.HexToAddress(account.Address).Bytes(), contractAddr.Bytes()))
+	}
+
+	// the storage of an account is truncated, the rest is queried with StorageRange
+	storageAddr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	db := suite.StateDB()
+	db.SetCode(storageAddr, []byte{byte(vm.STOP)})
+	for i := int64(1); i <= 300; i++ {
+		db.SetState(storageAddr, common.BigToHash(big.NewInt(i)), common.BigToHash(big.NewInt(i)))
+	}
+	suite.Require().NoError(db.Commit())
+
+	res, err = suite.queryClient.AccountRange(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountRangeRequest{
+		Start:      storageAddr.Hex(),
+		MaxResults: 1,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+	suite.Require().Len(res.Accounts[0].Storage, 256)
+	suite.Require().Equal(common.BigToHash(big.NewInt(257)).Hex(), res.Accounts[0].StorageNextKey)
+
+	storageRes, err := suite.queryClient.StorageRange(sdk.WrapSDKContext(suite.ctx), &types.QueryStorageRangeRequest{
+		Address:  storageAddr.Hex(),
+		KeyStart: res.Accounts[0].StorageNextKey,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(storageRes.Storage, 44)
+	suite.Require().Empty(storageRes.NextKey)
+}
+
+func (suite *KeeperTestSuite) TestNativeTracers() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, math.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	txMsg := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, math.NewIntWithDecimal(1, 18).BigInt())
+	suite.Commit()
+
+	contract := strings.ToLower(contractAddr.Hex())
+
+	testCases := []struct {
+		msg         string
+		traceConfig *types.TraceConfig
+		expPass     bool
+		check       func(result map[string]interface{})
+	}{
+		{
+			"call tracer",
+			&types.TraceConfig{Tracer: "callTracer"},
+			true,
+			func(result map[string]interface{}) {
+				suite.Require().Equal("CALL", result["type"])
+				suite.Require().Equal(contract, result["to"])
+			},
+		},
+		{
+			"4byte tracer",
+			&types.TraceConfig{Tracer: "4byteTracer"},
+			true,
+			func(result map[string]interface{}) {
+				// transfer(address,uint256)
+				suite.Require().Contains(result, "0xa9059cbb-64")
+			},
+		},
+		{
+			"prestate tracer",
+			&types.TraceConfig{Tracer: "prestateTracer"},
+			true,
+			func(result map[string]interface{}) {
+				suite.Require().Contains(result, contract)
+				suite.Require().Contains(result, strings.ToLower(suite.address.Hex()))
+			},
+		},
+		{
+			"prestate tracer in diff mode",
+			&types.TraceConfig{Tracer: "prestateTracer", TracerJsonConfig: `{"diffMode": true}`},
+			true,
+			func(result map[string]interface{}) {
+				suite.Require().Contains(result, "pre")
+				post := result["post"].(map[string]interface{})
+				// the token balances of the sender and the recipient change
+				storage := post[contract].(map[string]interface{})["storage"].(map[string]interface{})
+				suite.Require().Len(storage, 2)
+			},
+		},
+		{
+			"invalid tracer config",
+			&types.TraceConfig{Tracer: "prestateTracer", TracerJsonConfig: `{"diffMode": 1}`},
+			false,
+			nil,
+		},
+		{
+			"unknown tracer",
+			&types.TraceConfig{Tracer: "unknownTracer"},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			res, err := suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+				Msg:         txMsg,
+				TraceConfig: tc.traceConfig,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			var result map[string]interface{}
+			suite.Require().NoError(json.Unmarshal(res.Data, &result))
+			tc.check(result)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTraceCall() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	suite.Commit()
+
+	data, err := types.ERC20Contract.ABI.Pack("balanceOf", suite.address)
+	suite.Require().NoError(err)
+	args, err := json.Marshal(&types.TransactionArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&data)})
+	suite.Require().NoError(err)
+
+	// the balances mapping is the first slot of the contract
+	balanceSlot := crypto.Keccak256Hash(common.LeftPadBytes(suite.address.Bytes(), 32), common.LeftPadBytes(nil, 32))
+	diff := map[common.Hash]common.Hash{balanceSlot: common.BigToHash(big.NewInt(42))}
+	overrides, err := json.Marshal(types.StateOverride{contractAddr: {StateDiff: &diff}})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		msg        string
+		overrides  []byte
+		expBalance *big.Int
+	}{
+		{"no overrides", nil, big.NewInt(1000)},
+		{"state overrides", overrides, big.NewInt(42)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			res, err := suite.queryClient.TraceCall(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceCallRequest{
+				Call: &types.EthCallRequest{
+					Args:      args,
+					GasCap:    uint64(config.DefaultGasCap),
+					Overrides: tc.overrides,
+				},
+				TraceConfig: &types.TraceConfig{Tracer: "callTracer"},
+			})
+			suite.Require().NoError(err)
+
+			var result struct {
+				Type   string        `json:"type"`
+				Output hexutil.Bytes `json:"output"`
+			}
+			suite.Require().NoError(json.Unmarshal(res.Data, &result))
+			suite.Require().Equal("CALL", result.Type)
+			suite.Require().Equal(tc.expBalance.String(), new(big.Int).SetBytes(result.Output).String())
+		})
+	}
+
+	_, err = suite.queryClient.TraceCall(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceCallRequest{})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestFlatCallTracer() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, math.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	txMsg := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, math.NewIntWithDecimal(1, 18).BigInt())
+	suite.Commit()
+
+	res, err := suite.queryClient.TraceBlock(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceBlockRequest{
+		Txs:         []*types.MsgEthereumTx{txMsg},
+		TraceConfig: &types.TraceConfig{Tracer: "flatCallTracer"},
+	})
+	suite.Require().NoError(err)
+
+	var results []struct {
+		Result []evmtracers.FlatCallFrame `json:"result"`
+	}
+	suite.Require().NoError(json.Unmarshal(res.Data, &results))
+	suite.Require().Len(results, 1)
+	suite.Require().Len(results[0].Result, 1)
+
+	frame := results[0].Result[0]
+	suite.Require().Equal("call", frame.Type)
+	suite.Require().Equal("call", frame.Action.CallType)
+	suite.Require().Equal(suite.address, *frame.Action.From)
+	suite.Require().Equal(contractAddr, *frame.Action.To)
+	suite.Require().Empty(frame.TraceAddress)
+	suite.Require().Zero(frame.Subtraces)
+	suite.Require().Empty(frame.Error)
+	suite.Require().NotNil(frame.Result)
+	// transfer returns true
+	suite.Require().Equal(common.LeftPadBytes([]byte{1}, 32), []byte(*frame.Result.Output))
+}
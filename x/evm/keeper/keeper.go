@@ -17,6 +17,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	ethermint "github.com/tharsis/ethermint/types"
+	"github.com/tharsis/ethermint/x/evm/precompile"
 	"github.com/tharsis/ethermint/x/evm/statedb"
 	"github.com/tharsis/ethermint/x/evm/types"
 )
@@ -54,6 +55,9 @@ type Keeper struct {
 
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
+
+	// Stateful precompiled contracts, by address
+	precompiles map[common.Address]precompile.Contract
 }
 
 // NewKeeper generates new evm module keeper
@@ -221,6 +225,34 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	return k
 }
 
+// SetPrecompiles sets the stateful precompiled contracts. A contract is only set on the EVM, and its address is only
+// a precompile, once the address is in the ActivePrecompiles param.
+// It should be called only once during initialization, it panic if called more than once.
+func (k *Keeper) SetPrecompiles(contracts ...precompile.Contract) *Keeper {
+	if k.precompiles != nil {
+		panic("cannot set evm precompiles twice")
+	}
+
+	k.precompiles = make(map[common.Address]precompile.Contract, len(contracts))
+	for _, contract := range contracts {
+		k.precompiles[contract.Address()] = contract
+	}
+
+	return k
+}
+
+// activePrecompiles returns the stateful precompiled contracts activated by the params.
+func (k Keeper) activePrecompiles(params types.Params) map[common.Address]precompile.Contract {
+	contracts := make(map[common.Address]precompile.Contract, len(params.ActivePrecompiles))
+	for address, contract := range k.precompiles {
+		if params.IsActivePrecompile(address) {
+			contracts[address] = contract
+		}
+	}
+
+	return contracts
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(ctx sdk.Context, from common.Address, to *common.Address, receipt *ethtypes.Receipt) error {
 	if k.hooks == nil {
@@ -336,3 +368,21 @@ func (k Keeper) AddTransientGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, er
 	k.SetTransientGasUsed(ctx, result)
 	return result, nil
 }
+
+// SetTxFeePayerTransient sets the account paying the fees of the ethereum tx on behalf of the sender, the
+// leftover gas of the tx is refunded to it. Called in the ante handler for the fee granted txs.
+func (k Keeper) SetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash, feePayer sdk.AccAddress) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	store.Set(txHash.Bytes(), feePayer.Bytes())
+}
+
+// GetTxFeePayerTransient returns the account paying the fees of the ethereum tx, nil if the fees are paid
+// by the sender.
+func (k Keeper) GetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash) sdk.AccAddress {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeePayer)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return nil
+	}
+	return sdk.AccAddress(bz)
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// EmitNativeLogs emits EVM logs for the state changes of native modules, so that Ethereum clients can follow them.
+// The logs are emitted in a tx log event of the current transaction (or of the block, in BeginBlock and EndBlock),
+// and added to the block bloom, so that they're returned by eth_getLogs and the logs subscriptions.
+//
+// The native logs of a block are in a synthetic transaction, which isn't returned by the transaction queries:
+// its hash is types.NativeLogsTxHash of the block height, and its index is types.NativeLogsTxIndex, so that they
+// don't collide with the Ethereum transactions. The log indexes follow the logs of the Ethereum transactions
+// processed before, like the logs of a transaction.
+func (k Keeper) EmitNativeLogs(ctx sdk.Context, logs []*ethtypes.Log) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	txHash := types.NativeLogsTxHash(ctx.BlockHeight())
+	logIndex := k.GetLogSizeTransient(ctx)
+
+	txLogAttrs := make([]sdk.Attribute, len(logs))
+	for i, log := range logs {
+		log.BlockNumber = uint64(ctx.BlockHeight())
+		log.BlockHash = common.BytesToHash(ctx.HeaderHash())
+		log.TxHash = txHash
+		log.TxIndex = types.NativeLogsTxIndex
+		log.Index = uint(logIndex) + uint(i)
+
+		value, err := json.Marshal(types.NewLogFromEth(log))
+		if err != nil {
+			return sdkerrors.Wrap(err, "failed to encode log")
+		}
+		txLogAttrs[i] = sdk.NewAttribute(types.AttributeKeyTxLog, string(value))
+	}
+
+	bloom := k.GetBlockBloomTransient(ctx)
+	bloom.Or(bloom, big.NewInt(0).SetBytes(ethtypes.LogsBloom(logs)))
+	k.SetBlockBloomTransient(ctx, bloom)
+	k.SetLogSizeTransient(ctx, logIndex+uint64(len(logs)))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeTxLog, txLogAttrs...))
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2alpha1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tharsis/ethermint/x/evm/types"
+)
+
+// Cosmos stores have no state root per transaction, so the block replays compute intermediate roots from
+// the store writes instead: the root after a transaction is the hash of the previous root (the block hash
+// for the first transaction) and of the store writes of the transaction, in the store and key order.
+// The roots of two nodes replaying a block diverge at the first transaction with different writes.
+
+// replayWithRoots executes the transactions of a block replay, each in a branch of the context which is
+// written after the execution, and returns the intermediate roots.
+func replayWithRoots(
+	ctx sdk.Context,
+	txs []*types.MsgEthereumTx,
+	execute func(ctx sdk.Context, i int, tx *ethtypes.Transaction),
+) ([][]byte, error) {
+	root := common.BytesToHash(ctx.HeaderHash())
+	roots := make([][]byte, len(txs))
+
+	for i, tx := range txs {
+		var writes bytes.Buffer
+
+		branch := newTracedBranch(ctx.MultiStore(), &writes)
+
+		execute(ctx.WithMultiStore(branch), i, tx.AsTransaction())
+
+		branch.Write()
+
+		var err error
+		if root, err = nextRoot(root, &writes); err != nil {
+			return nil, err
+		}
+		roots[i] = root.Bytes()
+	}
+
+	return roots, nil
+}
+
+// tracedBranch is a branch of a multistore which traces the writes to the source stores when it's written,
+// in the store and key order. The SDK tracer can't be set on the branches of the adapted v1 stores.
+type tracedBranch struct {
+	source storetypes.MultiStore
+	tracer io.Writer
+	stores map[string]storetypes.CacheKVStore
+}
+
+var _ storetypes.CacheMultiStore = &tracedBranch{}
+
+func newTracedBranch(source storetypes.MultiStore, tracer io.Writer) *tracedBranch {
+	return &tracedBranch{
+		source: source,
+		tracer: tracer,
+		stores: map[string]storetypes.CacheKVStore{},
+	}
+}
+
+// GetKVStore implements MultiStore.
+func (b *tracedBranch) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, ok := b.stores[key.Name()]
+	if !ok {
+		parent := b.source.GetKVStore(key)
+		if b.tracer != nil {
+			parent = tracekv.NewStore(parent, b.tracer, nil)
+		}
+		store = cachekv.NewStore(parent)
+		b.stores[key.Name()] = store
+	}
+	return store
+}
+
+// CacheWrap implements MultiStore, the nested branches aren't traced.
+func (b *tracedBranch) CacheWrap() storetypes.CacheMultiStore {
+	return newTracedBranch(b, nil)
+}
+
+// Write implements CacheMultiStore.
+func (b *tracedBranch) Write() {
+	names := make([]string, 0, len(b.stores))
+	for name := range b.stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		b.stores[name].Write()
+	}
+}
+
+// TracingEnabled implements CacheMultiStore.
+func (b *tracedBranch) TracingEnabled() bool { return b.tracer != nil }
+
+// SetTracer implements CacheMultiStore, it applies to the stores not used yet.
+func (b *tracedBranch) SetTracer(w io.Writer) { b.tracer = w }
+
+// SetTracingContext implements CacheMultiStore, the traces have no context.
+func (b *tracedBranch) SetTracingContext(storetypes.TraceContext) {}
+
+// ListeningEnabled implements CacheMultiStore, the branches have no listeners.
+func (b *tracedBranch) ListeningEnabled(storetypes.StoreKey) bool { return false }
+
+// AddListeners implements CacheMultiStore, the branches have no listeners.
+func (b *tracedBranch) AddListeners(storetypes.StoreKey, []storetypes.WriteListener) {}
+
+// storeWrite is a write of the store trace, see the SDK tracekv store.
+type storeWrite struct {
+	Operation string `json:"operation"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+}
+
+// nextRoot returns the root of the traced store writes of a transaction, on top of the previous root.
+func nextRoot(root common.Hash, trace *bytes.Buffer) (common.Hash, error) {
+	data := [][]byte{root.Bytes()}
+
+	scanner := bufio.NewScanner(trace)
+	scanner.Buffer(nil, trace.Len()+1)
+	for scanner.Scan() {
+		var write storeWrite
+		if err := json.Unmarshal(scanner.Bytes(), &write); err != nil {
+			return common.Hash{}, err
+		}
+		if write.Operation != "write" && write.Operation != "delete" {
+			continue
+		}
+
+		key, err := base64.StdEncoding.DecodeString(write.Key)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		value, err := base64.StdEncoding.DecodeString(write.Value)
+		if err != nil {
+			return common.Hash{}, err
+		}
+
+		data = append(data,
+			crypto.Keccak256([]byte(write.Operation)),
+			crypto.Keccak256(key),
+			crypto.Keccak256(value),
+		)
+	}
+	if err := scanner.Err(); err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(data...), nil
+}
@@ -7,6 +7,8 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -425,7 +427,7 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 			refund := keeper.GasToRefund(vmdb.GetRefund(), gasUsed, tc.refundQuotient)
 			suite.Require().Equal(tc.expGasRefund, refund)
 
-			err = suite.app.EvmKeeper.RefundGas(suite.ctx, m, refund, "aphoton")
+			err = suite.app.EvmKeeper.RefundGas(suite.ctx, m, m.From().Bytes(), refund, "aphoton")
 			if tc.noError {
 				suite.Require().NoError(err)
 			} else {
@@ -436,6 +438,67 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 	suite.mintFeeCollector = false
 }
 
+func (suite *KeeperTestSuite) TestApplyTransactionFeePayerRefund() {
+	testCases := []struct {
+		name     string
+		feePayer bool
+	}{
+		{"refund to the sender", false},
+		{"refund to the fee payer", true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			denom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+			coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100000)))
+			suite.Require().NoError(testutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, authtypes.FeeCollectorName, coins))
+
+			ethSigner := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+			tx, err := newSignedEthTx(&ethtypes.LegacyTx{
+				GasPrice: big.NewInt(2),
+				Gas:      50000,
+				To:       &common.Address{},
+				Value:    big.NewInt(0),
+			},
+				suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+				sdk.AccAddress(suite.address.Bytes()),
+				suite.signer,
+				ethSigner,
+			)
+			suite.Require().NoError(err)
+
+			from, err := ethtypes.Sender(ethSigner, tx)
+			suite.Require().NoError(err)
+			sender := sdk.AccAddress(from.Bytes())
+			payer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+			refundee := sender
+			if tc.feePayer {
+				suite.app.EvmKeeper.SetTxFeePayerTransient(suite.ctx, tx.Hash(), payer)
+				refundee = payer
+			}
+
+			senderBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom)
+			payerBalance := suite.app.BankKeeper.GetBalance(suite.ctx, payer, denom)
+
+			res, err := suite.app.EvmKeeper.ApplyTransaction(suite.ctx, tx)
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed())
+			suite.Require().Less(res.GasUsed, tx.Gas())
+
+			refund := sdk.NewIntFromUint64(tx.Gas() - res.GasUsed).MulRaw(2)
+			if tc.feePayer {
+				suite.Require().Equal(senderBalance, suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom))
+				suite.Require().Equal(payerBalance.Amount.Add(refund), suite.app.BankKeeper.GetBalance(suite.ctx, refundee, denom).Amount)
+			} else {
+				suite.Require().Equal(senderBalance.Amount.Add(refund), suite.app.BankKeeper.GetBalance(suite.ctx, refundee, denom).Amount)
+				suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, payer, denom).IsZero())
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	testCases := []struct {
 		name        string
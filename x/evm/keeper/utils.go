@@ -21,13 +21,26 @@ func (k Keeper) DeductTxCostsFromUserBalance(
 	txData evmtypes.TxData,
 	denom string,
 	homestead, istanbul, london bool,
+) (sdk.Coins, error) {
+	return k.DeductTxCostsFromFeePayer(ctx, msgEthTx, txData, msgEthTx.GetFrom(), denom, homestead, istanbul, london)
+}
+
+// DeductTxCostsFromFeePayer it calculates the tx costs and deducts the fees from the fee payer balance,
+// which is the sender unless the fees are paid by a fee granter.
+func (k Keeper) DeductTxCostsFromFeePayer(
+	ctx sdk.Context,
+	msgEthTx evmtypes.MsgEthereumTx,
+	txData evmtypes.TxData,
+	feePayer sdk.AccAddress,
+	denom string,
+	homestead, istanbul, london bool,
 ) (sdk.Coins, error) {
 	isContractCreation := txData.GetTo() == nil
 
-	// fetch sender account from signature
-	signerAcc, err := ante.GetSignerAcc(ctx, k.accountKeeper, msgEthTx.GetFrom())
+	// fetch fee payer account
+	payerAcc, err := ante.GetSignerAcc(ctx, k.accountKeeper, feePayer)
 	if err != nil {
-		return nil, sdkerrors.Wrapf(err, "account not found for sender %s", msgEthTx.From)
+		return nil, sdkerrors.Wrapf(err, "account not found for fee payer %s", feePayer)
 	}
 
 	gasLimit := txData.GetGas()
@@ -74,12 +87,12 @@ func (k Keeper) DeductTxCostsFromUserBalance(
 
 	fees := sdk.Coins{sdk.NewCoin(denom, sdk.NewIntFromBigInt(feeAmt))}
 
-	// deduct the full gas cost from the user balance
-	if err := ante.DeductFees(k.bankKeeper, ctx, signerAcc, fees); err != nil {
+	// deduct the full gas cost from the fee payer balance
+	if err := ante.DeductFees(k.bankKeeper, ctx, payerAcc, fees); err != nil {
 		return nil, sdkerrors.Wrapf(
 			err,
-			"failed to deduct full gas cost %s from the user %s balance",
-			fees, msgEthTx.From,
+			"failed to deduct full gas cost %s from the fee payer %s balance",
+			fees, feePayer,
 		)
 	}
 	return fees, nil
@@ -72,6 +72,9 @@ func (b AppModuleBasic) RegisterGRPCGatewayRoutes(c client.Context, serveMux *ru
 	if err := types.RegisterQueryHandlerClient(context.Background(), serveMux, types.NewQueryClient(c)); err != nil {
 		panic(err)
 	}
+	if err := types.RegisterServiceHandlerClient(context.Background(), serveMux, types.NewServiceClient(c)); err != nil {
+		panic(err)
+	}
 }
 
 // GetTxCmd returns the root tx command for the evm module.
@@ -0,0 +1,143 @@
+// Package precompile implements stateful precompiled contracts, which expose the Cosmos SDK modules
+// to the EVM.
+//
+// The contracts are set on each EVM execution, with the stateful precompiles of the go-ethereum fork
+// (see third_party/go-ethereum), which run them with the caller and the static context of the call.
+package precompile
+
+import (
+	"errors"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/tharsis/ethermint/x/evm/statedb"
+)
+
+// ErrWriteProtection is returned when a precompile changes the state in a read-only call.
+var ErrWriteProtection = errors.New("precompiled contract state change in a read-only call")
+
+// Contract is a stateful precompiled contract.
+type Contract interface {
+	// Address returns the address of the contract.
+	Address() common.Address
+	// RequiredGas returns the base gas cost of a call, the SDK gas consumed by its state changes is added to it.
+	RequiredGas(input []byte) uint64
+	// Run runs a call.
+	Run(ctx *Context, input []byte) ([]byte, error)
+}
+
+// Context is the context of a stateful precompile call.
+type Context struct {
+	stateDB *statedb.StateDB
+	address common.Address
+	// gas is the gas left to the call
+	gas uint64
+
+	// Caller is the msg.sender of the call, it's the zero address for the delegate and code calls,
+	// which are read-only.
+	Caller common.Address
+	// Value is the value sent with the call.
+	Value *big.Int
+	// ReadOnly is whether the call can't change the state, e.g. a static call.
+	ReadOnly bool
+}
+
+// SDKContext returns the SDK context to read the state, with the changes of the previous calls.
+func (c *Context) SDKContext() sdk.Context {
+	return c.stateDB.NativeContext()
+}
+
+// Execute runs a state change of the call, which is reverted with the EVM state changes.
+// The SDK gas consumed by the change is charged to the call, the change fails with vm.ErrOutOfGas
+// if it consumes more than the gas left.
+func (c *Context) Execute(action func(ctx sdk.Context) error) (err error) {
+	if c.ReadOnly {
+		return ErrWriteProtection
+	}
+
+	gasMeter := sdk.NewGasMeter(c.gas)
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+
+			c.gas = 0
+			err = vm.ErrOutOfGas
+		}
+	}()
+
+	err = c.stateDB.ExecuteNativeAction(func(ctx sdk.Context) error {
+		return action(ctx.WithGasMeter(gasMeter))
+	})
+	c.gas -= gasMeter.GasConsumed()
+
+	return err
+}
+
+// AddLog emits an EVM log from the contract, which is reverted with the EVM state changes.
+func (c *Context) AddLog(topics []common.Hash, data []byte) error {
+	if c.ReadOnly {
+		return ErrWriteProtection
+	}
+
+	c.stateDB.AddLog(&ethtypes.Log{
+		Address: c.address,
+		Topics:  topics,
+		Data:    data,
+	})
+
+	return nil
+}
+
+// NewPrecompiles returns the go-ethereum stateful precompiles of an EVM execution, which run the contracts
+// on its StateDB. They're set on the EVM with WithPrecompiles.
+func NewPrecompiles(stateDB *statedb.StateDB, contracts map[common.Address]Contract) map[common.Address]vm.StatefulPrecompiledContract {
+	precompiles := make(map[common.Address]vm.StatefulPrecompiledContract, len(contracts))
+	for address, contract := range contracts {
+		precompiles[address] = statefulPrecompile{contract: contract, stateDB: stateDB}
+	}
+
+	return precompiles
+}
+
+// statefulPrecompile is the go-ethereum stateful precompile of a contract.
+type statefulPrecompile struct {
+	contract Contract
+	stateDB  *statedb.StateDB
+}
+
+var _ vm.StatefulPrecompiledContract = statefulPrecompile{}
+
+// RequiredGas implements vm.StatefulPrecompiledContract, the base gas cost is charged before the contract runs.
+func (p statefulPrecompile) RequiredGas(input []byte) uint64 {
+	return p.contract.RequiredGas(input)
+}
+
+// RunStateful implements vm.StatefulPrecompiledContract. The SDK gas consumed by the state changes of the call
+// is charged from the gas left.
+func (p statefulPrecompile) RunStateful(_ *vm.EVM, call vm.PrecompileCall, input []byte, gas uint64) ([]byte, uint64, error) {
+	ctx := &Context{
+		stateDB:  p.stateDB,
+		address:  p.contract.Address(),
+		gas:      gas,
+		Caller:   call.Caller,
+		Value:    call.Value,
+		ReadOnly: call.ReadOnly,
+	}
+
+	// The delegate and code calls run in the context of the calling contract, they would act on behalf
+	// of its caller.
+	if call.Type == vm.DELEGATECALL || call.Type == vm.CALLCODE {
+		ctx.Caller = common.Address{}
+		ctx.ReadOnly = true
+	}
+
+	output, err := p.contract.Run(ctx, input)
+
+	return output, ctx.gas, err
+}
@@ -0,0 +1,62 @@
+package statedb
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// nativeBranch is a cached branch of the SDK state, with the changes of a native action.
+type nativeBranch struct {
+	parent sdk.Context
+	ctx    sdk.Context
+	write  func()
+}
+
+// nativeChange is the journal entry of a native action, reverted by dropping its branch.
+type nativeChange struct{}
+
+func (ch nativeChange) revert(s *StateDB) {
+	s.nativeBranches = s.nativeBranches[:len(s.nativeBranches)-1]
+}
+
+func (ch nativeChange) dirtied() *common.Address {
+	return nil
+}
+
+// NativeContext returns the SDK context with the changes of the native actions, e.g. of the
+// stateful precompiles. It must only be used to read the state.
+func (s *StateDB) NativeContext() sdk.Context {
+	if len(s.nativeBranches) == 0 {
+		return s.ctx
+	}
+
+	return s.nativeBranches[len(s.nativeBranches)-1].ctx
+}
+
+// ExecuteNativeAction runs a change of the SDK state in a cached branch, which is journaled with the
+// EVM state changes: it's dropped if the call reverts, and written on Commit. The action must not
+// change the EVM accounts, which are overwritten on Commit.
+func (s *StateDB) ExecuteNativeAction(action func(ctx sdk.Context) error) error {
+	parent := s.NativeContext()
+	ctx, write := parent.CacheContext()
+
+	if err := action(ctx); err != nil {
+		return err
+	}
+
+	s.journal.append(nativeChange{})
+	s.nativeBranches = append(s.nativeBranches, nativeBranch{parent: parent, ctx: ctx, write: write})
+
+	return nil
+}
+
+// commitNativeActions writes the branches of the native actions to the SDK state, with their events.
+func (s *StateDB) commitNativeActions() {
+	for i := len(s.nativeBranches) - 1; i >= 0; i-- {
+		branch := s.nativeBranches[i]
+		branch.write()
+		branch.parent.EventManager().EmitEvents(branch.ctx.EventManager().Events())
+	}
+
+	s.nativeBranches = nil
+}
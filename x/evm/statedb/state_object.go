@@ -59,6 +59,8 @@ type stateObject struct {
 	// state storage
 	originStorage Storage
 	dirtyStorage  Storage
+	// fakeStorage replaces the stored state in a call with a state override
+	fakeStorage Storage
 
 	address common.Address
 
@@ -199,6 +201,9 @@ func (s *stateObject) Nonce() uint64 {
 
 // GetCommittedState query the committed state
 func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
+	if s.fakeStorage != nil {
+		return s.fakeStorage[key]
+	}
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
@@ -235,3 +240,13 @@ func (s *stateObject) SetState(key common.Hash, value common.Hash) {
 func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
+
+// SetStorage replaces the entire state storage with the given one, it's not journaled.
+func (s *stateObject) SetStorage(storage Storage) {
+	s.fakeStorage = make(Storage, len(storage))
+	for key, value := range storage {
+		s.fakeStorage[key] = value
+	}
+	// the dirty state is discarded with the stored state
+	s.dirtyStorage = make(Storage)
+}
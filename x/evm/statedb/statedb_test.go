@@ -1,9 +1,11 @@
 package statedb_test
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -385,6 +387,46 @@ func (suite *StateDBTestSuite) TestNestedSnapshot() {
 	suite.Require().Equal(common.Hash{}, db.GetState(address, key))
 }
 
+func (suite *StateDBTestSuite) TestNativeAction() {
+	storeKey := sdk.NewKVStoreKey("native")
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient"))
+	key1, key2 := []byte("key1"), []byte("key2")
+
+	setValue := func(key []byte) func(sdk.Context) error {
+		return func(ctx sdk.Context) error {
+			ctx.KVStore(storeKey).Set(key, []byte("value"))
+			ctx.EventManager().EmitEvent(sdk.NewEvent("native", sdk.NewAttribute("key", string(key))))
+			return nil
+		}
+	}
+
+	db := statedb.New(ctx, NewMockKeeper(), emptyTxConfig)
+
+	suite.Require().NoError(db.ExecuteNativeAction(setValue(key1)))
+	suite.Require().True(db.NativeContext().KVStore(storeKey).Has(key1))
+
+	rev := db.Snapshot()
+	suite.Require().NoError(db.ExecuteNativeAction(setValue(key2)))
+	suite.Require().True(db.NativeContext().KVStore(storeKey).Has(key2))
+
+	db.RevertToSnapshot(rev)
+	suite.Require().False(db.NativeContext().KVStore(storeKey).Has(key2))
+
+	// a failed action isn't journaled
+	suite.Require().Error(db.ExecuteNativeAction(func(ctx sdk.Context) error {
+		ctx.KVStore(storeKey).Set(key2, []byte("value"))
+		return errors.New("failure")
+	}))
+	suite.Require().False(db.NativeContext().KVStore(storeKey).Has(key2))
+
+	// the changes are only written on commit
+	suite.Require().False(ctx.KVStore(storeKey).Has(key1))
+	suite.Require().NoError(db.Commit())
+	suite.Require().True(ctx.KVStore(storeKey).Has(key1))
+	suite.Require().False(ctx.KVStore(storeKey).Has(key2))
+	suite.Require().Len(ctx.EventManager().Events(), 1)
+}
+
 func (suite *StateDBTestSuite) TestInvalidSnapshotId() {
 	db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
 	suite.Require().Panics(func() {
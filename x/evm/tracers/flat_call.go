@@ -0,0 +1,238 @@
+package tracers
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+func init() {
+	Register("flatCallTracer", newFlatCallTracer)
+}
+
+// FlatCallAction is the action of a flat call trace: a call, a contract creation or a self-destruct.
+type FlatCallAction struct {
+	CallType      string          `json:"callType,omitempty"`
+	From          *common.Address `json:"from,omitempty"`
+	To            *common.Address `json:"to,omitempty"`
+	Gas           *hexutil.Uint64 `json:"gas,omitempty"`
+	Input         *hexutil.Bytes  `json:"input,omitempty"`
+	Init          *hexutil.Bytes  `json:"init,omitempty"`
+	Value         *hexutil.Big    `json:"value,omitempty"`
+	Address       *common.Address `json:"address,omitempty"`
+	RefundAddress *common.Address `json:"refundAddress,omitempty"`
+	Balance       *hexutil.Big    `json:"balance,omitempty"`
+}
+
+// FlatCallResult is the result of a successful call or contract creation.
+type FlatCallResult struct {
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+}
+
+// FlatCallFrame is a call of a transaction in the Parity (OpenEthereum) trace format. The trace address is
+// the path of the call in the call tree, the subtraces are the number of calls it makes.
+type FlatCallFrame struct {
+	Action       FlatCallAction  `json:"action"`
+	Error        string          `json:"error,omitempty"`
+	Result       *FlatCallResult `json:"result"`
+	Subtraces    int             `json:"subtraces"`
+	TraceAddress []int           `json:"traceAddress"`
+	Type         string          `json:"type"`
+}
+
+// callFrame is a call of the call tree built during the execution.
+type callFrame struct {
+	typ     vm.OpCode
+	from    common.Address
+	to      common.Address
+	input   []byte
+	output  []byte
+	gas     uint64
+	gasUsed uint64
+	value   *big.Int
+	err     error
+	calls   []*callFrame
+}
+
+// flatCallTracer collects the calls of a transaction, in the flat format of the Parity trace namespace.
+type flatCallTracer struct {
+	stack     []*callFrame
+	root      *callFrame
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+func newFlatCallTracer(_ *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
+	return &flatCallTracer{}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *flatCallTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	typ := vm.CALL
+	if create {
+		typ = vm.CREATE
+	}
+	t.root = &callFrame{
+		typ:   typ,
+		from:  from,
+		to:    to,
+		input: common.CopyBytes(input),
+		gas:   gas,
+		value: new(big.Int).Set(value),
+	}
+	t.stack = []*callFrame{t.root}
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *flatCallTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	t.root.output = common.CopyBytes(output)
+	t.root.gasUsed = gasUsed
+	t.root.err = err
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *flatCallTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (t *flatCallTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, _ *vm.ScopeContext, depth int, err error) {
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *flatCallTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if atomic.LoadUint32(&t.interrupt) > 0 || len(t.stack) == 0 {
+		return
+	}
+
+	call := &callFrame{
+		typ:   typ,
+		from:  from,
+		to:    to,
+		input: common.CopyBytes(input),
+		gas:   gas,
+	}
+	if value != nil {
+		call.value = new(big.Int).Set(value)
+	}
+
+	parent := t.stack[len(t.stack)-1]
+	parent.calls = append(parent.calls, call)
+	t.stack = append(t.stack, call)
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *flatCallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if len(t.stack) <= 1 {
+		return
+	}
+
+	call := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+
+	call.output = common.CopyBytes(output)
+	call.gasUsed = gasUsed
+	call.err = err
+}
+
+// GetResult returns the json-encoded flat list of call traces, and any
+// error arising from the encoding or forceful termination (via `Stop`).
+func (t *flatCallTracer) GetResult() (json.RawMessage, error) {
+	frames := []FlatCallFrame{}
+	if t.root != nil {
+		frames = flatten(t.root, []int{}, frames)
+	}
+
+	res, err := json.Marshal(frames)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *flatCallTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+// flatten appends the frames of a call and of its subcalls, depth first.
+func flatten(call *callFrame, traceAddress []int, frames []FlatCallFrame) []FlatCallFrame {
+	frame := FlatCallFrame{
+		Subtraces:    len(call.calls),
+		TraceAddress: traceAddress,
+	}
+
+	from, to := call.from, call.to
+	value := (*hexutil.Big)(call.value)
+	switch call.typ {
+	case vm.CREATE, vm.CREATE2:
+		gas, init := hexutil.Uint64(call.gas), hexutil.Bytes(call.input)
+		frame.Type = "create"
+		frame.Action = FlatCallAction{From: &from, Gas: &gas, Init: &init, Value: value}
+		if call.err == nil {
+			code := hexutil.Bytes(call.output)
+			frame.Result = &FlatCallResult{GasUsed: hexutil.Uint64(call.gasUsed), Address: &to, Code: &code}
+		}
+	case vm.SELFDESTRUCT:
+		frame.Type = "suicide"
+		frame.Action = FlatCallAction{Address: &from, RefundAddress: &to, Balance: value}
+	default:
+		gas, input := hexutil.Uint64(call.gas), hexutil.Bytes(call.input)
+		frame.Type = "call"
+		frame.Action = FlatCallAction{
+			CallType: strings.ToLower(call.typ.String()),
+			From:     &from,
+			To:       &to,
+			Gas:      &gas,
+			Input:    &input,
+			Value:    value,
+		}
+		if frame.Action.Value == nil {
+			// delegate and static calls don't transfer value
+			frame.Action.Value = (*hexutil.Big)(new(big.Int))
+		}
+		if call.err == nil {
+			output := hexutil.Bytes(call.output)
+			frame.Result = &FlatCallResult{GasUsed: hexutil.Uint64(call.gasUsed), Output: &output}
+		}
+	}
+
+	if call.err != nil {
+		frame.Error = callError(call.err)
+	}
+
+	frames = append(frames, frame)
+	for i, subcall := range call.calls {
+		subAddress := make([]int, len(traceAddress)+1)
+		copy(subAddress, traceAddress)
+		subAddress[len(traceAddress)] = i
+		frames = flatten(subcall, subAddress, frames)
+	}
+	return frames
+}
+
+// callError returns the Parity error message of a failed call.
+func callError(err error) string {
+	switch {
+	case errors.Is(err, vm.ErrExecutionReverted):
+		return "Reverted"
+	case errors.Is(err, vm.ErrOutOfGas):
+		return "Out of gas"
+	case errors.Is(err, vm.ErrInsufficientBalance):
+		return "Insufficient balance"
+	default:
+		return err.Error()
+	}
+}
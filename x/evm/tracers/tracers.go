@@ -0,0 +1,50 @@
+// Package tracers implements the native tracers of the EVM module, which are selected by name in the trace
+// configurations, next to the go-ethereum tracers.
+//
+// The go-ethereum native tracers (callTracer, 4byteTracer, noopTracer...) and the JavaScript tracers are
+// constructed without configuration. The tracers of this package take the JSON configuration of the trace
+// request (see TraceConfig.TracerJsonConfig), and replace the go-ethereum tracers of the same name, e.g. the
+// prestateTracer with its diff mode, or add new ones, e.g. the flatCallTracer of the Parity trace format.
+// Applications register their own native tracers with Register.
+package tracers
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+// Constructor creates a native tracer from the JSON configuration of the trace request, which is empty
+// when the request has none.
+type Constructor func(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error)
+
+var (
+	mu           sync.RWMutex
+	constructors = make(map[string]Constructor)
+)
+
+// Register registers a native tracer under the given name. The name can't be registered twice.
+func Register(name string, constructor Constructor) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, ok := constructors[name]; ok {
+		panic(fmt.Sprintf("tracer %s already registered", name))
+	}
+	constructors[name] = constructor
+}
+
+// New returns the tracer of the given name, a native tracer of this package or a go-ethereum tracer, which
+// is a native tracer or JavaScript code. The go-ethereum tracers ignore the configuration.
+func New(name string, ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	mu.RLock()
+	constructor, ok := constructors[name]
+	mu.RUnlock()
+
+	if ok {
+		return constructor(ctx, cfg)
+	}
+	return tracers.New(name, ctx)
+}
@@ -14,4 +14,6 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// StateOverrides are applied to the state of a call before its execution
+	StateOverrides StateOverride
 }
@@ -37,6 +37,9 @@ type Params struct {
 	ExtraEIPs []int64 `protobuf:"varint,4,rep,packed,name=extra_eips,json=extraEips,proto3" json:"extra_eips,omitempty" yaml:"extra_eips"`
 	// chain config defines the EVM chain configuration parameters
 	ChainConfig ChainConfig `protobuf:"bytes,5,opt,name=chain_config,json=chainConfig,proto3" json:"chain_config" yaml:"chain_config"`
+	// active precompiles defines the hex addresses of the stateful precompiled
+	// contracts callable by the EVM
+	ActivePrecompiles []string `protobuf:"bytes,6,rep,name=active_precompiles,json=activePrecompiles,proto3" json:"active_precompiles,omitempty" yaml:"active_precompiles"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -107,6 +110,13 @@ func (m *Params) GetChainConfig() ChainConfig {
 	return ChainConfig{}
 }
 
+func (m *Params) GetActivePrecompiles() []string {
+	if m != nil {
+		return m.ActivePrecompiles
+	}
+	return nil
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -538,6 +548,9 @@ type TraceConfig struct {
 	EnableMemory bool `protobuf:"varint,11,opt,name=enable_memory,json=enableMemory,proto3" json:"enableMemory"`
 	// enable return data capture
 	EnableReturnData bool `protobuf:"varint,12,opt,name=enable_return_data,json=enableReturnData,proto3" json:"enableReturnData"`
+	// JSON configuration of the native tracer, e.g. `{"diffMode": true}` for the
+	// prestateTracer
+	TracerJsonConfig string `protobuf:"bytes,13,opt,name=tracer_json_config,json=tracerJsonConfig,proto3" json:"tracerJsonConfig"`
 }
 
 func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
@@ -643,6 +656,13 @@ func (m *TraceConfig) GetEnableReturnData() bool {
 	return false
 }
 
+func (m *TraceConfig) GetTracerJsonConfig() string {
+	if m != nil {
+		return m.TracerJsonConfig
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
@@ -657,100 +677,103 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xdf, 0x6e, 0xdb, 0xb6,
-	0x1a, 0x4f, 0x62, 0x27, 0x91, 0x69, 0xc7, 0x56, 0x98, 0x34, 0xc7, 0x4d, 0x71, 0xa2, 0x1c, 0x5d,
-	0x1c, 0xe4, 0x00, 0x6d, 0xdc, 0xa4, 0x08, 0x4e, 0xd1, 0x62, 0x17, 0x51, 0x92, 0xb6, 0xc9, 0xba,
-	0x2d, 0x60, 0x32, 0x0c, 0x18, 0x30, 0x08, 0xb4, 0xc4, 0xca, 0x5a, 0x24, 0xd1, 0x20, 0x29, 0xd7,
-	0x1e, 0xf6, 0x00, 0x03, 0x76, 0xb3, 0x47, 0xd8, 0x2b, 0xec, 0x2d, 0x8a, 0x5d, 0xf5, 0x66, 0xc0,
-	0xb0, 0x0b, 0xa1, 0x48, 0xef, 0x72, 0xe9, 0x27, 0x18, 0x44, 0xd2, 0x7f, 0x13, 0x6c, 0x4b, 0xae,
-	0xcc, 0xdf, 0xf7, 0xe7, 0xf7, 0x23, 0x3f, 0x7e, 0x14, 0x69, 0xb0, 0x4e, 0x44, 0x8b, 0xb0, 0x38,
-	0x4c, 0x44, 0x83, 0x74, 0xe2, 0x46, 0x67, 0x27, 0xff, 0xd9, 0x6e, 0x33, 0x2a, 0x28, 0x34, 0x87,
-	0xbe, 0xed, 0xdc, 0xd8, 0xd9, 0x59, 0x5f, 0x0d, 0x68, 0x40, 0xa5, 0xb3, 0x91, 0x8f, 0x54, 0x9c,
-	0xfd, 0xdb, 0x1c, 0x58, 0x38, 0xc5, 0x0c, 0xc7, 0x1c, 0xee, 0x80, 0x12, 0xe9, 0xc4, 0xae, 0x4f,
-	0x12, 0x1a, 0xd7, 0x67, 0x37, 0x67, 0xb7, 0x4a, 0xce, 0x6a, 0x3f, 0xb3, 0xcc, 0x1e, 0x8e, 0xa3,
-	0x67, 0xf6, 0xd0, 0x65, 0x23, 0x83, 0x74, 0xe2, 0xc3, 0x7c, 0x08, 0x3f, 0x01, 0x4b, 0x24, 0xc1,
-	0xcd, 0x88, 0xb8, 0x1e, 0x23, 0x58, 0x90, 0xfa, 0xdc, 0xe6, 0xec, 0x96, 0xe1, 0xd4, 0xfb, 0x99,
-	0xb5, 0xaa, 0xd3, 0xc6, 0xdd, 0x36, 0xaa, 0x28, 0x7c, 0x20, 0x21, 0xfc, 0x3f, 0x28, 0x0f, 0xfc,
-	0x38, 0x8a, 0xea, 0x05, 0x99, 0xbc, 0xd6, 0xcf, 0x2c, 0x38, 0x99, 0x8c, 0xa3, 0xc8, 0x46, 0x40,
-	0xa7, 0xe2, 0x28, 0x82, 0xfb, 0x00, 0x90, 0xae, 0x60, 0xd8, 0x25, 0x61, 0x9b, 0xd7, 0x8b, 0x9b,
-	0x85, 0xad, 0x82, 0x63, 0x5f, 0x66, 0x56, 0xe9, 0x28, 0xb7, 0x1e, 0x1d, 0x9f, 0xf2, 0x7e, 0x66,
-	0x2d, 0x6b, 0x92, 0x61, 0xa0, 0x8d, 0x4a, 0x12, 0x1c, 0x85, 0x6d, 0x0e, 0xbf, 0x01, 0x15, 0xaf,
-	0x85, 0xc3, 0xc4, 0xf5, 0x68, 0xf2, 0x26, 0x0c, 0xea, 0xf3, 0x9b, 0xb3, 0x5b, 0xe5, 0xdd, 0x7f,
-	0x6f, 0x4f, 0xd7, 0x6d, 0xfb, 0x20, 0x8f, 0x3a, 0x90, 0x41, 0xce, 0x83, 0x77, 0x99, 0x35, 0xd3,
-	0xcf, 0xac, 0x15, 0x45, 0x3d, 0x4e, 0x60, 0xa3, 0xb2, 0x37, 0x8a, 0xb4, 0x7f, 0xa9, 0x82, 0xf2,
-	0x58, 0x26, 0x8c, 0x41, 0xad, 0x45, 0x63, 0xc2, 0x05, 0xc1, 0xbe, 0xdb, 0x8c, 0xa8, 0x77, 0xa1,
-	0x4b, 0x7c, 0xf8, 0x47, 0x66, 0xfd, 0x37, 0x08, 0x45, 0x2b, 0x6d, 0x6e, 0x7b, 0x34, 0x6e, 0x78,
-	0x94, 0xc7, 0x94, 0xeb, 0x9f, 0x47, 0xdc, 0xbf, 0x68, 0x88, 0x5e, 0x9b, 0xf0, 0xed, 0xe3, 0x44,
-	0xf4, 0x33, 0x6b, 0x4d, 0x09, 0x4f, 0x51, 0xd9, 0xa8, 0x3a, 0xb4, 0x38, 0xb9, 0x01, 0xf6, 0x40,
-	0xd5, 0xc7, 0xd4, 0x7d, 0x43, 0xd9, 0x85, 0x56, 0x9b, 0x93, 0x6a, 0x67, 0xff, 0x5c, 0xed, 0x32,
-	0xb3, 0x2a, 0x87, 0xfb, 0x5f, 0xbc, 0xa0, 0xec, 0x42, 0x72, 0xf6, 0x33, 0xeb, 0x9e, 0x52, 0x9f,
-	0x64, 0xb6, 0x51, 0xc5, 0xc7, 0x74, 0x18, 0x06, 0xbf, 0x02, 0xe6, 0x30, 0x80, 0xa7, 0xed, 0x36,
-	0x65, 0x42, 0xef, 0xec, 0xa3, 0xcb, 0xcc, 0xaa, 0x6a, 0xca, 0x33, 0xe5, 0xe9, 0x67, 0xd6, 0xbf,
-	0xa6, 0x48, 0x75, 0x8e, 0x8d, 0xaa, 0x9a, 0x56, 0x87, 0x42, 0x0e, 0x2a, 0x24, 0x6c, 0xef, 0xec,
-	0x3d, 0xd6, 0x2b, 0x2a, 0xca, 0x15, 0x9d, 0xde, 0x6a, 0x45, 0xe5, 0xa3, 0xe3, 0xd3, 0x9d, 0xbd,
-	0xc7, 0x83, 0x05, 0xe9, 0x7d, 0x1c, 0xa7, 0xb5, 0x51, 0x59, 0x41, 0xb5, 0x9a, 0x63, 0xa0, 0xa1,
-	0xdb, 0xc2, 0xbc, 0x25, 0xbb, 0xa4, 0xe4, 0x6c, 0x5d, 0x66, 0x16, 0x50, 0x4c, 0xaf, 0x30, 0x6f,
-	0x8d, 0xf6, 0xa5, 0xd9, 0xfb, 0x0e, 0x27, 0x22, 0x4c, 0xe3, 0x01, 0x17, 0x50, 0xc9, 0x79, 0xd4,
-	0x70, 0xfe, 0x7b, 0x7a, 0xfe, 0x0b, 0x77, 0x9e, 0xff, 0xde, 0x4d, 0xf3, 0xdf, 0x9b, 0x9c, 0xbf,
-	0x8a, 0x19, 0x8a, 0x3e, 0xd5, 0xa2, 0x8b, 0x77, 0x16, 0x7d, 0x7a, 0x93, 0xe8, 0xd3, 0x49, 0x51,
-	0x15, 0x93, 0x37, 0xfb, 0x54, 0x25, 0xea, 0xc6, 0xdd, 0x9b, 0xfd, 0x5a, 0x51, 0xab, 0x43, 0x8b,
-	0x92, 0xfb, 0x1e, 0xac, 0x7a, 0x34, 0xe1, 0x22, 0xb7, 0x25, 0xb4, 0x1d, 0x11, 0xad, 0x59, 0x92,
-	0x9a, 0xc7, 0xb7, 0xd2, 0x7c, 0xa0, 0x4f, 0xf6, 0x0d, 0x7c, 0x36, 0x5a, 0x99, 0x34, 0x2b, 0xf5,
-	0x36, 0x30, 0xdb, 0x44, 0x10, 0xc6, 0x9b, 0x29, 0x0b, 0xb4, 0x32, 0x90, 0xca, 0x47, 0xb7, 0x52,
-	0xd6, 0xe7, 0x60, 0x9a, 0xcb, 0x46, 0xb5, 0x91, 0x49, 0x29, 0x7e, 0x0b, 0xaa, 0x61, 0x3e, 0x8d,
-	0x66, 0x1a, 0x69, 0xbd, 0xb2, 0xd4, 0x3b, 0xb8, 0x95, 0x9e, 0x3e, 0xcc, 0x93, 0x4c, 0x36, 0x5a,
-	0x1a, 0x18, 0x94, 0x56, 0x0a, 0x60, 0x9c, 0x86, 0xcc, 0x0d, 0x22, 0xec, 0x85, 0x84, 0x69, 0xbd,
-	0x8a, 0xd4, 0x7b, 0x79, 0x2b, 0xbd, 0xfb, 0x4a, 0xef, 0x3a, 0x9b, 0x8d, 0xcc, 0xdc, 0xf8, 0x52,
-	0xd9, 0x94, 0xac, 0x0f, 0x2a, 0x4d, 0xc2, 0xa2, 0x30, 0xd1, 0x82, 0x4b, 0x52, 0x70, 0xff, 0x56,
-	0x82, 0xba, 0x4f, 0xc7, 0x79, 0x6c, 0x54, 0x56, 0x70, 0xa8, 0x12, 0xd1, 0xc4, 0xa7, 0x03, 0x95,
-	0xe5, 0xbb, 0xab, 0x8c, 0xf3, 0xd8, 0xa8, 0xac, 0xa0, 0x52, 0xe9, 0x82, 0x15, 0xcc, 0x18, 0x7d,
-	0x3b, 0x55, 0x43, 0x28, 0xc5, 0x5e, 0xdd, 0x4a, 0x6c, 0x5d, 0x89, 0xdd, 0x40, 0x67, 0xa3, 0x65,
-	0x69, 0x9d, 0xa8, 0x22, 0x05, 0x66, 0x4c, 0x58, 0x40, 0xc6, 0xef, 0x81, 0x95, 0xbb, 0xb7, 0xe6,
-	0x34, 0x97, 0x8d, 0xaa, 0xd2, 0x34, 0xfc, 0xf6, 0x9f, 0x14, 0x8d, 0xaa, 0x59, 0x3b, 0x29, 0x1a,
-	0x35, 0xd3, 0x3c, 0x29, 0x1a, 0xa6, 0xb9, 0x8c, 0x96, 0x7a, 0x34, 0xa2, 0x6e, 0xe7, 0x89, 0xca,
-	0x40, 0x65, 0xf2, 0x16, 0x73, 0x7d, 0x90, 0x51, 0xd5, 0xc3, 0x02, 0x47, 0x3d, 0x2e, 0x34, 0x5d,
-	0x03, 0xcc, 0x9f, 0x89, 0xfc, 0x5d, 0x60, 0x82, 0xc2, 0x05, 0xe9, 0xa9, 0x0b, 0x12, 0xe5, 0x43,
-	0xb8, 0x0a, 0xe6, 0x3b, 0x38, 0x4a, 0xd5, 0x03, 0xa3, 0x84, 0x14, 0xb0, 0x4f, 0x41, 0xed, 0x9c,
-	0xe1, 0x84, 0x63, 0x4f, 0x84, 0x34, 0x79, 0x4d, 0x03, 0x0e, 0x21, 0x28, 0xca, 0x0f, 0xb5, 0xca,
-	0x95, 0x63, 0xf8, 0x3f, 0x50, 0x8c, 0x68, 0xc0, 0xeb, 0x73, 0x9b, 0x85, 0xad, 0xf2, 0xee, 0xbd,
-	0xeb, 0x57, 0xfc, 0x6b, 0x1a, 0x20, 0x19, 0x62, 0xff, 0x3a, 0x07, 0x0a, 0xaf, 0x69, 0x00, 0xeb,
-	0x60, 0x11, 0xfb, 0x3e, 0x23, 0x9c, 0x6b, 0xa6, 0x01, 0x84, 0x6b, 0x60, 0x41, 0xd0, 0x76, 0xe8,
-	0x29, 0xba, 0x12, 0xd2, 0x28, 0x17, 0xf6, 0xb1, 0xc0, 0xf2, 0xaa, 0xab, 0x20, 0x39, 0x86, 0xbb,
-	0xa0, 0x22, 0x57, 0xe6, 0x26, 0x69, 0xdc, 0x24, 0x4c, 0xde, 0x58, 0x45, 0xa7, 0x76, 0x95, 0x59,
-	0x65, 0x69, 0xff, 0x5c, 0x9a, 0xd1, 0x38, 0x80, 0x0f, 0xc1, 0xa2, 0xe8, 0x8e, 0x5f, 0x36, 0x2b,
-	0x57, 0x99, 0x55, 0x13, 0xa3, 0x65, 0xe6, 0x77, 0x09, 0x5a, 0x10, 0x5d, 0x79, 0xa7, 0x34, 0x80,
-	0x21, 0xba, 0x6e, 0x98, 0xf8, 0xa4, 0x2b, 0xef, 0x93, 0xa2, 0xb3, 0x7a, 0x95, 0x59, 0xe6, 0x58,
-	0xf8, 0x71, 0xee, 0x43, 0x8b, 0xa2, 0x2b, 0x07, 0xf0, 0x21, 0x00, 0x6a, 0x4a, 0x52, 0x41, 0xdd,
-	0x06, 0x4b, 0x57, 0x99, 0x55, 0x92, 0x56, 0xc9, 0x3d, 0x1a, 0x42, 0x1b, 0xcc, 0x2b, 0x6e, 0x43,
-	0x72, 0x57, 0xae, 0x32, 0xcb, 0x88, 0x68, 0xa0, 0x38, 0x95, 0x2b, 0x2f, 0x15, 0x23, 0x31, 0xed,
-	0x10, 0x5f, 0x7e, 0x70, 0x0d, 0x34, 0x80, 0xf6, 0x8f, 0x73, 0xc0, 0x38, 0xef, 0x22, 0xc2, 0xd3,
-	0x48, 0xc0, 0x17, 0xc0, 0xf4, 0x68, 0x22, 0x18, 0xf6, 0x84, 0x3b, 0x51, 0x5a, 0xe7, 0xc1, 0xa8,
-	0xc3, 0xa6, 0x23, 0x6c, 0x54, 0x1b, 0x98, 0xf6, 0x75, 0xfd, 0x57, 0xc1, 0x7c, 0x33, 0xa2, 0x34,
-	0x96, 0x9d, 0x50, 0x41, 0x0a, 0x40, 0x24, 0xab, 0x26, 0x77, 0xb9, 0x20, 0x1f, 0x72, 0xff, 0xb9,
-	0xbe, 0xcb, 0x53, 0xad, 0xe2, 0xac, 0xe9, 0xc7, 0x5c, 0x55, 0x69, 0xeb, 0x7c, 0x3b, 0xaf, 0xad,
-	0x6c, 0x25, 0x13, 0x14, 0x18, 0x11, 0x72, 0xd3, 0x2a, 0x28, 0x1f, 0xc2, 0x75, 0x60, 0x30, 0xd2,
-	0x21, 0x4c, 0x10, 0x5f, 0x6e, 0x8e, 0x81, 0x86, 0x18, 0xde, 0x07, 0x46, 0x80, 0xb9, 0x9b, 0x72,
-	0xe2, 0xab, 0x9d, 0x40, 0x8b, 0x01, 0xe6, 0x5f, 0x72, 0xe2, 0x3f, 0x2b, 0xfe, 0xf0, 0xb3, 0x35,
-	0x63, 0x63, 0x50, 0xde, 0xf7, 0x3c, 0xc2, 0xf9, 0x79, 0xda, 0x8e, 0xc8, 0x5f, 0x74, 0xd8, 0x2e,
-	0xa8, 0x70, 0x41, 0x19, 0x0e, 0x88, 0x7b, 0x41, 0x7a, 0xba, 0xcf, 0x54, 0xd7, 0x68, 0xfb, 0xa7,
-	0xa4, 0xc7, 0xd1, 0x38, 0xd0, 0x12, 0x1f, 0x0a, 0xa0, 0x7c, 0xce, 0xb0, 0x47, 0xf4, 0xa3, 0x33,
-	0xef, 0xd5, 0x1c, 0x32, 0x2d, 0xa1, 0x51, 0xae, 0x2d, 0xc2, 0x98, 0xd0, 0x54, 0xe8, 0xf3, 0x34,
-	0x80, 0x79, 0x06, 0x23, 0xa4, 0x4b, 0x3c, 0x59, 0xc6, 0x22, 0xd2, 0x08, 0xee, 0x81, 0x25, 0x3f,
-	0xe4, 0xf2, 0x35, 0xce, 0x05, 0xf6, 0x2e, 0xd4, 0xf2, 0x1d, 0xf3, 0x2a, 0xb3, 0x2a, 0xda, 0x71,
-	0x96, 0xdb, 0xd1, 0x04, 0x82, 0xcf, 0x41, 0x6d, 0x94, 0x26, 0x67, 0x2b, 0x6b, 0x63, 0x38, 0xf0,
-	0x2a, 0xb3, 0xaa, 0xc3, 0x50, 0xe9, 0x41, 0x53, 0x38, 0xdf, 0x69, 0x9f, 0x34, 0xd3, 0x40, 0x36,
-	0x9f, 0x81, 0x14, 0xc8, 0xad, 0x51, 0x18, 0x87, 0x42, 0x36, 0xdb, 0x3c, 0x52, 0x00, 0x3e, 0x07,
-	0x25, 0xda, 0x21, 0x8c, 0x85, 0x3e, 0xe1, 0xf2, 0xf6, 0xfd, 0xbb, 0xa7, 0x3c, 0x1a, 0xc5, 0xe7,
-	0x8b, 0xd3, 0xff, 0x34, 0x62, 0x12, 0x53, 0xd6, 0x93, 0xd7, 0xa9, 0x5e, 0x9c, 0x72, 0x7c, 0x26,
-	0xed, 0x68, 0x02, 0x41, 0x07, 0x40, 0x9d, 0xc6, 0x88, 0x48, 0x59, 0xe2, 0xca, 0xf3, 0x5f, 0x91,
-	0xb9, 0xf2, 0x14, 0x2a, 0x2f, 0x92, 0xce, 0x43, 0x2c, 0x30, 0xba, 0x66, 0x39, 0x29, 0x1a, 0x45,
-	0x73, 0xfe, 0xa4, 0x68, 0x2c, 0x9a, 0xc6, 0x70, 0xfd, 0x7a, 0x16, 0x68, 0x65, 0x80, 0xc7, 0xe8,
-	0x1d, 0xe7, 0xdd, 0xe5, 0xc6, 0xec, 0xfb, 0xcb, 0x8d, 0xd9, 0x0f, 0x97, 0x1b, 0xb3, 0x3f, 0x7d,
-	0xdc, 0x98, 0x79, 0xff, 0x71, 0x63, 0xe6, 0xf7, 0x8f, 0x1b, 0x33, 0x5f, 0x6f, 0x8d, 0x7d, 0xce,
-	0x45, 0x0b, 0x33, 0x1e, 0xf2, 0xc6, 0xe8, 0x0f, 0x62, 0x57, 0xfe, 0x45, 0x94, 0x1f, 0xf5, 0xe6,
-	0x82, 0xfc, 0xeb, 0xf7, 0xe4, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x69, 0xef, 0x82, 0x39, 0x40,
-	0x0e, 0x00, 0x00,
+	// 1531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x4e, 0x23, 0xcb,
+	0x15, 0x06, 0x6c, 0xa0, 0x5d, 0x36, 0x76, 0x53, 0x70, 0x89, 0x2f, 0xa3, 0x4b, 0x93, 0x5e, 0x44,
+	0x8e, 0x74, 0x2f, 0xbe, 0x70, 0x85, 0x32, 0x9a, 0x51, 0x16, 0x34, 0x30, 0x33, 0x10, 0x92, 0xa0,
+	0x82, 0x28, 0x52, 0xa4, 0xa8, 0x55, 0xee, 0xae, 0x69, 0xf7, 0xd0, 0xdd, 0x65, 0x55, 0x95, 0x3d,
+	0x76, 0x94, 0x07, 0x88, 0x94, 0x4d, 0x1e, 0x21, 0x52, 0xf2, 0x02, 0x79, 0x8b, 0x51, 0x56, 0xb3,
+	0x8c, 0xb2, 0x68, 0x45, 0xcc, 0x8e, 0xa5, 0x9f, 0x20, 0xaa, 0x1f, 0xff, 0x8f, 0xae, 0x06, 0x56,
+	0x5d, 0xe7, 0xef, 0xfb, 0xaa, 0x4e, 0x9d, 0xea, 0x53, 0x05, 0x76, 0x89, 0x68, 0x13, 0x96, 0xc6,
+	0x99, 0x68, 0x92, 0x5e, 0xda, 0xec, 0x1d, 0xca, 0xcf, 0x41, 0x87, 0x51, 0x41, 0xa1, 0x3d, 0xb6,
+	0x1d, 0x48, 0x65, 0xef, 0x70, 0x77, 0x3b, 0xa2, 0x11, 0x55, 0xc6, 0xa6, 0x1c, 0x69, 0x3f, 0xf7,
+	0x1f, 0x05, 0xb0, 0x76, 0x8d, 0x19, 0x4e, 0x39, 0x3c, 0x04, 0x25, 0xd2, 0x4b, 0xfd, 0x90, 0x64,
+	0x34, 0xad, 0x2f, 0xef, 0x2f, 0x37, 0x4a, 0xde, 0xf6, 0x30, 0x77, 0xec, 0x01, 0x4e, 0x93, 0x17,
+	0xee, 0xd8, 0xe4, 0x22, 0x8b, 0xf4, 0xd2, 0x33, 0x39, 0x84, 0xbf, 0x04, 0x1b, 0x24, 0xc3, 0xad,
+	0x84, 0xf8, 0x01, 0x23, 0x58, 0x90, 0xfa, 0xca, 0xfe, 0x72, 0xc3, 0xf2, 0xea, 0xc3, 0xdc, 0xd9,
+	0x36, 0x61, 0xd3, 0x66, 0x17, 0x55, 0xb4, 0x7c, 0xaa, 0x44, 0xf8, 0x0b, 0x50, 0x1e, 0xd9, 0x71,
+	0x92, 0xd4, 0x0b, 0x2a, 0x78, 0x67, 0x98, 0x3b, 0x70, 0x36, 0x18, 0x27, 0x89, 0x8b, 0x80, 0x09,
+	0xc5, 0x49, 0x02, 0x4f, 0x00, 0x20, 0x7d, 0xc1, 0xb0, 0x4f, 0xe2, 0x0e, 0xaf, 0x17, 0xf7, 0x0b,
+	0x8d, 0x82, 0xe7, 0xde, 0xe7, 0x4e, 0xe9, 0x5c, 0x6a, 0xcf, 0x2f, 0xae, 0xf9, 0x30, 0x77, 0x36,
+	0x0d, 0xc8, 0xd8, 0xd1, 0x45, 0x25, 0x25, 0x9c, 0xc7, 0x1d, 0x0e, 0xff, 0x08, 0x2a, 0x41, 0x1b,
+	0xc7, 0x99, 0x1f, 0xd0, 0xec, 0x6d, 0x1c, 0xd5, 0x57, 0xf7, 0x97, 0x1b, 0xe5, 0xa3, 0x6f, 0x0e,
+	0xe6, 0xf3, 0x76, 0x70, 0x2a, 0xbd, 0x4e, 0x95, 0x93, 0xf7, 0xec, 0x43, 0xee, 0x2c, 0x0d, 0x73,
+	0x67, 0x4b, 0x43, 0x4f, 0x03, 0xb8, 0xa8, 0x1c, 0x4c, 0x3c, 0xe1, 0x15, 0x80, 0x38, 0x10, 0x71,
+	0x8f, 0xf8, 0x1d, 0x46, 0x02, 0x9a, 0x76, 0xe2, 0x84, 0xf0, 0xfa, 0xda, 0x7e, 0xa1, 0x51, 0xf2,
+	0xbe, 0x19, 0xe6, 0xce, 0xd7, 0x1a, 0x61, 0xd1, 0xc7, 0x45, 0x9b, 0x5a, 0x79, 0x3d, 0xa5, 0xfb,
+	0x57, 0x15, 0x94, 0xa7, 0xe6, 0x01, 0x53, 0x50, 0x6b, 0xd3, 0x94, 0x70, 0x41, 0x70, 0xe8, 0xb7,
+	0x12, 0x1a, 0xdc, 0x99, 0x0d, 0x3b, 0xfb, 0x6f, 0xee, 0xfc, 0x2c, 0x8a, 0x45, 0xbb, 0xdb, 0x3a,
+	0x08, 0x68, 0xda, 0x0c, 0x28, 0x4f, 0x29, 0x37, 0x9f, 0xef, 0x78, 0x78, 0xd7, 0x14, 0x83, 0x0e,
+	0xe1, 0x07, 0x17, 0x99, 0x18, 0xe6, 0xce, 0x8e, 0x9e, 0xc4, 0x1c, 0x94, 0x8b, 0xaa, 0x63, 0x8d,
+	0x27, 0x15, 0x70, 0x00, 0xaa, 0x21, 0xa6, 0xfe, 0x5b, 0xca, 0xee, 0x0c, 0xdb, 0x8a, 0x62, 0xbb,
+	0xf9, 0x72, 0xb6, 0xfb, 0xdc, 0xa9, 0x9c, 0x9d, 0xfc, 0xf6, 0x15, 0x65, 0x77, 0x0a, 0x73, 0x98,
+	0x3b, 0x5f, 0x69, 0xf6, 0x59, 0x64, 0x17, 0x55, 0x42, 0x4c, 0xc7, 0x6e, 0xf0, 0xf7, 0xc0, 0x1e,
+	0x3b, 0xf0, 0x6e, 0xa7, 0x43, 0x99, 0x30, 0x75, 0xf2, 0xdd, 0x7d, 0xee, 0x54, 0x0d, 0xe4, 0x8d,
+	0xb6, 0x0c, 0x73, 0xe7, 0x27, 0x73, 0xa0, 0x26, 0xc6, 0x45, 0x55, 0x03, 0x6b, 0x5c, 0x21, 0x07,
+	0x15, 0x12, 0x77, 0x0e, 0x8f, 0xbf, 0x37, 0x2b, 0x2a, 0xaa, 0x15, 0x5d, 0x3f, 0x6a, 0x45, 0xe5,
+	0xf3, 0x8b, 0xeb, 0xc3, 0xe3, 0xef, 0x47, 0x0b, 0x32, 0x55, 0x31, 0x0d, 0xeb, 0xa2, 0xb2, 0x16,
+	0xf5, 0x6a, 0x2e, 0x80, 0x11, 0xfd, 0x36, 0xe6, 0x6d, 0x55, 0x73, 0x25, 0xaf, 0x71, 0x9f, 0x3b,
+	0x40, 0x23, 0xbd, 0xc1, 0xbc, 0x3d, 0xd9, 0x97, 0xd6, 0xe0, 0x4f, 0x38, 0x13, 0x71, 0x37, 0x1d,
+	0x61, 0x01, 0x1d, 0x2c, 0xbd, 0xc6, 0xf3, 0x3f, 0x36, 0xf3, 0x5f, 0x7b, 0xf2, 0xfc, 0x8f, 0x3f,
+	0x37, 0xff, 0xe3, 0xd9, 0xf9, 0x6b, 0x9f, 0x31, 0xe9, 0x73, 0x43, 0xba, 0xfe, 0x64, 0xd2, 0xe7,
+	0x9f, 0x23, 0x7d, 0x3e, 0x4b, 0xaa, 0x7d, 0x64, 0xb1, 0xcf, 0x65, 0xa2, 0x6e, 0x3d, 0xbd, 0xd8,
+	0x17, 0x92, 0x5a, 0x1d, 0x6b, 0x34, 0xdd, 0x9f, 0xc1, 0x76, 0x40, 0x33, 0x2e, 0xa4, 0x2e, 0xa3,
+	0x9d, 0x84, 0x18, 0xce, 0x92, 0xe2, 0xbc, 0x78, 0x14, 0xe7, 0x33, 0xf3, 0x9f, 0xf8, 0x0c, 0x9e,
+	0x8b, 0xb6, 0x66, 0xd5, 0x9a, 0xbd, 0x03, 0xec, 0x0e, 0x11, 0x84, 0xf1, 0x56, 0x97, 0x45, 0x86,
+	0x19, 0x28, 0xe6, 0xf3, 0x47, 0x31, 0x9b, 0x73, 0x30, 0x8f, 0xe5, 0xa2, 0xda, 0x44, 0xa5, 0x19,
+	0xdf, 0x81, 0x6a, 0x2c, 0xa7, 0xd1, 0xea, 0x26, 0x86, 0xaf, 0xac, 0xf8, 0x4e, 0x1f, 0xc5, 0x67,
+	0x0e, 0xf3, 0x2c, 0x92, 0x8b, 0x36, 0x46, 0x0a, 0xcd, 0xd5, 0x05, 0x30, 0xed, 0xc6, 0xcc, 0x8f,
+	0x12, 0x1c, 0xc4, 0x84, 0x19, 0xbe, 0x8a, 0xe2, 0x7b, 0xfd, 0x28, 0x3e, 0xf3, 0xff, 0x5c, 0x44,
+	0x73, 0x91, 0x2d, 0x95, 0xaf, 0xb5, 0x4e, 0xd3, 0x86, 0xa0, 0xd2, 0x22, 0x2c, 0x89, 0x33, 0x43,
+	0xb8, 0xa1, 0x08, 0x4f, 0x1e, 0x45, 0x68, 0xea, 0x74, 0x1a, 0xc7, 0x45, 0x65, 0x2d, 0x8e, 0x59,
+	0x12, 0x9a, 0x85, 0x74, 0xc4, 0xb2, 0xf9, 0x74, 0x96, 0x69, 0x1c, 0x17, 0x95, 0xb5, 0xa8, 0x59,
+	0xfa, 0x60, 0x0b, 0x33, 0x46, 0xdf, 0xcf, 0xe5, 0x10, 0x2a, 0xb2, 0x37, 0x8f, 0x22, 0xdb, 0x35,
+	0x3d, 0x68, 0x11, 0x4e, 0x36, 0x21, 0xa9, 0x9d, 0xc9, 0x22, 0x05, 0x76, 0x4a, 0x58, 0x44, 0xa6,
+	0xfb, 0xc0, 0xd6, 0xd3, 0x4b, 0x73, 0x1e, 0xcb, 0x45, 0x55, 0xa5, 0x1a, 0xff, 0xfb, 0x2f, 0x8b,
+	0x56, 0xd5, 0xae, 0x5d, 0x16, 0xad, 0x9a, 0x6d, 0x5f, 0x16, 0x2d, 0xdb, 0xde, 0x44, 0x1b, 0x03,
+	0x9a, 0x50, 0xbf, 0xf7, 0x83, 0x8e, 0x40, 0x65, 0xf2, 0x1e, 0x73, 0x73, 0x90, 0x51, 0x35, 0xc0,
+	0x02, 0x27, 0x03, 0x2e, 0x0c, 0x5c, 0x13, 0xac, 0xde, 0x08, 0x79, 0xcb, 0xb0, 0x41, 0xe1, 0x8e,
+	0x0c, 0x74, 0x83, 0x44, 0x72, 0x08, 0xb7, 0xc1, 0x6a, 0x0f, 0x27, 0x5d, 0x7d, 0x5d, 0x29, 0x21,
+	0x2d, 0xb8, 0xd7, 0xa0, 0x76, 0xcb, 0x70, 0xc6, 0x65, 0xfb, 0xa5, 0xd9, 0x15, 0x8d, 0x38, 0x84,
+	0xa0, 0xa8, 0x7e, 0xd4, 0x3a, 0x56, 0x8d, 0xe1, 0xcf, 0x41, 0x31, 0xa1, 0x11, 0xaf, 0xaf, 0xec,
+	0x17, 0x1a, 0xe5, 0xa3, 0xaf, 0x16, 0x2f, 0x0c, 0x57, 0x34, 0x42, 0xca, 0xc5, 0xfd, 0xf7, 0x0a,
+	0x28, 0x5c, 0xd1, 0x08, 0xd6, 0xc1, 0x3a, 0x0e, 0x43, 0x46, 0x38, 0x37, 0x48, 0x23, 0x11, 0xee,
+	0x80, 0x35, 0x41, 0x3b, 0x71, 0xa0, 0xe1, 0x4a, 0xc8, 0x48, 0x92, 0x38, 0xc4, 0x02, 0xab, 0x56,
+	0x57, 0x41, 0x6a, 0x0c, 0x8f, 0x40, 0x45, 0xad, 0xcc, 0xcf, 0xba, 0x69, 0x8b, 0x30, 0xd5, 0xb1,
+	0x8a, 0x5e, 0xed, 0x21, 0x77, 0xca, 0x4a, 0xff, 0x1b, 0xa5, 0x46, 0xd3, 0x02, 0xfc, 0x16, 0xac,
+	0x8b, 0xfe, 0x74, 0xb3, 0xd9, 0x7a, 0xc8, 0x9d, 0x9a, 0x98, 0x2c, 0x53, 0xf6, 0x12, 0xb4, 0x26,
+	0xfa, 0xf2, 0x0b, 0x9b, 0xc0, 0x12, 0x7d, 0x3f, 0xce, 0x42, 0xd2, 0x57, 0xfd, 0xa4, 0xe8, 0x6d,
+	0x3f, 0xe4, 0x8e, 0x3d, 0xe5, 0x7e, 0x21, 0x6d, 0x68, 0x5d, 0xf4, 0xd5, 0x00, 0x7e, 0x0b, 0x80,
+	0x9e, 0x92, 0x62, 0xd0, 0xdd, 0x60, 0xe3, 0x21, 0x77, 0x4a, 0x4a, 0xab, 0xb0, 0x27, 0x43, 0xe8,
+	0x82, 0x55, 0x8d, 0x6d, 0x29, 0xec, 0xca, 0x43, 0xee, 0x58, 0x09, 0x8d, 0x34, 0xa6, 0x36, 0xc9,
+	0x54, 0x31, 0x92, 0xd2, 0x1e, 0x09, 0xd5, 0x0f, 0xd7, 0x42, 0x23, 0xd1, 0xfd, 0xeb, 0x0a, 0xb0,
+	0x6e, 0xfb, 0x88, 0xf0, 0x6e, 0x22, 0xe0, 0x2b, 0x60, 0x07, 0x34, 0x13, 0x0c, 0x07, 0xc2, 0x9f,
+	0x49, 0xad, 0xf7, 0x6c, 0x52, 0x61, 0xf3, 0x1e, 0x2e, 0xaa, 0x8d, 0x54, 0x27, 0x26, 0xff, 0xdb,
+	0x60, 0xb5, 0x95, 0x50, 0x9a, 0xaa, 0x4a, 0xa8, 0x20, 0x2d, 0x40, 0xa4, 0xb2, 0xa6, 0x76, 0xb9,
+	0xa0, 0xae, 0x85, 0x3f, 0x5d, 0xdc, 0xe5, 0xb9, 0x52, 0xf1, 0x76, 0xcc, 0xd5, 0xb0, 0xaa, 0xb9,
+	0x4d, 0xbc, 0x2b, 0x73, 0xab, 0x4a, 0xc9, 0x06, 0x05, 0x46, 0x84, 0xda, 0xb4, 0x0a, 0x92, 0x43,
+	0xb8, 0x0b, 0x2c, 0x46, 0x7a, 0x84, 0x09, 0x12, 0xaa, 0xcd, 0xb1, 0xd0, 0x58, 0x86, 0x5f, 0x03,
+	0x2b, 0xc2, 0xdc, 0xef, 0x72, 0x12, 0xea, 0x9d, 0x40, 0xeb, 0x11, 0xe6, 0xbf, 0xe3, 0x24, 0x7c,
+	0x51, 0xfc, 0xcb, 0xdf, 0x9d, 0x25, 0x17, 0x83, 0xf2, 0x49, 0x10, 0x10, 0xce, 0x6f, 0xbb, 0x9d,
+	0x84, 0xfc, 0x48, 0x85, 0x1d, 0x81, 0x0a, 0x17, 0x94, 0xe1, 0x88, 0xf8, 0x77, 0x64, 0x60, 0xea,
+	0x4c, 0x57, 0x8d, 0xd1, 0xff, 0x8a, 0x0c, 0x38, 0x9a, 0x16, 0x0c, 0xc5, 0x3f, 0x8b, 0xa0, 0x7c,
+	0xcb, 0x70, 0x40, 0xcc, 0xa5, 0x53, 0xd6, 0xaa, 0x14, 0x99, 0xa1, 0x30, 0x92, 0xe4, 0x16, 0x71,
+	0x4a, 0x68, 0x57, 0x98, 0xf3, 0x34, 0x12, 0x65, 0x04, 0x23, 0xa4, 0x4f, 0x02, 0x95, 0xc6, 0x22,
+	0x32, 0x12, 0x3c, 0x06, 0x1b, 0x61, 0xcc, 0xd5, 0xdd, 0x9e, 0x0b, 0x1c, 0xdc, 0xe9, 0xe5, 0x7b,
+	0xf6, 0x43, 0xee, 0x54, 0x8c, 0xe1, 0x46, 0xea, 0xd1, 0x8c, 0x04, 0x5f, 0x82, 0xda, 0x24, 0x4c,
+	0xcd, 0x56, 0xe5, 0xc6, 0xf2, 0xe0, 0x43, 0xee, 0x54, 0xc7, 0xae, 0xca, 0x82, 0xe6, 0x64, 0xb9,
+	0xd3, 0x21, 0x69, 0x75, 0x23, 0x55, 0x7c, 0x16, 0xd2, 0x82, 0xd4, 0x26, 0x71, 0x1a, 0x0b, 0x55,
+	0x6c, 0xab, 0x48, 0x0b, 0xf0, 0x25, 0x28, 0xd1, 0x1e, 0x61, 0x2c, 0x0e, 0x09, 0xaf, 0x83, 0x2f,
+	0x78, 0x18, 0xa0, 0x89, 0xbf, 0x5c, 0x9c, 0x79, 0xb7, 0xa4, 0x24, 0xa5, 0x6c, 0x50, 0x2f, 0x4f,
+	0x16, 0xa7, 0x0d, 0xbf, 0x56, 0x7a, 0x34, 0x23, 0x41, 0x0f, 0x40, 0x13, 0xc6, 0x88, 0xe8, 0xb2,
+	0xcc, 0x57, 0xe7, 0xbf, 0xa2, 0x62, 0xd5, 0x29, 0xd4, 0x56, 0xa4, 0x8c, 0x67, 0x58, 0x60, 0xb4,
+	0xa0, 0x91, 0x18, 0x7a, 0x4f, 0xfc, 0x77, 0x9c, 0x8e, 0x5f, 0x36, 0xba, 0xdb, 0x8d, 0x4e, 0x72,
+	0x40, 0xd8, 0x25, 0xa7, 0xa3, 0x79, 0x2f, 0x68, 0x2e, 0x8b, 0x56, 0xd1, 0x5e, 0xbd, 0x2c, 0x5a,
+	0xeb, 0xb6, 0x35, 0xce, 0xa1, 0x59, 0x09, 0xda, 0x1a, 0xc9, 0x53, 0x53, 0xf4, 0xbc, 0x0f, 0xf7,
+	0x7b, 0xcb, 0x1f, 0xef, 0xf7, 0x96, 0xff, 0x77, 0xbf, 0xb7, 0xfc, 0xb7, 0x4f, 0x7b, 0x4b, 0x1f,
+	0x3f, 0xed, 0x2d, 0xfd, 0xe7, 0xd3, 0xde, 0xd2, 0x1f, 0x1a, 0x53, 0x2d, 0x41, 0xb4, 0x31, 0xe3,
+	0x31, 0x6f, 0x4e, 0x9e, 0xac, 0x7d, 0xf5, 0x68, 0x55, 0x8d, 0xa1, 0xb5, 0xa6, 0x1e, 0xa3, 0x3f,
+	0xfc, 0x7f, 0x00, 0x8e, 0xdc, 0x6a, 0x94, 0xd2, 0x0e, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -773,6 +796,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ActivePrecompiles) > 0 {
+		for iNdEx := len(m.ActivePrecompiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActivePrecompiles[iNdEx])
+			copy(dAtA[i:], m.ActivePrecompiles[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.ActivePrecompiles[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size, err := m.ChainConfig.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1340,6 +1372,13 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TracerJsonConfig) > 0 {
+		i -= len(m.TracerJsonConfig)
+		copy(dAtA[i:], m.TracerJsonConfig)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.TracerJsonConfig)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.EnableReturnData {
 		i--
 		if m.EnableReturnData {
@@ -1465,6 +1504,12 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.ChainConfig.Size()
 	n += 1 + l + sovEvm(uint64(l))
+	if len(m.ActivePrecompiles) > 0 {
+		for _, s := range m.ActivePrecompiles {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1706,6 +1751,10 @@ func (m *TraceConfig) Size() (n int) {
 	if m.EnableReturnData {
 		n += 2
 	}
+	l = len(m.TracerJsonConfig)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -1925,6 +1974,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePrecompiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivePrecompiles = append(m.ActivePrecompiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -3674,6 +3755,38 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableReturnData = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TracerJsonConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
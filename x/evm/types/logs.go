@@ -1,15 +1,36 @@
 package types
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	ethermint "github.com/tharsis/ethermint/types"
 )
 
+// NativeLogsTxIndex is the transaction index of the EVM logs of the native modules (see Keeper.EmitNativeLogs),
+// past the index of any Ethereum transaction of a block.
+const NativeLogsTxIndex = math.MaxUint32
+
+// nativeLogsTxHashPrefix is the prefix of the preimage of the native logs transaction hash.
+var nativeLogsTxHashPrefix = []byte("ethermint-native-logs")
+
+// NativeLogsTxHash returns the transaction hash of the EVM logs of the native modules in a block: the Keccak-256
+// hash of "ethermint-native-logs" followed by the big-endian block height. It isn't the hash of an Ethereum or
+// Cosmos transaction.
+func NativeLogsTxHash(height int64) common.Hash {
+	preimage := make([]byte, len(nativeLogsTxHashPrefix)+8)
+	copy(preimage, nativeLogsTxHashPrefix)
+	binary.BigEndian.PutUint64(preimage[len(nativeLogsTxHashPrefix):], uint64(height))
+
+	return crypto.Keccak256Hash(preimage)
+}
+
 // NewTransactionLogs creates a new NewTransactionLogs instance.
 func NewTransactionLogs(hash common.Hash, logs []*Log) TransactionLogs {
 	return TransactionLogs{
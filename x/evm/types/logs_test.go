@@ -8,6 +8,7 @@ import (
 	"github.com/tharsis/ethermint/tests"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 func TestTransactionLogsValidate(t *testing.T) {
@@ -158,3 +159,9 @@ func TestValidateLog(t *testing.T) {
 		}
 	}
 }
+
+func TestNativeLogsTxHash(t *testing.T) {
+	preimage := append([]byte("ethermint-native-logs"), 0, 0, 0, 0, 0, 0, 0x01, 0x02)
+	require.Equal(t, common.BytesToHash(crypto.Keccak256(preimage)), NativeLogsTxHash(0x0102))
+	require.NotEqual(t, NativeLogsTxHash(1), NativeLogsTxHash(2))
+}
@@ -315,12 +315,23 @@ func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
 
 // BuildTx builds the canonical cosmos tx from ethereum msg
 func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.Tx, error) {
+	return msg.BuildTxWithFeePayer(b, evmDenom, nil)
+}
+
+// BuildTxWithFeePayer builds the canonical cosmos tx from ethereum msg, with the fees paid by the fee payer
+// if it's not empty. The fee payer must have granted a fee allowance to the sender.
+func (msg *MsgEthereumTx) BuildTxWithFeePayer(b client.TxBuilder, evmDenom string, feePayer sdk.AccAddress) (signing.Tx, error) {
 	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
 	if !ok {
 		return nil, errors.New("unsupported builder")
 	}
 
-	option, err := codectypes.NewAnyWithValue(&ExtensionOptionsEthereumTx{})
+	extOption := &ExtensionOptionsEthereumTx{}
+	if !feePayer.Empty() {
+		extOption.FeePayer = feePayer.String()
+	}
+
+	option, err := codectypes.NewAnyWithValue(extOption)
 	if err != nil {
 		return nil, err
 	}
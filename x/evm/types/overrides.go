@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Validate checks that no account overrides both the state and the state diff.
+func (diff StateOverride) Validate() error {
+	for addr, account := range diff {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+	}
+	return nil
+}
+
+// BlockOverrides is a set of header fields to override for a message call.
+type BlockOverrides struct {
+	Number   *hexutil.Big    `json:"number"`
+	Time     *hexutil.Uint64 `json:"time"`
+	Coinbase *common.Address `json:"coinbase"`
+	BaseFee  *hexutil.Big    `json:"baseFee"`
+}
+
+// Apply overrides the block height and time of the context, and the coinbase and base fee of the EVM config.
+func (diff *BlockOverrides) Apply(ctx sdk.Context, cfg *EVMConfig) (sdk.Context, error) {
+	if diff == nil {
+		return ctx, nil
+	}
+
+	if diff.Number != nil {
+		number := (*big.Int)(diff.Number)
+		if !number.IsInt64() || number.Sign() < 0 {
+			return ctx, fmt.Errorf("invalid block number override %s", number)
+		}
+		ctx = ctx.WithBlockHeight(number.Int64())
+	}
+	if diff.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*diff.Time), 0).UTC())
+	}
+	if diff.Coinbase != nil {
+		cfg.CoinBase = *diff.Coinbase
+	}
+	if diff.BaseFee != nil {
+		cfg.BaseFee = (*big.Int)(diff.BaseFee)
+	}
+
+	return ctx, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,6 +27,8 @@ var (
 	ParamStoreKeyExtraEIPs    = []byte("EnableExtraEIPs")
 	ParamStoreKeyChainConfig  = []byte("ChainConfig")
 
+	ParamStoreKeyActivePrecompiles = []byte("ActivePrecompiles")
+
 	// AvailableExtraEIPs define the list of all EIPs that can be enabled by the
 	// EVM interpreter. These EIPs are applied in order and can override the
 	// instruction sets from the latest hard fork enabled by the ChainConfig. For
@@ -70,6 +73,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableCall, &p.EnableCall, validateBool),
 		paramtypes.NewParamSetPair(ParamStoreKeyExtraEIPs, &p.ExtraEIPs, validateEIPs),
 		paramtypes.NewParamSetPair(ParamStoreKeyChainConfig, &p.ChainConfig, validateChainConfig),
+		paramtypes.NewParamSetPair(ParamStoreKeyActivePrecompiles, &p.ActivePrecompiles, validatePrecompiles),
 	}
 }
 
@@ -83,6 +87,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePrecompiles(p.ActivePrecompiles); err != nil {
+		return err
+	}
+
 	return p.ChainConfig.Validate()
 }
 
@@ -95,6 +103,16 @@ func (p Params) EIPs() []int {
 	return eips
 }
 
+// IsActivePrecompile returns whether the stateful precompiled contract of an address is active.
+func (p Params) IsActivePrecompile(address common.Address) bool {
+	for _, precompile := range p.ActivePrecompiles {
+		if common.HexToAddress(precompile) == address {
+			return true
+		}
+	}
+	return false
+}
+
 func validateEVMDenom(i interface{}) error {
 	denom, ok := i.(string)
 	if !ok {
@@ -127,6 +145,28 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+func validatePrecompiles(i interface{}) error {
+	precompiles, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid precompile slice type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool, len(precompiles))
+	for _, precompile := range precompiles {
+		if !common.IsHexAddress(precompile) {
+			return fmt.Errorf("invalid precompile address %s", precompile)
+		}
+
+		address := common.HexToAddress(precompile)
+		if seen[address] {
+			return fmt.Errorf("duplicate precompile address %s", precompile)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
 func validateChainConfig(i interface{}) error {
 	cfg, ok := i.(ChainConfig)
 	if !ok {
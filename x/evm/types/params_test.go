@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -45,6 +46,22 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid precompile",
+			Params{
+				EvmDenom:          "stake",
+				ActivePrecompiles: []string{"0x900"},
+			},
+			true,
+		},
+		{
+			"duplicate precompile",
+			Params{
+				EvmDenom:          "stake",
+				ActivePrecompiles: []string{"0x0000000000000000000000000000000000000900", "0x0000000000000000000000000000000000000900"},
+			},
+			true,
+		},
 		{
 			"invalid chain config",
 			NewParams("ara", true, true, ChainConfig{}, 2929, 1884, 1344),
@@ -77,6 +94,16 @@ func TestParamsValidatePriv(t *testing.T) {
 	require.NoError(t, validateBool(true))
 	require.Error(t, validateEIPs(""))
 	require.NoError(t, validateEIPs([]int64{1884}))
+	require.Error(t, validatePrecompiles(""))
+	require.NoError(t, validatePrecompiles([]string{"0x0000000000000000000000000000000000000900"}))
+}
+
+func TestParamsIsActivePrecompile(t *testing.T) {
+	params := DefaultParams()
+	params.ActivePrecompiles = []string{"0x0000000000000000000000000000000000000900"}
+
+	require.True(t, params.IsActivePrecompile(common.HexToAddress("0x900")))
+	require.False(t, params.IsActivePrecompile(common.HexToAddress("0x901")))
 }
 
 func TestValidateChainConfig(t *testing.T) {
@@ -0,0 +1,1267 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ethermint/evm/v1/receipt.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Receipt defines the receipt of an ethereum transaction, with the fields of the eth_getTransactionReceipt
+// JSON-RPC.
+type Receipt struct {
+	// status is 1 for the successful transactions and 0 for the failed ones
+	Status uint64 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	// cumulative_gas_used is the gas used in the block up to and including the transaction
+	CumulativeGasUsed uint64 `protobuf:"varint,2,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	// logs_bloom is the bloom filter of the transaction logs
+	LogsBloom []byte `protobuf:"bytes,3,opt,name=logs_bloom,json=logsBloom,proto3" json:"logs_bloom,omitempty"`
+	// logs are the logs emitted by the transaction
+	Logs []*Log `protobuf:"bytes,4,rep,name=logs,proto3" json:"logs,omitempty"`
+	// tx_hash is the hex ethereum transaction hash
+	TxHash string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// contract_address is the hex address of the contract created by the transaction, if any
+	ContractAddress string `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// gas_used is the gas used by the transaction
+	GasUsed uint64 `protobuf:"varint,7,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// type is the ethereum transaction type
+	Type uint32 `protobuf:"varint,8,opt,name=type,proto3" json:"type,omitempty"`
+	// block_hash is the hex hash of the block
+	BlockHash string `protobuf:"bytes,9,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_number is the height of the block
+	BlockNumber uint64 `protobuf:"varint,10,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// tx_index is the index of the transaction in the ethereum transactions of the block
+	TxIndex uint64 `protobuf:"varint,11,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// from is the hex sender address
+	From string `protobuf:"bytes,12,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the hex recipient address, empty for the contract creations
+	To string `protobuf:"bytes,13,opt,name=to,proto3" json:"to,omitempty"`
+	// effective_gas_price is the price paid per gas by the dynamic fee transactions, empty for the other
+	// transaction types
+	EffectiveGasPrice string `protobuf:"bytes,14,opt,name=effective_gas_price,json=effectiveGasPrice,proto3" json:"effective_gas_price,omitempty"`
+}
+
+func (m *Receipt) Reset()         { *m = Receipt{} }
+func (m *Receipt) String() string { return proto.CompactTextString(m) }
+func (*Receipt) ProtoMessage()    {}
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ec806ea06bf923, []int{0}
+}
+func (m *Receipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Receipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Receipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Receipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Receipt.Merge(m, src)
+}
+func (m *Receipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *Receipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_Receipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Receipt proto.InternalMessageInfo
+
+// QueryBlockReceiptsRequest defines the request type for querying the receipts of a block.
+type QueryBlockReceiptsRequest struct {
+	// height of the block, the latest block is queried if zero
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBlockReceiptsRequest) Reset()         { *m = QueryBlockReceiptsRequest{} }
+func (m *QueryBlockReceiptsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockReceiptsRequest) ProtoMessage()    {}
+func (*QueryBlockReceiptsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ec806ea06bf923, []int{1}
+}
+func (m *QueryBlockReceiptsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockReceiptsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockReceiptsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockReceiptsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockReceiptsRequest.Merge(m, src)
+}
+func (m *QueryBlockReceiptsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockReceiptsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockReceiptsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockReceiptsRequest proto.InternalMessageInfo
+
+func (m *QueryBlockReceiptsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryBlockReceiptsResponse defines the response type for querying the receipts of a block.
+type QueryBlockReceiptsResponse struct {
+	// receipts of the ethereum transactions, in the order of the transactions in the block
+	Receipts []*Receipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+}
+
+func (m *QueryBlockReceiptsResponse) Reset()         { *m = QueryBlockReceiptsResponse{} }
+func (m *QueryBlockReceiptsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockReceiptsResponse) ProtoMessage()    {}
+func (*QueryBlockReceiptsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1ec806ea06bf923, []int{2}
+}
+func (m *QueryBlockReceiptsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockReceiptsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockReceiptsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockReceiptsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockReceiptsResponse.Merge(m, src)
+}
+func (m *QueryBlockReceiptsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockReceiptsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockReceiptsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockReceiptsResponse proto.InternalMessageInfo
+
+func (m *QueryBlockReceiptsResponse) GetReceipts() []*Receipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Receipt)(nil), "ethermint.evm.v1.Receipt")
+	proto.RegisterType((*QueryBlockReceiptsRequest)(nil), "ethermint.evm.v1.QueryBlockReceiptsRequest")
+	proto.RegisterType((*QueryBlockReceiptsResponse)(nil), "ethermint.evm.v1.QueryBlockReceiptsResponse")
+}
+
+func init() { proto.RegisterFile("ethermint/evm/v1/receipt.proto", fileDescriptor_c1ec806ea06bf923) }
+
+var fileDescriptor_c1ec806ea06bf923 = []byte{
+	// 568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x93, 0x90, 0x34, 0xd7, 0xa6, 0xb4, 0xc7, 0xaf, 0x4b, 0x04, 0x26, 0x64, 0x72, 0x54,
+	0x64, 0x2b, 0xad, 0x58, 0xd8, 0xc8, 0x52, 0x90, 0x10, 0x02, 0x57, 0x2c, 0x2c, 0xd6, 0xc5, 0x79,
+	0xb1, 0x2d, 0x62, 0x9f, 0xb9, 0x3b, 0x5b, 0xae, 0x10, 0x0b, 0x13, 0x23, 0x12, 0x33, 0x12, 0x2b,
+	0x7f, 0x08, 0x12, 0x63, 0x25, 0x16, 0x46, 0x94, 0xf0, 0x87, 0xa0, 0x3b, 0xbb, 0xe1, 0x47, 0x40,
+	0x62, 0xbb, 0xf7, 0x7d, 0xcf, 0xdf, 0xf7, 0xdd, 0xbd, 0x67, 0x64, 0x82, 0x0c, 0x81, 0xc7, 0x51,
+	0x22, 0x1d, 0xc8, 0x63, 0x27, 0x1f, 0x3b, 0x1c, 0x7c, 0x88, 0x52, 0x69, 0xa7, 0x9c, 0x49, 0x86,
+	0xf7, 0xd6, 0xbc, 0x0d, 0x79, 0x6c, 0xe7, 0xe3, 0xfe, 0xe5, 0x80, 0x05, 0x4c, 0x93, 0x8e, 0x3a,
+	0x95, 0x7d, 0xfd, 0xeb, 0x01, 0x63, 0xc1, 0x02, 0x1c, 0x9a, 0x46, 0x0e, 0x4d, 0x12, 0x26, 0xa9,
+	0x8c, 0x58, 0x22, 0x2a, 0xb6, 0xbf, 0xe1, 0xa2, 0xc4, 0x34, 0x37, 0xfc, 0xd4, 0x40, 0x6d, 0xb7,
+	0xf4, 0xc4, 0x57, 0x51, 0x4b, 0x48, 0x2a, 0x33, 0x41, 0x8c, 0x81, 0x61, 0x35, 0xdd, 0xaa, 0xc2,
+	0x36, 0xba, 0xe4, 0x67, 0x71, 0xb6, 0xa0, 0x32, 0xca, 0xc1, 0x0b, 0xa8, 0xf0, 0x32, 0x01, 0x33,
+	0x52, 0xd7, 0x4d, 0xfb, 0x3f, 0xa9, 0x63, 0x2a, 0x9e, 0x0a, 0x98, 0xe1, 0x1b, 0x08, 0x2d, 0x58,
+	0x20, 0xbc, 0xe9, 0x82, 0xb1, 0x98, 0x34, 0x06, 0x86, 0xb5, 0xe3, 0x76, 0x14, 0x32, 0x51, 0x00,
+	0x1e, 0xa1, 0xa6, 0x2a, 0x48, 0x73, 0xd0, 0xb0, 0xb6, 0x0f, 0xaf, 0xd8, 0x7f, 0xde, 0xd1, 0x7e,
+	0xc8, 0x02, 0x57, 0xb7, 0xe0, 0x6b, 0xa8, 0x2d, 0x0b, 0x2f, 0xa4, 0x22, 0x24, 0x17, 0x06, 0x86,
+	0xd5, 0x71, 0x5b, 0xb2, 0xb8, 0x4f, 0x45, 0x88, 0x47, 0x68, 0xcf, 0x67, 0x89, 0xe4, 0xd4, 0x97,
+	0x1e, 0x9d, 0xcd, 0x38, 0x08, 0x41, 0x5a, 0xba, 0xe3, 0xe2, 0x39, 0x7e, 0xaf, 0x84, 0x71, 0x0f,
+	0x6d, 0xad, 0x23, 0xb7, 0x75, 0xe4, 0x76, 0x50, 0x05, 0xc5, 0xa8, 0x29, 0x4f, 0x53, 0x20, 0x5b,
+	0x03, 0xc3, 0xea, 0xba, 0xfa, 0xac, 0xc2, 0x4f, 0x17, 0xcc, 0x7f, 0x5e, 0xba, 0x76, 0xb4, 0x66,
+	0x47, 0x23, 0xda, 0xf8, 0x16, 0xda, 0x29, 0xe9, 0x24, 0x8b, 0xa7, 0xc0, 0x09, 0xd2, 0x8a, 0xdb,
+	0x1a, 0x7b, 0xa4, 0x21, 0x65, 0x28, 0x0b, 0x2f, 0x4a, 0x66, 0x50, 0x90, 0xed, 0xd2, 0x50, 0x16,
+	0x0f, 0x54, 0xa9, 0x0c, 0xe7, 0x9c, 0xc5, 0x64, 0x47, 0xcb, 0xea, 0x33, 0xde, 0x45, 0x75, 0xc9,
+	0x48, 0x57, 0x23, 0x75, 0xc9, 0xd4, 0x6b, 0xc3, 0x7c, 0x0e, 0xfe, 0xfa, 0xb1, 0x53, 0x1e, 0xf9,
+	0x40, 0x76, 0x75, 0xc3, 0xfe, 0x9a, 0x3a, 0xa6, 0xe2, 0xb1, 0x22, 0xee, 0x36, 0xdf, 0x7c, 0xb8,
+	0x59, 0x1b, 0x1e, 0xa1, 0xde, 0x93, 0x0c, 0xf8, 0xe9, 0x44, 0x05, 0xa9, 0x06, 0x2a, 0x5c, 0x78,
+	0x91, 0x81, 0xd0, 0x83, 0x0d, 0x21, 0x0a, 0x42, 0xa9, 0x07, 0xdb, 0x70, 0xab, 0x6a, 0x78, 0x82,
+	0xfa, 0x7f, 0xfb, 0x48, 0xa4, 0x2c, 0x11, 0x80, 0xef, 0xa0, 0xad, 0x6a, 0x1b, 0xd5, 0x42, 0xa8,
+	0x59, 0xf5, 0x36, 0x67, 0x55, 0x7d, 0xe5, 0xae, 0x5b, 0x0f, 0x3f, 0x1a, 0xa8, 0x7d, 0x02, 0x3c,
+	0x8f, 0x7c, 0xc0, 0xef, 0x0d, 0xd4, 0xfd, 0x4d, 0x1c, 0x1f, 0x6c, 0x4a, 0xfc, 0x33, 0x77, 0xff,
+	0xf6, 0xff, 0x35, 0x97, 0x79, 0x87, 0xe3, 0xd7, 0x5f, 0xbe, 0xbf, 0xab, 0x1f, 0xe0, 0x91, 0xb3,
+	0xb1, 0xef, 0xe5, 0xc8, 0xce, 0x23, 0x3a, 0x2f, 0xcb, 0xfb, 0xbf, 0x9a, 0x4c, 0x3e, 0x2f, 0x4d,
+	0xe3, 0x6c, 0x69, 0x1a, 0xdf, 0x96, 0xa6, 0xf1, 0x76, 0x65, 0xd6, 0xce, 0x56, 0x66, 0xed, 0xeb,
+	0xca, 0xac, 0x3d, 0xb3, 0x82, 0x48, 0x86, 0xd9, 0xd4, 0xf6, 0x59, 0xec, 0xc8, 0x90, 0x72, 0x11,
+	0x89, 0x5f, 0x64, 0x0b, 0x2d, 0xac, 0xf6, 0x45, 0x4c, 0x5b, 0xfa, 0x47, 0x3a, 0xfa, 0x31, 0x00,
+	0xb1, 0xda, 0xb1, 0x9c, 0xcc, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// BlockReceipts queries the receipts of all the ethereum transactions in a block.
+	BlockReceipts(ctx context.Context, in *QueryBlockReceiptsRequest, opts ...grpc.CallOption) (*QueryBlockReceiptsResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) BlockReceipts(ctx context.Context, in *QueryBlockReceiptsRequest, opts ...grpc.CallOption) (*QueryBlockReceiptsResponse, error) {
+	out := new(QueryBlockReceiptsResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Service/BlockReceipts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// BlockReceipts queries the receipts of all the ethereum transactions in a block.
+	BlockReceipts(context.Context, *QueryBlockReceiptsRequest) (*QueryBlockReceiptsResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) BlockReceipts(ctx context.Context, req *QueryBlockReceiptsRequest) (*QueryBlockReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockReceipts not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_BlockReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).BlockReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Service/BlockReceipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).BlockReceipts(ctx, req.(*QueryBlockReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethermint.evm.v1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlockReceipts",
+			Handler:    _Service_BlockReceipts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ethermint/evm/v1/receipt.proto",
+}
+
+func (m *Receipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EffectiveGasPrice) > 0 {
+		i -= len(m.EffectiveGasPrice)
+		copy(dAtA[i:], m.EffectiveGasPrice)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.EffectiveGasPrice)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Type != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReceipt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LogsBloom) > 0 {
+		i -= len(m.LogsBloom)
+		copy(dAtA[i:], m.LogsBloom)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.LogsBloom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CumulativeGasUsed != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.CumulativeGasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockReceiptsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockReceiptsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockReceiptsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockReceiptsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockReceiptsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockReceiptsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReceipt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReceipt(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReceipt(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Receipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovReceipt(uint64(m.Status))
+	}
+	if m.CumulativeGasUsed != 0 {
+		n += 1 + sovReceipt(uint64(m.CumulativeGasUsed))
+	}
+	l = len(m.LogsBloom)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovReceipt(uint64(l))
+		}
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovReceipt(uint64(m.GasUsed))
+	}
+	if m.Type != 0 {
+		n += 1 + sovReceipt(uint64(m.Type))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovReceipt(uint64(m.BlockNumber))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovReceipt(uint64(m.TxIndex))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	l = len(m.EffectiveGasPrice)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockReceiptsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovReceipt(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockReceiptsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovReceipt(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovReceipt(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReceipt(x uint64) (n int) {
+	return sovReceipt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Receipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReceipt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Receipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Receipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeGasUsed", wireType)
+			}
+			m.CumulativeGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CumulativeGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogsBloom", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogsBloom = append(m.LogsBloom[:0], dAtA[iNdEx:postIndex]...)
+			if m.LogsBloom == nil {
+				m.LogsBloom = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveGasPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReceipt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockReceiptsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReceipt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockReceiptsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockReceiptsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReceipt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockReceiptsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReceipt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockReceiptsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockReceiptsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, &Receipt{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReceipt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReceipt(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReceipt
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReceipt
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReceipt
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReceipt
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReceipt        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReceipt          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReceipt = fmt.Errorf("proto: unexpected end of group")
+)
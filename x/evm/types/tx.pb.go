@@ -253,7 +253,11 @@ func (m *DynamicFeeTx) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DynamicFeeTx proto.InternalMessageInfo
 
+// ExtensionOptionsEthereumTx defines the extension option of the cosmos txs wrapping ethereum txs.
 type ExtensionOptionsEthereumTx struct {
+	// fee_payer is the optional bech32 address of the account paying the fees of the
+	// ethereum txs, it must have granted a fee allowance to the senders.
+	FeePayer string `protobuf:"bytes,1,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty" yaml:"fee_payer"`
 }
 
 func (m *ExtensionOptionsEthereumTx) Reset()         { *m = ExtensionOptionsEthereumTx{} }
@@ -352,61 +356,62 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x31, 0x8f, 0xe3, 0x44,
-	0x14, 0xce, 0x24, 0x4e, 0xec, 0x4c, 0xc2, 0xe9, 0x64, 0xed, 0x49, 0x4e, 0xc4, 0xc5, 0x91, 0x25,
-	0x20, 0x20, 0xc5, 0xd6, 0x2d, 0x54, 0x5b, 0xb1, 0xbe, 0xdd, 0x3b, 0xdd, 0x29, 0x27, 0x90, 0x15,
-	0x1a, 0xae, 0x88, 0x66, 0x9d, 0x59, 0x67, 0x44, 0xec, 0xb1, 0x3c, 0x13, 0xcb, 0x41, 0xa2, 0x41,
-	0x14, 0x74, 0x20, 0xf1, 0x07, 0x28, 0xa8, 0x68, 0xe1, 0x07, 0x50, 0x5e, 0x79, 0x82, 0x06, 0x51,
-	0x18, 0x94, 0xa5, 0xda, 0x0e, 0x7e, 0x01, 0x9a, 0xb1, 0xb3, 0x9b, 0x10, 0xe5, 0x80, 0xe3, 0x10,
-	0x55, 0xe6, 0xf9, 0x7b, 0xf3, 0xe6, 0xbd, 0xf7, 0x7d, 0x79, 0x0f, 0x76, 0x30, 0x9f, 0xe1, 0x24,
-	0x24, 0x11, 0x77, 0x70, 0x1a, 0x3a, 0xe9, 0x1d, 0x87, 0x67, 0x76, 0x9c, 0x50, 0x4e, 0xf5, 0x9b,
-	0x57, 0x90, 0x8d, 0xd3, 0xd0, 0x4e, 0xef, 0x74, 0x0f, 0x02, 0x1a, 0x50, 0x09, 0x3a, 0xe2, 0x54,
-	0xf8, 0x75, 0x5f, 0x0e, 0x28, 0x0d, 0xe6, 0xd8, 0x41, 0x31, 0x71, 0x50, 0x14, 0x51, 0x8e, 0x38,
-	0xa1, 0x11, 0x2b, 0xd1, 0x4e, 0x89, 0x4a, 0xeb, 0x6c, 0x71, 0xee, 0xa0, 0x68, 0xb9, 0x86, 0x7c,
-	0xca, 0x42, 0xca, 0x26, 0x45, 0xc4, 0xc2, 0x28, 0xa1, 0xee, 0x4e, 0x5a, 0x22, 0x05, 0x89, 0x59,
-	0x9f, 0x01, 0xf8, 0xd2, 0x23, 0x16, 0x9c, 0x0a, 0x0f, 0xbc, 0x08, 0xc7, 0x99, 0x3e, 0x80, 0xca,
-	0x14, 0x71, 0x64, 0x80, 0x3e, 0x18, 0xb4, 0x0e, 0x0f, 0xec, 0xe2, 0x49, 0x7b, 0xfd, 0xa4, 0x7d,
-	0x1c, 0x2d, 0x3d, 0xe9, 0xa1, 0x77, 0xa0, 0xc2, 0xc8, 0x87, 0xd8, 0xa8, 0xf6, 0xc1, 0x00, 0xb8,
-	0xf5, 0xcb, 0xdc, 0x04, 0x43, 0x4f, 0x7e, 0xd2, 0x4d, 0xa8, 0xcc, 0x10, 0x9b, 0x19, 0xb5, 0x3e,
-	0x18, 0x34, 0xdd, 0xd6, 0xef, 0xb9, 0xa9, 0x26, 0xf3, 0xf8, 0xc8, 0x1a, 0x5a, 0x9e, 0x04, 0x74,
-	0x1d, 0x2a, 0xe7, 0x09, 0x0d, 0x0d, 0x45, 0x38, 0x78, 0xf2, 0x7c, 0xa4, 0x7c, 0xfa, 0xa5, 0x59,
-	0xb1, 0xbe, 0xa9, 0x42, 0x6d, 0x84, 0x03, 0xe4, 0x2f, 0xc7, 0x99, 0x7e, 0x00, 0xeb, 0x11, 0x8d,
-	0x7c, 0x2c, 0xb3, 0x51, 0xbc, 0xc2, 0xd0, 0xef, 0xc3, 0x66, 0x80, 0x44, 0xa9, 0xc4, 0x2f, 0x5e,
-	0x6f, 0xba, 0x6f, 0xfc, 0x94, 0x9b, 0xaf, 0x06, 0x84, 0xcf, 0x16, 0x67, 0xb6, 0x4f, 0xc3, 0xb2,
-	0x01, 0xe5, 0xcf, 0x90, 0x4d, 0x3f, 0x70, 0xf8, 0x32, 0xc6, 0xcc, 0x7e, 0x10, 0x71, 0x4f, 0x0b,
-	0x10, 0x7b, 0x57, 0xdc, 0xd5, 0x7b, 0xb0, 0x16, 0x20, 0x26, 0xb3, 0x54, 0xdc, 0xf6, 0x2a, 0x37,
-	0xb5, 0xfb, 0x88, 0x8d, 0x48, 0x48, 0xb8, 0x27, 0x00, 0xfd, 0x06, 0xac, 0x72, 0x5a, 0xe6, 0x58,
-	0xe5, 0x54, 0x7f, 0x08, 0xeb, 0x29, 0x9a, 0x2f, 0xb0, 0x51, 0x97, 0x8f, 0xbe, 0xf5, 0xf7, 0x1f,
-	0x5d, 0xe5, 0x66, 0xe3, 0x38, 0xa4, 0x8b, 0x88, 0x7b, 0x45, 0x08, 0xd1, 0x01, 0xd9, 0xe7, 0x46,
-	0x1f, 0x0c, 0xda, 0x65, 0x47, 0xdb, 0x10, 0xa4, 0x86, 0x2a, 0x3f, 0x80, 0x54, 0x58, 0x89, 0xa1,
-	0x15, 0x56, 0x22, 0x2c, 0x66, 0x34, 0x0b, 0x8b, 0x1d, 0xdd, 0x10, 0xbd, 0xfa, 0xfe, 0xdb, 0x61,
-	0x63, 0x9c, 0x9d, 0x20, 0x8e, 0xac, 0xdf, 0x6a, 0xb0, 0x7d, 0xec, 0xfb, 0x98, 0xb1, 0x11, 0x61,
-	0x7c, 0x9c, 0xe9, 0x8f, 0xa1, 0xe6, 0xcf, 0x10, 0x89, 0x26, 0x64, 0x2a, 0x9b, 0xd7, 0x74, 0xdf,
-	0xfe, 0x47, 0xd9, 0xaa, 0x77, 0xc5, 0xed, 0x07, 0x27, 0x97, 0xb9, 0xa9, 0xfa, 0xc5, 0xd1, 0x2b,
-	0x0f, 0xd3, 0x6b, 0x5a, 0xaa, 0x7b, 0x69, 0xa9, 0xfd, 0x7b, 0x5a, 0x94, 0x67, 0xd3, 0x52, 0xdf,
-	0xa5, 0xa5, 0xf1, 0xe2, 0x68, 0x51, 0x37, 0x68, 0x79, 0x0c, 0x35, 0x24, 0x7b, 0x8b, 0x99, 0xa1,
-	0xf5, 0x6b, 0x83, 0xd6, 0xe1, 0x6d, 0xfb, 0xcf, 0xff, 0x67, 0xbb, 0xe8, 0xfe, 0x78, 0x11, 0xcf,
-	0xb1, 0xdb, 0x7f, 0x92, 0x9b, 0x95, 0xcb, 0xdc, 0x84, 0xe8, 0x8a, 0x92, 0xaf, 0x7f, 0x36, 0xe1,
-	0x35, 0x41, 0xde, 0x55, 0xc0, 0x82, 0xf3, 0xe6, 0x16, 0xe7, 0x70, 0x8b, 0xf3, 0xd6, 0x3e, 0xce,
-	0xbf, 0x53, 0x60, 0xfb, 0x64, 0x19, 0xa1, 0x90, 0xf8, 0xf7, 0x30, 0xfe, 0x7f, 0x38, 0x7f, 0x08,
-	0x5b, 0x82, 0x73, 0x4e, 0xe2, 0x89, 0x8f, 0xe2, 0xe7, 0x60, 0x5d, 0x48, 0x66, 0x4c, 0xe2, 0xbb,
-	0x28, 0x5e, 0xc7, 0x3a, 0xc7, 0x58, 0xc6, 0x52, 0x9e, 0x2b, 0xd6, 0x3d, 0x8c, 0x45, 0xac, 0x52,
-	0x42, 0xf5, 0x67, 0x4b, 0xa8, 0xb1, 0x2b, 0x21, 0xf5, 0xc5, 0x49, 0x48, 0xdb, 0x23, 0xa1, 0xe6,
-	0x7f, 0x22, 0x21, 0xb8, 0x25, 0xa1, 0xd6, 0x96, 0x84, 0xda, 0xfb, 0x24, 0x64, 0xc1, 0xee, 0x69,
-	0xc6, 0x71, 0xc4, 0x08, 0x8d, 0xde, 0x89, 0xe5, 0xaa, 0xb9, 0x5e, 0x05, 0xe5, 0x40, 0xfe, 0x0a,
-	0xc0, 0x5b, 0x5b, 0x2b, 0xc2, 0xc3, 0x2c, 0xa6, 0x11, 0x93, 0x85, 0xca, 0x29, 0x0f, 0x8a, 0x21,
-	0x2e, 0x07, 0xfb, 0xeb, 0x50, 0x99, 0xd3, 0x80, 0x19, 0x55, 0x59, 0xe4, 0xad, 0xdd, 0x22, 0x47,
-	0x34, 0xf0, 0xa4, 0x8b, 0x7e, 0x13, 0xd6, 0x12, 0xcc, 0xa5, 0x66, 0xda, 0x9e, 0x38, 0xea, 0x1d,
-	0xa8, 0xa5, 0xe1, 0x04, 0x27, 0x09, 0x4d, 0xca, 0xa9, 0xab, 0xa6, 0xe1, 0xa9, 0x30, 0x05, 0x24,
-	0xc4, 0xb1, 0x60, 0x78, 0x5a, 0xb0, 0xea, 0xa9, 0x01, 0x62, 0xef, 0x31, 0x3c, 0x2d, 0xd2, 0x3c,
-	0xfc, 0x04, 0xc0, 0xda, 0x23, 0x16, 0xe8, 0x1f, 0x41, 0xb8, 0xb1, 0xcd, 0xcc, 0xdd, 0x04, 0xb6,
-	0x6a, 0xe9, 0xbe, 0xf6, 0x17, 0x0e, 0xeb, 0x62, 0xad, 0x57, 0x3e, 0xfe, 0xe1, 0xd7, 0x2f, 0xaa,
-	0xa6, 0x75, 0xdb, 0xd9, 0x5d, 0xa7, 0xa5, 0xf7, 0x84, 0x67, 0xae, 0xfb, 0x64, 0xd5, 0x03, 0x4f,
-	0x57, 0x3d, 0xf0, 0xcb, 0xaa, 0x07, 0x3e, 0xbf, 0xe8, 0x55, 0x9e, 0x5e, 0xf4, 0x2a, 0x3f, 0x5e,
-	0xf4, 0x2a, 0xef, 0x0f, 0x36, 0xf4, 0xc4, 0x67, 0x28, 0x61, 0x84, 0x6d, 0x84, 0xca, 0x64, 0x30,
-	0xa9, 0xaa, 0xb3, 0x86, 0x5c, 0xb6, 0x6f, 0xfe, 0x11, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x54, 0xa7,
-	0x4c, 0x50, 0x08, 0x00, 0x00,
+	// 880 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0xce, 0x24, 0x4e, 0xe2, 0x4c, 0x42, 0xb5, 0x1a, 0x6d, 0x25, 0x6f, 0x44, 0xe3, 0xc8, 0x12,
+	0x10, 0x90, 0xd6, 0xd6, 0x2e, 0x9c, 0xf6, 0xc4, 0xba, 0xbb, 0xad, 0x5a, 0x6d, 0x45, 0x65, 0xa5,
+	0x17, 0x7a, 0x88, 0x66, 0x9d, 0x59, 0x67, 0x44, 0xec, 0xb1, 0x3c, 0x13, 0xcb, 0x41, 0xe2, 0x82,
+	0x38, 0x70, 0x03, 0x89, 0x3f, 0xc0, 0x81, 0x13, 0x57, 0xf8, 0x01, 0x1c, 0x7b, 0xac, 0xe0, 0x82,
+	0x38, 0x18, 0x94, 0xe5, 0xb4, 0x37, 0xfa, 0x0b, 0xd0, 0x8c, 0xbd, 0xdb, 0x84, 0x28, 0x05, 0xca,
+	0xa2, 0x9e, 0xf2, 0x9e, 0xbf, 0xe7, 0x37, 0x6f, 0xbe, 0xef, 0x8b, 0x1f, 0xdc, 0x21, 0x62, 0x42,
+	0x92, 0x90, 0x46, 0xc2, 0x21, 0x69, 0xe8, 0xa4, 0x7b, 0x8e, 0xc8, 0xec, 0x38, 0x61, 0x82, 0xa1,
+	0xad, 0x2b, 0xc8, 0x26, 0x69, 0x68, 0xa7, 0x7b, 0xdd, 0xed, 0x80, 0x05, 0x4c, 0x81, 0x8e, 0x8c,
+	0x8a, 0xba, 0xee, 0xeb, 0x01, 0x63, 0xc1, 0x94, 0x38, 0x38, 0xa6, 0x0e, 0x8e, 0x22, 0x26, 0xb0,
+	0xa0, 0x2c, 0xe2, 0x25, 0xba, 0x53, 0xa2, 0x2a, 0x3b, 0x9d, 0x9d, 0x39, 0x38, 0x9a, 0x5f, 0x42,
+	0x3e, 0xe3, 0x21, 0xe3, 0xa3, 0xa2, 0x63, 0x91, 0x94, 0x50, 0x77, 0x6d, 0x2c, 0x39, 0x82, 0xc2,
+	0xac, 0x2f, 0x00, 0x7c, 0xed, 0x01, 0x0f, 0x8e, 0x65, 0x05, 0x99, 0x85, 0xc3, 0x0c, 0x0d, 0xa0,
+	0x36, 0xc6, 0x02, 0x1b, 0xa0, 0x0f, 0x06, 0xed, 0xfd, 0x6d, 0xbb, 0x38, 0xd2, 0xbe, 0x3c, 0xd2,
+	0x3e, 0x8c, 0xe6, 0x9e, 0xaa, 0x40, 0x3b, 0x50, 0xe3, 0xf4, 0x63, 0x62, 0x54, 0xfb, 0x60, 0x00,
+	0xdc, 0xfa, 0x45, 0x6e, 0x82, 0x5d, 0x4f, 0x3d, 0x42, 0x26, 0xd4, 0x26, 0x98, 0x4f, 0x8c, 0x5a,
+	0x1f, 0x0c, 0x5a, 0x6e, 0xfb, 0x59, 0x6e, 0x36, 0x93, 0x69, 0x7c, 0x60, 0xed, 0x5a, 0x9e, 0x02,
+	0x10, 0x82, 0xda, 0x59, 0xc2, 0x42, 0x43, 0x93, 0x05, 0x9e, 0x8a, 0x0f, 0xb4, 0xcf, 0xbf, 0x36,
+	0x2b, 0xd6, 0x77, 0x55, 0xa8, 0x9f, 0x90, 0x00, 0xfb, 0xf3, 0x61, 0x86, 0xb6, 0x61, 0x3d, 0x62,
+	0x91, 0x4f, 0xd4, 0x34, 0x9a, 0x57, 0x24, 0xe8, 0x2e, 0x6c, 0x05, 0x58, 0x5e, 0x95, 0xfa, 0xc5,
+	0xe9, 0x2d, 0xf7, 0x9d, 0x5f, 0x72, 0xf3, 0xcd, 0x80, 0x8a, 0xc9, 0xec, 0xd4, 0xf6, 0x59, 0x58,
+	0x12, 0x50, 0xfe, 0xec, 0xf2, 0xf1, 0x47, 0x8e, 0x98, 0xc7, 0x84, 0xdb, 0xf7, 0x22, 0xe1, 0xe9,
+	0x01, 0xe6, 0x0f, 0xe5, 0xbb, 0xa8, 0x07, 0x6b, 0x01, 0xe6, 0x6a, 0x4a, 0xcd, 0xed, 0x2c, 0x72,
+	0x53, 0xbf, 0x8b, 0xf9, 0x09, 0x0d, 0xa9, 0xf0, 0x24, 0x80, 0x6e, 0xc0, 0xaa, 0x60, 0xe5, 0x8c,
+	0x55, 0xc1, 0xd0, 0x7d, 0x58, 0x4f, 0xf1, 0x74, 0x46, 0x8c, 0xba, 0x3a, 0xf4, 0xbd, 0x7f, 0x7e,
+	0xe8, 0x22, 0x37, 0x1b, 0x87, 0x21, 0x9b, 0x45, 0xc2, 0x2b, 0x5a, 0x48, 0x06, 0x14, 0xcf, 0x8d,
+	0x3e, 0x18, 0x74, 0x4a, 0x46, 0x3b, 0x10, 0xa4, 0x46, 0x53, 0x3d, 0x00, 0xa9, 0xcc, 0x12, 0x43,
+	0x2f, 0xb2, 0x44, 0x66, 0xdc, 0x68, 0x15, 0x19, 0x3f, 0xb8, 0x21, 0xb9, 0xfa, 0xf1, 0xfb, 0xdd,
+	0xc6, 0x30, 0x3b, 0xc2, 0x02, 0x5b, 0x7f, 0xd4, 0x60, 0xe7, 0xd0, 0xf7, 0x09, 0xe7, 0x27, 0x94,
+	0x8b, 0x61, 0x86, 0x1e, 0x43, 0xdd, 0x9f, 0x60, 0x1a, 0x8d, 0xe8, 0x58, 0x91, 0xd7, 0x72, 0xdf,
+	0xff, 0x57, 0xd3, 0x36, 0x6f, 0xcb, 0xb7, 0xef, 0x1d, 0x5d, 0xe4, 0x66, 0xd3, 0x2f, 0x42, 0xaf,
+	0x0c, 0xc6, 0xcf, 0x65, 0xa9, 0x6e, 0x94, 0xa5, 0xf6, 0xdf, 0x65, 0xd1, 0x5e, 0x2c, 0x4b, 0x7d,
+	0x5d, 0x96, 0xc6, 0xf5, 0xc9, 0xd2, 0x5c, 0x92, 0xe5, 0x31, 0xd4, 0xb1, 0xe2, 0x96, 0x70, 0x43,
+	0xef, 0xd7, 0x06, 0xed, 0xfd, 0x5b, 0xf6, 0x5f, 0xff, 0xcf, 0x76, 0xc1, 0xfe, 0x70, 0x16, 0x4f,
+	0x89, 0xdb, 0x7f, 0x92, 0x9b, 0x95, 0x8b, 0xdc, 0x84, 0xf8, 0x4a, 0x92, 0x6f, 0x7f, 0x35, 0xe1,
+	0x73, 0x81, 0xbc, 0xab, 0x86, 0x85, 0xe6, 0xad, 0x15, 0xcd, 0xe1, 0x8a, 0xe6, 0xed, 0x4d, 0x9a,
+	0xff, 0xa0, 0xc1, 0xce, 0xd1, 0x3c, 0xc2, 0x21, 0xf5, 0xef, 0x10, 0xf2, 0x6a, 0x34, 0xbf, 0x0f,
+	0xdb, 0x52, 0x73, 0x41, 0xe3, 0x91, 0x8f, 0xe3, 0x97, 0x50, 0x5d, 0x5a, 0x66, 0x48, 0xe3, 0xdb,
+	0x38, 0xbe, 0xec, 0x75, 0x46, 0x88, 0xea, 0xa5, 0xbd, 0x54, 0xaf, 0x3b, 0x84, 0xc8, 0x5e, 0xa5,
+	0x85, 0xea, 0x2f, 0xb6, 0x50, 0x63, 0xdd, 0x42, 0xcd, 0xeb, 0xb3, 0x90, 0xbe, 0xc1, 0x42, 0xad,
+	0xff, 0xc5, 0x42, 0x70, 0xc5, 0x42, 0xed, 0x15, 0x0b, 0x75, 0x36, 0x59, 0xe8, 0x11, 0xec, 0x1e,
+	0x67, 0x82, 0x44, 0x9c, 0xb2, 0xe8, 0x83, 0x58, 0xad, 0x9a, 0xa5, 0x55, 0xb0, 0x07, 0x5b, 0x52,
+	0x8c, 0x18, 0xcf, 0x49, 0x52, 0x1a, 0x6a, 0xfb, 0x59, 0x6e, 0x6e, 0xcd, 0x71, 0x38, 0x3d, 0xb0,
+	0xae, 0x20, 0xcb, 0xd3, 0xcf, 0x08, 0x79, 0x28, 0xc3, 0xf2, 0x1b, 0xfe, 0x0d, 0x80, 0x37, 0x57,
+	0xb6, 0x8a, 0x47, 0x78, 0xcc, 0x22, 0xae, 0xb8, 0x51, 0x8b, 0x01, 0x14, 0xdf, 0x7d, 0x19, 0xa3,
+	0xb7, 0xa1, 0x36, 0x65, 0x01, 0x37, 0xaa, 0x8a, 0x97, 0x9b, 0xeb, 0xbc, 0x9c, 0xb0, 0xc0, 0x53,
+	0x25, 0x68, 0x0b, 0xd6, 0x12, 0x22, 0x94, 0xcd, 0x3a, 0x9e, 0x0c, 0xd1, 0x0e, 0xd4, 0xd3, 0x70,
+	0x44, 0x92, 0x84, 0x25, 0xe5, 0x87, 0xba, 0x99, 0x86, 0xc7, 0x32, 0x95, 0x90, 0xf4, 0xd3, 0x8c,
+	0x93, 0x71, 0x61, 0x04, 0xaf, 0x19, 0x60, 0xfe, 0x88, 0x93, 0x71, 0x31, 0xe6, 0xfe, 0x67, 0x00,
+	0xd6, 0x1e, 0xf0, 0x00, 0x7d, 0x02, 0xe1, 0xd2, 0xad, 0xcd, 0xf5, 0x01, 0x56, 0xee, 0xd2, 0x7d,
+	0xeb, 0x6f, 0x0a, 0x2e, 0x2f, 0x6b, 0xbd, 0xf1, 0xe9, 0x4f, 0xbf, 0x7f, 0x55, 0x35, 0xad, 0x5b,
+	0xce, 0xfa, 0x06, 0x2e, 0xab, 0x47, 0x22, 0x73, 0xdd, 0x27, 0x8b, 0x1e, 0x78, 0xba, 0xe8, 0x81,
+	0xdf, 0x16, 0x3d, 0xf0, 0xe5, 0x79, 0xaf, 0xf2, 0xf4, 0xbc, 0x57, 0xf9, 0xf9, 0xbc, 0x57, 0xf9,
+	0x70, 0xb0, 0x64, 0x41, 0x31, 0xc1, 0x09, 0xa7, 0x7c, 0xa9, 0x55, 0xa6, 0x9a, 0x29, 0x23, 0x9e,
+	0x36, 0xd4, 0x7e, 0x7e, 0xf7, 0xcf, 0x01, 0x00, 0xfd, 0xce, 0x2d, 0x11, 0x83, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -906,6 +911,13 @@ func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1166,6 +1178,10 @@ func (m *ExtensionOptionsEthereumTx) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2555,6 +2571,38 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -35,6 +35,10 @@ type TransactionArgs struct {
 	// Introduced by AccessListTxType transaction.
 	AccessList *ethtypes.AccessList `json:"accessList,omitempty"`
 	ChainID    *hexutil.Big         `json:"chainId,omitempty"`
+
+	// FeePayer is the optional account paying the fees on behalf of the sender, through a fee allowance
+	// granted to the sender. It's not part of the ethereum tx, and doesn't change the gas estimation.
+	FeePayer *common.Address `json:"feePayer,omitempty"`
 }
 
 // String return the struct in a string format
@@ -15,6 +15,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	k.ProcessRecordExpiryQueue(ctx)
 	k.ProcessAuthorityExpiryQueue(ctx)
+	k.PruneBlockChangeSets(ctx)
 
 	return []abci.ValidatorUpdate{}
 }
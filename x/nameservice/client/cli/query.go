@@ -9,9 +9,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
+	"github.com/tharsis/ethermint/x/nameservice/keeper"
 	"github.com/tharsis/ethermint/x/nameservice/types"
 )
 
+const (
+	FlagFromHeight = "from"
+	FlagToHeight   = "to"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	bondQueryCmd := &cobra.Command{
@@ -33,6 +39,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryByBond(),
 		GetCmdBalance(),
 		GetCmdNames(),
+		GetCmdChanges(),
 	)
 	return bondQueryCmd
 }
@@ -396,3 +403,62 @@ $ %s query %s authority-expiry
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdChanges gets the block changesets of a range of heights.
+func GetCmdChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "changes",
+		Short: "Get records, names, authorities and auctions changed in a range of blocks.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get records, names, authorities and auctions changed in a range of blocks.
+The range is inclusive, --to defaults to the latest height.
+Example:
+$ %s query %s changes --from 100 --to 200
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+			if err != nil {
+				return err
+			}
+
+			toHeight, err := cmd.Flags().GetInt64(FlagToHeight)
+			if err != nil {
+				return err
+			}
+
+			// Page through the range, responses cover at most keeper.MaxBlockChangeSetsRange blocks.
+			queryClient := types.NewQueryClient(clientCtx)
+			res := &types.QueryGetBlockChangeSetsResponse{BlockChangeSets: []*types.BlockChangeSet{}}
+			for {
+				page, err := queryClient.GetBlockChangeSets(cmd.Context(), &types.QueryGetBlockChangeSetsRequest{FromHeight: fromHeight, ToHeight: toHeight})
+				if err != nil {
+					return err
+				}
+
+				res.BlockChangeSets = append(res.BlockChangeSets, page.BlockChangeSets...)
+				res.ToHeight = page.ToHeight
+				if page.ToHeight-fromHeight+1 < keeper.MaxBlockChangeSetsRange || page.ToHeight == toHeight {
+					break
+				}
+
+				fromHeight = page.ToHeight + 1
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 1, "First block height (inclusive).")
+	cmd.Flags().Int64(FlagToHeight, 0, "Last block height (inclusive), defaults to the latest height.")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -520,6 +520,55 @@ func (s *IntegrationTestSuite) TestGetAuthorityExpiryQueue() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdChanges() {
+	val := s.network.Validators[0]
+	sr := s.Require()
+	var authorityName = "TestGetCmdChanges"
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr bool
+	}{
+		{
+			"invalid height range",
+			[]string{"--from=10", "--to=5", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+		},
+		{
+			"changes up to the latest height",
+			[]string{"--from=1", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+		},
+	}
+
+	createNameRecord(authorityName, s)
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdChanges()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expErr {
+				sr.Error(err)
+			} else {
+				sr.NoError(err)
+				var response types.QueryGetBlockChangeSetsResponse
+				err = clientCtx.Codec.UnmarshalJSON(out.Bytes(), &response)
+				sr.NoError(err)
+				sr.NotZero(response.ToHeight)
+
+				var authorities []string
+				for _, changeSet := range response.GetBlockChangeSets() {
+					authorities = append(authorities, changeSet.Authorities...)
+				}
+				sr.Contains(authorities, authorityName)
+			}
+		})
+	}
+}
+
 func createNameRecord(authorityName string, s *IntegrationTestSuite) {
 	val := s.network.Validators[0]
 	sr := s.Require()
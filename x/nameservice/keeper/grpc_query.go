@@ -14,6 +14,12 @@ const BondIDAttributeName = "bondId"
 // ExpiryTimeAttributeName denotes the record expiry time.
 const ExpiryTimeAttributeName = "expiryTime"
 
+// MaxBatchQuerySize is the maximum number of records or names in a batch query.
+const MaxBatchQuerySize = 1000
+
+// MaxBlockChangeSetsRange is the maximum number of blocks covered by a GetBlockChangeSets query response.
+const MaxBlockChangeSetsRange = 1000
+
 type Querier struct {
 	Keeper
 }
@@ -49,7 +55,7 @@ func (q Querier) GetRecord(c context.Context, req *types.QueryRecordByIdRequest)
 	if !q.Keeper.HasRecord(ctx, id) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Record not found.")
 	}
-	record := q.Keeper.GetRecord(ctx, id)
+	record := recordObjToRecord(ctx.KVStore(q.Keeper.storeKey), q.Keeper.cdc, q.Keeper.GetRecord(ctx, id))
 	return &types.QueryRecordByIdResponse{Record: record}, nil
 }
 
@@ -102,6 +108,62 @@ func (q Querier) ResolveCrn(c context.Context, req *types.QueryResolveCrn) (*typ
 	return &types.QueryResolveCrnResponse{Record: record}, nil
 }
 
+func (q Querier) GetRecordsByIds(c context.Context, req *types.QueryGetRecordsByIdsRequest) (*types.QueryGetRecordsByIdsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	ids := req.GetIds()
+	if len(ids) > MaxBatchQuerySize {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "too many ids, max %d", MaxBatchQuerySize)
+	}
+
+	store := ctx.KVStore(q.Keeper.storeKey)
+	records := make([]types.Record, len(ids))
+	for i, id := range ids {
+		if q.Keeper.HasRecord(ctx, id) {
+			records[i] = recordObjToRecord(store, q.Keeper.cdc, q.Keeper.GetRecord(ctx, id))
+		}
+	}
+
+	return &types.QueryGetRecordsByIdsResponse{Records: records}, nil
+}
+
+func (q Querier) LookupCrns(c context.Context, req *types.QueryLookupCrnsRequest) (*types.QueryLookupCrnsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	crns := req.GetCrns()
+	if len(crns) > MaxBatchQuerySize {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "too many CRNs, max %d", MaxBatchQuerySize)
+	}
+
+	names := make([]types.NameRecord, len(crns))
+	for i, crn := range crns {
+		if !q.Keeper.HasNameRecord(ctx, crn) {
+			continue
+		}
+
+		if nameRecord := q.Keeper.GetNameRecord(ctx, crn); nameRecord != nil {
+			names[i] = *nameRecord
+		}
+	}
+
+	return &types.QueryLookupCrnsResponse{Names: names}, nil
+}
+
+func (q Querier) ResolveCrns(c context.Context, req *types.QueryResolveCrnsRequest) (*types.QueryResolveCrnsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	crns := req.GetCrns()
+	if len(crns) > MaxBatchQuerySize {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "too many CRNs, max %d", MaxBatchQuerySize)
+	}
+
+	records := make([]types.Record, len(crns))
+	for i, crn := range crns {
+		if record := q.Keeper.ResolveCRN(ctx, crn); record != nil {
+			records[i] = *record
+		}
+	}
+
+	return &types.QueryResolveCrnsResponse{Records: records}, nil
+}
+
 func (q Querier) GetRecordExpiryQueue(c context.Context, _ *types.QueryGetRecordExpiryQueue) (*types.QueryGetRecordExpiryQueueResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	records := q.Keeper.GetRecordExpiryQueue(ctx)
@@ -114,6 +176,74 @@ func (q Querier) GetAuthorityExpiryQueue(c context.Context, _ *types.QueryGetAut
 	return &types.QueryGetAuthorityExpiryQueueResponse{Authorities: authorities}, nil
 }
 
+func (q Querier) GetBlockChangeSets(c context.Context, req *types.QueryGetBlockChangeSetsRequest) (*types.QueryGetBlockChangeSetsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := q.checkChangeSetsStored(ctx); err != nil {
+		return nil, err
+	}
+
+	fromHeight, toHeight, err := getBlockChangeSetsRange(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if toHeight-fromHeight >= MaxBlockChangeSetsRange {
+		toHeight = fromHeight + MaxBlockChangeSetsRange - 1
+	}
+
+	changeSets := q.Keeper.GetBlockChangeSets(ctx, fromHeight, toHeight)
+	return &types.QueryGetBlockChangeSetsResponse{BlockChangeSets: changeSets, ToHeight: toHeight}, nil
+}
+
+func (q Querier) StreamBlockChangeSets(req *types.QueryGetBlockChangeSetsRequest, stream types.Query_StreamBlockChangeSetsServer) error {
+	ctx := sdk.UnwrapSDKContext(stream.Context())
+	if err := q.checkChangeSetsStored(ctx); err != nil {
+		return err
+	}
+
+	fromHeight, toHeight, err := getBlockChangeSetsRange(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	q.Keeper.IterateBlockChangeSets(ctx, fromHeight, toHeight, func(changeSet *types.BlockChangeSet) bool {
+		err = stream.Send(changeSet)
+		return err != nil
+	})
+
+	return err
+}
+
+// checkChangeSetsStored returns an error in the events mode, so that clients don't mistake the changesets
+// that aren't stored for blocks without changes.
+func (q Querier) checkChangeSetsStored(ctx sdk.Context) error {
+	if getChangeSetMode(ctx, q.Keeper.paramSubspace) == types.ChangeSetModeEvents {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Changesets disabled in events mode.")
+	}
+
+	return nil
+}
+
+// getBlockChangeSetsRange validates the requested height range, the end of the range defaults to the latest height.
+// The returned range is empty if it starts after the latest height.
+func getBlockChangeSetsRange(ctx sdk.Context, req *types.QueryGetBlockChangeSetsRequest) (int64, int64, error) {
+	fromHeight, toHeight := req.GetFromHeight(), req.GetToHeight()
+	if fromHeight < 0 || toHeight < 0 {
+		return 0, 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Height cannot be negative.")
+	}
+
+	if toHeight != 0 && toHeight < fromHeight {
+		return 0, 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Invalid height range.")
+	}
+
+	// Ranges past the latest height are cut short, so that clients can poll for new blocks.
+	if toHeight == 0 || toHeight > ctx.BlockHeight() {
+		toHeight = ctx.BlockHeight()
+	}
+
+	return fromHeight, toHeight, nil
+}
+
 func matchOnRecordField(record *types.RecordType, attr *types.QueryListRecordsRequest_KeyValueInput) (fieldFound bool, matched bool) {
 	fieldFound = false
 	matched = true
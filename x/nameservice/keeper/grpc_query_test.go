@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"os"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tharsis/ethermint/x/nameservice/client/cli"
+	nameservicekeeper "github.com/tharsis/ethermint/x/nameservice/keeper"
 	nameservicetypes "github.com/tharsis/ethermint/x/nameservice/types"
+	"google.golang.org/grpc"
 )
 
 func (suite *KeeperTestSuite) TestGrpcQueryParams() {
@@ -270,3 +273,144 @@ func (suite *KeeperTestSuite) TestGrpcQueryWhoIS() {
 		})
 	}
 }
+
+// changeSetStream collects the changesets sent by StreamBlockChangeSets.
+type changeSetStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	changeSets []*nameservicetypes.BlockChangeSet
+}
+
+func (s *changeSetStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *changeSetStream) Send(changeSet *nameservicetypes.BlockChangeSet) error {
+	s.changeSets = append(s.changeSets, changeSet)
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestGrpcGetBlockChangeSets() {
+	sr := suite.Require()
+	querier := nameservicekeeper.Querier{Keeper: suite.app.NameServiceKeeper}
+
+	for _, height := range []int64{2, 5, 9} {
+		suite.app.NameServiceKeeper.PutRecord(suite.ctx.WithBlockHeight(height), nameservicetypes.Record{Id: fmt.Sprintf("record-%d", height)})
+	}
+	ctx := sdk.WrapSDKContext(suite.ctx.WithBlockHeight(2 * nameservicekeeper.MaxBlockChangeSetsRange))
+
+	testCases := []struct {
+		msg        string
+		req        *nameservicetypes.QueryGetBlockChangeSetsRequest
+		expErr     bool
+		expHeights []int64
+		expTo      int64
+	}{
+		{
+			"all heights",
+			&nameservicetypes.QueryGetBlockChangeSetsRequest{FromHeight: 1},
+			false,
+			[]int64{2, 5, 9},
+			nameservicekeeper.MaxBlockChangeSetsRange,
+		},
+		{
+			"inclusive range",
+			&nameservicetypes.QueryGetBlockChangeSetsRequest{FromHeight: 2, ToHeight: 5},
+			false,
+			[]int64{2, 5},
+			5,
+		},
+		{
+			"range past the latest height",
+			&nameservicetypes.QueryGetBlockChangeSetsRequest{FromHeight: 3 * nameservicekeeper.MaxBlockChangeSetsRange},
+			false,
+			[]int64{},
+			2 * nameservicekeeper.MaxBlockChangeSetsRange,
+		},
+		{
+			"invalid range",
+			&nameservicetypes.QueryGetBlockChangeSetsRequest{FromHeight: 5, ToHeight: 2},
+			true,
+			nil,
+			0,
+		},
+		{
+			"range cut short",
+			&nameservicetypes.QueryGetBlockChangeSetsRequest{FromHeight: 3, ToHeight: nameservicekeeper.MaxBlockChangeSetsRange + 10},
+			false,
+			[]int64{5, 9},
+			nameservicekeeper.MaxBlockChangeSetsRange + 2,
+		},
+	}
+	for _, test := range testCases {
+		suite.Run(fmt.Sprintf("Case %s ", test.msg), func() {
+			resp, err := querier.GetBlockChangeSets(ctx, test.req)
+			if test.expErr {
+				sr.Error(err)
+				return
+			}
+
+			sr.NoError(err)
+			heights := []int64{}
+			for _, changeSet := range resp.BlockChangeSets {
+				heights = append(heights, changeSet.Height)
+				sr.Equal([]string{fmt.Sprintf("record-%d", changeSet.Height)}, changeSet.Records)
+			}
+			sr.Equal(test.expHeights, heights)
+			sr.Equal(test.expTo, resp.ToHeight)
+		})
+	}
+
+	// Streams aren't limited to MaxBlockChangeSetsRange.
+	stream := &changeSetStream{ctx: ctx}
+	err := querier.StreamBlockChangeSets(&nameservicetypes.QueryGetBlockChangeSetsRequest{FromHeight: 3}, stream)
+	sr.NoError(err)
+	sr.Len(stream.changeSets, 2)
+	sr.Equal(int64(5), stream.changeSets[0].Height)
+	sr.Equal(int64(9), stream.changeSets[1].Height)
+
+	// Changesets aren't stored in the events mode, the queries fail instead of returning no changes.
+	params := suite.app.NameServiceKeeper.GetParams(suite.ctx)
+	params.ChangesetMode = nameservicetypes.ChangeSetModeEvents
+	suite.app.NameServiceKeeper.SetParams(suite.ctx, params)
+
+	_, err = querier.GetBlockChangeSets(ctx, &nameservicetypes.QueryGetBlockChangeSetsRequest{FromHeight: 1})
+	sr.ErrorContains(err, "events mode")
+	err = querier.StreamBlockChangeSets(&nameservicetypes.QueryGetBlockChangeSetsRequest{FromHeight: 1}, &changeSetStream{ctx: ctx})
+	sr.ErrorContains(err, "events mode")
+}
+
+func (suite *KeeperTestSuite) TestGrpcBatchQueries() {
+	grpcClient, ctx := suite.queryClient, suite.ctx
+	sr := suite.Require()
+
+	suite.app.NameServiceKeeper.PutRecord(ctx, nameservicetypes.Record{Id: "batch-record"})
+	suite.app.NameServiceKeeper.SetNameAuthority(ctx, "batch.test", &nameservicetypes.NameAuthority{Status: nameservicetypes.AuthorityActive})
+	suite.app.NameServiceKeeper.SetNameRecord(ctx, "crn://batch.test/app", "batch-record")
+
+	records, err := grpcClient.GetRecordsByIds(context.Background(), &nameservicetypes.QueryGetRecordsByIdsRequest{Ids: []string{"missing", "batch-record"}})
+	sr.NoError(err)
+	sr.Len(records.Records, 2)
+	sr.Empty(records.Records[0].Id)
+	sr.Equal("batch-record", records.Records[1].Id)
+	sr.Equal([]string{"crn://batch.test/app"}, records.Records[1].Names)
+
+	record, err := grpcClient.GetRecord(context.Background(), &nameservicetypes.QueryRecordByIdRequest{Id: "batch-record"})
+	sr.NoError(err)
+	sr.Equal([]string{"crn://batch.test/app"}, record.Record.Names)
+
+	names, err := grpcClient.LookupCrns(context.Background(), &nameservicetypes.QueryLookupCrnsRequest{Crns: []string{"crn://batch.test/app", "crn://batch.test/missing"}})
+	sr.NoError(err)
+	sr.Len(names.Names, 2)
+	sr.Equal("batch-record", names.Names[0].Latest.Id)
+	sr.Nil(names.Names[1].Latest)
+
+	resolved, err := grpcClient.ResolveCrns(context.Background(), &nameservicetypes.QueryResolveCrnsRequest{Crns: []string{"crn://batch.test/missing", "crn://batch.test/app"}})
+	sr.NoError(err)
+	sr.Len(resolved.Records, 2)
+	sr.Empty(resolved.Records[0].Id)
+	sr.Equal("batch-record", resolved.Records[1].Id)
+
+	_, err = grpcClient.GetRecordsByIds(context.Background(), &nameservicetypes.QueryGetRecordsByIdsRequest{Ids: make([]string, nameservicekeeper.MaxBatchQuerySize+1)})
+	sr.Error(err)
+}
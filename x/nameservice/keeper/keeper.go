@@ -69,6 +69,8 @@ type Keeper struct {
 	cdc codec.BinaryCodec // The wire codec for binary encoding/decoding.
 
 	paramSubspace paramtypes.Subspace
+
+	hooks types.RegistryHooks
 }
 
 // NewKeeper creates new instances of the nameservice Keeper
@@ -90,6 +92,17 @@ func NewKeeper(cdc codec.BinaryCodec, accountKeeper auth.AccountKeeper, bankKeep
 	}
 }
 
+// SetHooks sets the hooks notified of the registry changes.
+// It should be called only once during initialization, it panics if called more than once.
+func (k *Keeper) SetHooks(hooks types.RegistryHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set nameservice hooks twice")
+	}
+
+	k.hooks = hooks
+	return k
+}
+
 // GetRecordIndexKey Generates Bond ID -> Bond index key.
 func GetRecordIndexKey(id string) []byte {
 	return append(PrefixCIDToRecordIndex, []byte(id)...)
@@ -339,7 +352,7 @@ func (k Keeper) RecordExpiryQueueIterator(ctx sdk.Context, endTime time.Time) sd
 // GetAllExpiredRecords returns a concatenated list of all the timeslices before currTime.
 func (k Keeper) GetAllExpiredRecords(ctx sdk.Context, currTime time.Time) (expiredRecordCIDs []string) {
 	// Gets an iterator for all timeslices from time 0 until the current block header time.
-	itr := k.RecordExpiryQueueIterator(ctx, ctx.BlockHeader().Time)
+	itr := k.RecordExpiryQueueIterator(ctx, currTime)
 	defer itr.Close()
 
 	for ; itr.Valid(); itr.Next() {
@@ -367,7 +380,7 @@ func (k Keeper) ProcessRecordExpiryQueue(ctx sdk.Context) {
 			k.PutRecord(ctx, record)
 			k.DeleteRecordExpiryQueue(ctx, record)
 
-			return
+			continue
 		}
 
 		// Try to renew the record by taking rent.
@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
@@ -63,6 +65,125 @@ func TestParams(t *testing.T) {
 	require.True(t, params.Equal(expParams))
 }
 
+func (suite *KeeperTestSuite) putChangeSets(heights ...int64) {
+	for _, height := range heights {
+		ctx := suite.ctx.WithBlockHeight(height).WithBlockTime(time.Unix(height*10, 0))
+		suite.app.NameServiceKeeper.PutRecord(ctx, types.Record{Id: fmt.Sprintf("record-%d", height)})
+	}
+}
+
+func (suite *KeeperTestSuite) changeSetHeights() []int64 {
+	heights := []int64{}
+	for _, changeSet := range suite.app.NameServiceKeeper.GetBlockChangeSets(suite.ctx, 0, 1<<62) {
+		heights = append(heights, changeSet.Height)
+	}
+
+	return heights
+}
+
+func (suite *KeeperTestSuite) TestPruneBlockChangeSets() {
+	testCases := []struct {
+		msg        string
+		blocks     uint64
+		period     time.Duration
+		mode       string
+		expHeights []int64
+	}{
+		{"retention disabled", 0, 0, types.ChangeSetModeStore, []int64{2, 5, 9}},
+		{"retention in blocks", 5, 0, types.ChangeSetModeStore, []int64{9}},
+		{"retention period", 0, 60 * time.Second, types.ChangeSetModeStore, []int64{5, 9}},
+		{"both retentions", 8, 60 * time.Second, types.ChangeSetModeStore, []int64{5, 9}},
+		{"events mode", 0, 0, types.ChangeSetModeEvents, []int64{}},
+	}
+
+	for _, test := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", test.msg), func() {
+			suite.SetupTest()
+			suite.putChangeSets(2, 5, 9)
+
+			params := suite.app.NameServiceKeeper.GetParams(suite.ctx)
+			params.ChangesetRetentionBlocks = test.blocks
+			params.ChangesetRetentionPeriod = test.period
+			params.ChangesetMode = test.mode
+			suite.app.NameServiceKeeper.SetParams(suite.ctx, params)
+
+			suite.ctx = suite.ctx.WithBlockHeight(12).WithBlockTime(time.Unix(100, 0))
+			suite.app.NameServiceKeeper.PruneBlockChangeSets(suite.ctx)
+			suite.Require().Equal(test.expHeights, suite.changeSetHeights())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBlockChangeEvents() {
+	sr := suite.Require()
+
+	params := suite.app.NameServiceKeeper.GetParams(suite.ctx)
+	params.ChangesetMode = types.ChangeSetModeEvents
+	suite.app.NameServiceKeeper.SetParams(suite.ctx, params)
+
+	ctx := suite.ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	suite.app.NameServiceKeeper.PutRecord(ctx, types.Record{Id: "record-2"})
+	sr.Empty(suite.changeSetHeights())
+
+	events := ctx.EventManager().Events()
+	sr.Len(events, 1)
+	sr.Equal(types.EventTypeBlockChange, events[0].Type)
+	sr.Equal(types.AttributeKeyChangeKind, string(events[0].Attributes[0].Key))
+	sr.Equal(types.BlockChangeKindRecord, string(events[0].Attributes[0].Value))
+	sr.Equal(types.AttributeKeyChangeId, string(events[0].Attributes[1].Key))
+	sr.Equal("record-2", string(events[0].Attributes[1].Value))
+}
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	suite.putChangeSets(2, 5, 9)
+
+	suite.ctx = suite.ctx.WithBlockHeight(int64(types.DefaultChangeSetRetentionBlocks) + 5)
+	migrator := nameservicekeeper.NewMigrator(suite.app.NameServiceKeeper)
+	suite.Require().NoError(migrator.Migrate1to2(suite.ctx))
+
+	suite.Require().Equal([]int64{9}, suite.changeSetHeights())
+	suite.Require().True(suite.app.NameServiceKeeper.GetParams(suite.ctx).Equal(types.DefaultParams()))
+}
+
+func (suite *KeeperTestSuite) TestProcessExpiryQueues() {
+	k := suite.app.NameServiceKeeper
+	expiryTime := time.Unix(1000, 0).UTC()
+	ctx := suite.ctx.WithBlockTime(expiryTime.Add(time.Second))
+
+	// the first entries have no bond, the entries after them are still processed
+	for _, record := range []types.Record{
+		{Id: "record-1", ExpiryTime: expiryTime.Format(time.RFC3339)},
+		{Id: "record-2", BondId: suite.bond.Id, ExpiryTime: expiryTime.Format(time.RFC3339)},
+	} {
+		k.PutRecord(ctx, record)
+		k.InsertRecordExpiryQueue(ctx, record)
+	}
+	for name, bondID := range map[string]string{"authority-1": "", "authority-2": suite.bond.Id} {
+		k.SetNameAuthority(ctx, name, &types.NameAuthority{BondId: bondID, Status: types.AuthorityActive, ExpiryTime: expiryTime})
+	}
+	k.InsertAuthorityExpiryQueue(ctx, "authority-1", expiryTime)
+	k.InsertAuthorityExpiryQueue(ctx, "authority-2", expiryTime)
+
+	k.ProcessRecordExpiryQueue(ctx)
+	k.ProcessAuthorityExpiryQueue(ctx)
+
+	params := k.GetParams(ctx)
+	renewedTime := ctx.BlockTime().Add(params.RecordRentDuration)
+
+	suite.Require().True(k.GetRecord(ctx, "record-1").Deleted)
+	record := k.GetRecord(ctx, "record-2")
+	suite.Require().False(record.Deleted)
+	suite.Require().Equal(renewedTime.Format(time.RFC3339), record.ExpiryTime)
+	suite.Require().Empty(k.GetAllExpiredRecords(ctx, ctx.BlockTime()))
+	suite.Require().Equal([]string{"record-2"}, k.GetRecordExpiryQueueTimeSlice(ctx, renewedTime))
+
+	suite.Require().Equal(types.AuthorityExpired, k.GetNameAuthority(ctx, "authority-1").Status)
+	authority := k.GetNameAuthority(ctx, "authority-2")
+	suite.Require().Equal(types.AuthorityActive, authority.Status)
+	suite.Require().Equal(ctx.BlockTime().Add(params.AuthorityRentDuration), authority.ExpiryTime)
+	suite.Require().Empty(k.GetAllExpiredAuthorities(ctx, ctx.BlockTime()))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
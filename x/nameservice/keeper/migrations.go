@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/ethermint/x/nameservice/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates the store from consensus version v1 to v2. It sets the changeset
+// retention params and deletes the stored changesets that are past the retention.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramSubspace.Set(ctx, types.KeyChangeSetRetentionBlocks, types.DefaultChangeSetRetentionBlocks)
+	m.keeper.paramSubspace.Set(ctx, types.KeyChangeSetRetentionPeriod, types.DefaultChangeSetRetentionPeriod)
+	m.keeper.paramSubspace.Set(ctx, types.KeyChangeSetMode, types.DefaultChangeSetMode)
+
+	m.keeper.pruneBlockChangeSets(ctx, m.keeper.GetParams(ctx), 0)
+	return nil
+}
@@ -20,7 +20,7 @@ var _ types.MsgServer = msgServer{}
 
 func (m msgServer) SetRecord(c context.Context, msg *types.MsgSetRecord) (*types.MsgSetRecordResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
 		return nil, err
 	}
@@ -34,6 +34,12 @@ func (m msgServer) SetRecord(c context.Context, msg *types.MsgSetRecord) (*types
 		return nil, err
 	}
 
+	if m.hooks != nil {
+		if err := m.hooks.AfterRecordSet(ctx, signer, *record); err != nil {
+			return nil, err
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeSetRecord,
@@ -53,7 +59,7 @@ func (m msgServer) SetRecord(c context.Context, msg *types.MsgSetRecord) (*types
 
 func (m msgServer) SetName(c context.Context, msg *types.MsgSetName) (*types.MsgSetNameResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +67,12 @@ func (m msgServer) SetName(c context.Context, msg *types.MsgSetName) (*types.Msg
 	if err != nil {
 		return nil, err
 	}
+
+	if m.hooks != nil {
+		if err := m.hooks.AfterNameSet(ctx, signer, msg.Crn, msg.Cid); err != nil {
+			return nil, err
+		}
+	}
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeSetRecord,
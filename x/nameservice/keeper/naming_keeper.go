@@ -39,12 +39,13 @@ func GetCIDToNamesIndexKey(id string) []byte {
 
 func SetNameAuthority(ctx sdk.Context, store sdk.KVStore, codec codec.BinaryCodec, name string, authority *types.NameAuthority) {
 	store.Set(GetNameAuthorityIndexKey(name), codec.MustMarshal(authority))
-	updateBlockChangeSetForNameAuthority(ctx, codec, store, name)
 }
 
 // SetNameAuthority creates the NameAuthority record.
 func (k Keeper) SetNameAuthority(ctx sdk.Context, name string, authority *types.NameAuthority) {
-	SetNameAuthority(ctx, ctx.KVStore(k.storeKey), k.cdc, name, authority)
+	store := ctx.KVStore(k.storeKey)
+	SetNameAuthority(ctx, store, k.cdc, name, authority)
+	updateBlockChangeSetForNameAuthority(ctx, k.cdc, store, k.paramSubspace, name)
 }
 
 func removeAuctionToAuthorityMapping(store sdk.KVStore, auctionID string) {
@@ -104,9 +105,9 @@ func RemoveBondToAuthorityIndexEntry(store sdk.KVStore, bondID string, name stri
 }
 
 func (k Keeper) updateBlockChangeSetForName(ctx sdk.Context, crn string) {
-	changeSet := k.getOrCreateBlockChangeSet(ctx, ctx.BlockHeight())
-	changeSet.Names = append(changeSet.Names, crn)
-	k.saveBlockChangeSet(ctx, changeSet)
+	updateBlockChangeSet(ctx, ctx.KVStore(k.storeKey), k.cdc, k.paramSubspace, types.BlockChangeKindName, crn, func(changeSet *types.BlockChangeSet) {
+		changeSet.Names = append(changeSet.Names, crn)
+	})
 }
 
 func (k Keeper) getAuthority(ctx sdk.Context, crn string) (string, *url.URL, *types.NameAuthority, error) {
@@ -149,6 +150,10 @@ func (k Keeper) checkCRNAccess(ctx sdk.Context, signer sdk.AccAddress, crn strin
 	if authority.OwnerPublicKey == "" {
 		// Try to set owner public key if account has it available now.
 		ownerAccount := k.accountKeeper.GetAccount(ctx, signer)
+		if ownerAccount == nil {
+			return nil
+		}
+
 		pubKey := ownerAccount.GetPubKey()
 		if pubKey != nil {
 			// Update public key in authority record.
@@ -537,7 +542,7 @@ func (k Keeper) ResolveCRN(ctx sdk.Context, crn string) *types.Record {
 	// Name should not resolve if it's stale.
 	// i.e. authority was registered later than the name.
 	record, nameRecord := ResolveCRN(ctx.KVStore(k.storeKey), crn, k, ctx)
-	if authority.Height > nameRecord.Latest.Height {
+	if nameRecord == nil || authority.Height > nameRecord.Latest.Height {
 		return nil
 	}
 
@@ -612,7 +617,7 @@ func (k Keeper) ProcessAuthorityExpiryQueue(ctx sdk.Context) {
 
 			ctx.Logger().Info(fmt.Sprintf("Marking authority expired as no bond present: %s", name))
 
-			return
+			continue
 		}
 
 		// Try to renew the authority by taking rent.
@@ -647,7 +652,7 @@ func (k Keeper) DeleteAuthorityExpiryQueue(ctx sdk.Context, name string, authori
 // GetAllExpiredAuthorities returns a concatenated list of all the timeslices before currTime.
 func (k Keeper) GetAllExpiredAuthorities(ctx sdk.Context, currTime time.Time) (expiredAuthorityNames []string) {
 	// Gets an iterator for all timeslices from time 0 until the current block header time.
-	itr := k.AuthorityExpiryQueueIterator(ctx, ctx.BlockHeader().Time)
+	itr := k.AuthorityExpiryQueueIterator(ctx, currTime)
 	defer itr.Close()
 
 	for ; itr.Valid(); itr.Next() {
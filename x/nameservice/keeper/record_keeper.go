@@ -8,6 +8,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	auctionkeeper "github.com/tharsis/ethermint/x/auction/keeper"
 	auctiontypes "github.com/tharsis/ethermint/x/auction/types"
 	bondtypes "github.com/tharsis/ethermint/x/bond/types"
@@ -19,6 +20,7 @@ type RecordKeeper struct {
 	auctionKeeper auctionkeeper.Keeper
 	storeKey      storetypes.StoreKey // Unexposed key to access store from sdk.Context
 	cdc           codec.BinaryCodec   // The wire codec for binary encoding/decoding.
+	paramSubspace paramtypes.Subspace
 }
 
 func (k RecordKeeper) UsesAuction(ctx sdk.Context, auctionID string) bool {
@@ -80,6 +82,7 @@ func (k RecordKeeper) OnAuctionWinnerSelected(ctx sdk.Context, auctionID string)
 
 		authority.AuctionId = ""
 		SetNameAuthority(ctx, store, k.cdc, name, &authority)
+		updateBlockChangeSetForNameAuthority(ctx, k.cdc, store, k.paramSubspace, name)
 
 		// Forget about this auction now, we no longer need it.
 		removeAuctionToAuthorityMapping(store, auctionID)
@@ -108,6 +111,11 @@ func (k RecordKeeper) GetAuctionToAuthorityMapping(ctx sdk.Context, auctionID st
 	return ""
 }
 
+// GetNameAuthority - gets a name authority from the store.
+func (k RecordKeeper) GetNameAuthority(ctx sdk.Context, name string) types.NameAuthority {
+	return GetNameAuthority(ctx.KVStore(k.storeKey), k.cdc, name)
+}
+
 // UsesBond returns true if the bond has associated records.
 func (k RecordKeeper) UsesBond(ctx sdk.Context, bondId string) bool {
 	bondIDPrefix := append(PrefixBondIDToRecordsIndex, []byte(bondId)...)
@@ -124,11 +132,16 @@ func (k Keeper) RemoveBondToRecordIndexEntry(ctx sdk.Context, bondID string, id
 }
 
 // NewRecordKeeper creates new instances of the nameservice RecordKeeper
-func NewRecordKeeper(auctionKeeper auctionkeeper.Keeper, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) RecordKeeper {
+func NewRecordKeeper(auctionKeeper auctionkeeper.Keeper, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, ps paramtypes.Subspace) RecordKeeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
 	return RecordKeeper{
 		auctionKeeper: auctionKeeper,
 		storeKey:      storeKey,
 		cdc:           cdc,
+		paramSubspace: ps,
 	}
 }
 
@@ -3,97 +3,171 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tharsis/ethermint/x/nameservice/helpers"
 	"github.com/tharsis/ethermint/x/nameservice/types"
 )
 
+// maxPrunedBlockChangeSets bounds the number of changesets deleted by the EndBlocker in a single block.
+// At most one changeset is added per block, so a backlog (e.g. after lowering the retention) is still worked off.
+const maxPrunedBlockChangeSets = 100
+
 func GetBlockChangeSetIndexKey(height int64) []byte {
 	return append(PrefixBlockChangesetIndex, helpers.Int64ToBytes(height)...)
 }
 
-func getOrCreateBlockChangeset(store sdk.KVStore, codec codec.BinaryCodec, height int64) *types.BlockChangeSet {
-	bz := store.Get(GetBlockChangeSetIndexKey(height))
+func getOrCreateBlockChangeset(ctx sdk.Context, store sdk.KVStore, codec codec.BinaryCodec) *types.BlockChangeSet {
+	bz := store.Get(GetBlockChangeSetIndexKey(ctx.BlockHeight()))
 
 	if bz != nil {
 		var changeSet types.BlockChangeSet
-		err := codec.Unmarshal(bz, &changeSet)
-		if err != nil {
-			return nil
-		}
+		codec.MustUnmarshal(bz, &changeSet)
 		return &changeSet
 	}
 
 	return &types.BlockChangeSet{
-		Height:      height,
+		Height:      ctx.BlockHeight(),
 		Records:     []string{},
 		Names:       []string{},
 		Auctions:    []string{},
 		AuctionBids: []*types.AuctionBidInfo{},
+		Time:        ctx.BlockTime(),
 	}
 }
 
-func updateBlockChangeSetForAuction(ctx sdk.Context, k RecordKeeper, id string) {
-	changeSet := getOrCreateBlockChangeset(ctx.KVStore(k.storeKey), k.cdc, ctx.BlockHeight())
+func saveBlockChangeSet(store sdk.KVStore, codec codec.BinaryCodec, changeset *types.BlockChangeSet) {
+	bz := codec.MustMarshal(changeset)
+	store.Set(GetBlockChangeSetIndexKey(changeset.Height), bz)
+}
 
-	found := false
-	for _, elem := range changeSet.Auctions {
-		if id == elem {
-			found = true
-			break
-		}
+// getChangeSetMode returns the changeset mode param, defaulting to the store mode if it isn't set.
+func getChangeSetMode(ctx sdk.Context, paramSubspace paramtypes.Subspace) string {
+	mode := types.ChangeSetModeStore
+	paramSubspace.GetIfExists(ctx, types.KeyChangeSetMode, &mode)
+	return mode
+}
+
+// updateBlockChangeSet applies a change to the changeset of the current block. In the events mode, nothing is
+// stored and the change is emitted as a block-change event instead.
+func updateBlockChangeSet(ctx sdk.Context, store sdk.KVStore, codec codec.BinaryCodec, paramSubspace paramtypes.Subspace,
+	kind string, id string, update func(changeSet *types.BlockChangeSet), attributes ...sdk.Attribute) {
+	if getChangeSetMode(ctx, paramSubspace) == types.ChangeSetModeEvents {
+		attributes = append([]sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyChangeKind, kind),
+			sdk.NewAttribute(types.AttributeKeyChangeId, id),
+		}, attributes...)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeBlockChange, attributes...))
+		return
 	}
 
-	if !found {
+	changeSet := getOrCreateBlockChangeset(ctx, store, codec)
+	update(changeSet)
+	saveBlockChangeSet(store, codec, changeSet)
+}
+
+func updateBlockChangeSetForAuction(ctx sdk.Context, k RecordKeeper, id string) {
+	updateBlockChangeSet(ctx, ctx.KVStore(k.storeKey), k.cdc, k.paramSubspace, types.BlockChangeKindAuction, id, func(changeSet *types.BlockChangeSet) {
+		for _, elem := range changeSet.Auctions {
+			if id == elem {
+				return
+			}
+		}
+
 		changeSet.Auctions = append(changeSet.Auctions, id)
-		saveBlockChangeSet(ctx.KVStore(k.storeKey), k.cdc, changeSet)
-	}
+	})
 }
 
-func saveBlockChangeSet(store sdk.KVStore, codec codec.BinaryCodec, changeset *types.BlockChangeSet) {
-	bz := codec.MustMarshal(changeset)
-	store.Set(GetBlockChangeSetIndexKey(changeset.Height), bz)
+func (k Keeper) updateBlockChangeSetForRecord(ctx sdk.Context, id string) {
+	updateBlockChangeSet(ctx, ctx.KVStore(k.storeKey), k.cdc, k.paramSubspace, types.BlockChangeKindRecord, id, func(changeSet *types.BlockChangeSet) {
+		changeSet.Records = append(changeSet.Records, id)
+	})
 }
 
-func (k Keeper) saveBlockChangeSet(ctx sdk.Context, changeSet *types.BlockChangeSet) {
-	saveBlockChangeSet(ctx.KVStore(k.storeKey), k.cdc, changeSet)
+func updateBlockChangeSetForAuctionBid(ctx sdk.Context, k RecordKeeper, id, bidderAddress string) {
+	updateBlockChangeSet(ctx, ctx.KVStore(k.storeKey), k.cdc, k.paramSubspace, types.BlockChangeKindAuctionBid, id, func(changeSet *types.BlockChangeSet) {
+		changeSet.AuctionBids = append(changeSet.AuctionBids, &types.AuctionBidInfo{AuctionId: id, BidderAddress: bidderAddress})
+	}, sdk.NewAttribute(types.AttributeKeyBidderAddress, bidderAddress))
 }
 
-func (k Keeper) updateBlockChangeSetForRecord(ctx sdk.Context, id string) {
-	changeSet := k.getOrCreateBlockChangeSet(ctx, ctx.BlockHeight())
-	changeSet.Records = append(changeSet.Records, id)
-	k.saveBlockChangeSet(ctx, changeSet)
+func updateBlockChangeSetForNameAuthority(ctx sdk.Context, codec codec.BinaryCodec, store sdk.KVStore, paramSubspace paramtypes.Subspace, name string) {
+	updateBlockChangeSet(ctx, store, codec, paramSubspace, types.BlockChangeKindAuthority, name, func(changeSet *types.BlockChangeSet) {
+		changeSet.Authorities = append(changeSet.Authorities, name)
+	})
 }
 
-func (k Keeper) getOrCreateBlockChangeSet(ctx sdk.Context, height int64) *types.BlockChangeSet {
+// isBlockChangeSetExpired returns true if the changeset is past the retention configured in params.
+func isBlockChangeSetExpired(ctx sdk.Context, params types.Params, changeSet *types.BlockChangeSet) bool {
+	if params.ChangesetMode == types.ChangeSetModeEvents {
+		return true
+	}
+
+	if params.ChangesetRetentionBlocks > 0 && changeSet.Height <= ctx.BlockHeight()-int64(params.ChangesetRetentionBlocks) {
+		return true
+	}
+
+	return params.ChangesetRetentionPeriod > 0 && !changeSet.Time.Add(params.ChangesetRetentionPeriod).After(ctx.BlockTime())
+}
+
+// PruneBlockChangeSets deletes the oldest changesets that are past the retention, deleting at most
+// maxPrunedBlockChangeSets per block.
+func (k Keeper) PruneBlockChangeSets(ctx sdk.Context) {
+	k.pruneBlockChangeSets(ctx, k.GetParams(ctx), maxPrunedBlockChangeSets)
+}
+
+// pruneBlockChangeSets deletes up to limit (0 for no limit) changesets that are past the retention
+// and returns the number of deleted changesets.
+func (k Keeper) pruneBlockChangeSets(ctx sdk.Context, params types.Params, limit int) int {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(GetBlockChangeSetIndexKey(height))
+	itr := sdk.KVStorePrefixIterator(store, PrefixBlockChangesetIndex)
 
-	if bz != nil {
+	var expiredKeys [][]byte
+	for ; itr.Valid() && (limit == 0 || len(expiredKeys) < limit); itr.Next() {
 		var changeSet types.BlockChangeSet
-		err := k.cdc.Unmarshal(bz, &changeSet)
-		if err != nil {
-			return nil
+		k.cdc.MustUnmarshal(itr.Value(), &changeSet)
+
+		// Changesets are ordered by height, so the remaining ones are newer.
+		if !isBlockChangeSetExpired(ctx, params, &changeSet) {
+			break
 		}
-		return &changeSet
+
+		expiredKeys = append(expiredKeys, itr.Key())
 	}
+	itr.Close()
 
-	return &types.BlockChangeSet{
-		Height:      height,
-		Records:     []string{},
-		Names:       []string{},
-		Auctions:    []string{},
-		AuctionBids: []*types.AuctionBidInfo{},
+	for _, key := range expiredKeys {
+		store.Delete(key)
 	}
+
+	return len(expiredKeys)
 }
 
-func updateBlockChangeSetForAuctionBid(ctx sdk.Context, k RecordKeeper, id, bidderAddress string) {
-	changeSet := getOrCreateBlockChangeset(ctx.KVStore(k.storeKey), k.cdc, ctx.BlockHeight())
-	changeSet.AuctionBids = append(changeSet.AuctionBids, &types.AuctionBidInfo{AuctionId: id, BidderAddress: bidderAddress})
-	saveBlockChangeSet(ctx.KVStore(k.storeKey), k.cdc, changeSet)
+// IterateBlockChangeSets iterates over the changesets of blocks in [fromHeight, toHeight], in height order.
+func (k Keeper) IterateBlockChangeSets(ctx sdk.Context, fromHeight, toHeight int64, cb func(changeSet *types.BlockChangeSet) (stop bool)) {
+	if fromHeight > toHeight {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	itr := store.Iterator(GetBlockChangeSetIndexKey(fromHeight), GetBlockChangeSetIndexKey(toHeight+1))
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		var changeSet types.BlockChangeSet
+		k.cdc.MustUnmarshal(itr.Value(), &changeSet)
+
+		if cb(&changeSet) {
+			break
+		}
+	}
 }
 
-func updateBlockChangeSetForNameAuthority(ctx sdk.Context, codec codec.BinaryCodec, store sdk.KVStore, name string) {
-	changeSet := getOrCreateBlockChangeset(store, codec, ctx.BlockHeight())
-	changeSet.Authorities = append(changeSet.Authorities, name)
-	saveBlockChangeSet(store, codec, changeSet)
+// GetBlockChangeSets returns the changesets of blocks in [fromHeight, toHeight].
+func (k Keeper) GetBlockChangeSets(ctx sdk.Context, fromHeight, toHeight int64) []*types.BlockChangeSet {
+	changeSets := []*types.BlockChangeSet{}
+	k.IterateBlockChangeSets(ctx, fromHeight, toHeight, func(changeSet *types.BlockChangeSet) bool {
+		changeSets = append(changeSets, changeSet)
+		return false
+	})
+
+	return changeSets
 }
@@ -0,0 +1,111 @@
+package precompile
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	auctiontypes "github.com/tharsis/ethermint/x/auction/types"
+	bondtypes "github.com/tharsis/ethermint/x/bond/types"
+	"github.com/tharsis/ethermint/x/nameservice/keeper"
+	"github.com/tharsis/ethermint/x/nameservice/types"
+)
+
+// EVMKeeper defines the EVM keeper methods used by the log mirror.
+type EVMKeeper interface {
+	EmitNativeLogs(ctx sdk.Context, logs []*ethtypes.Log) error
+}
+
+// LogMirror mirrors the registry changes into EVM logs of the registry address (see the events of RegistryABI),
+// so that Ethereum indexers can follow the registry. It's notified of the records and names set, and the bonds
+// refilled, by the module messages, and of the authority auctions won. The names set by contracts are logged by
+// the registry precompile.
+type LogMirror struct {
+	evmKeeper    EVMKeeper
+	recordKeeper keeper.RecordKeeper
+}
+
+var (
+	_ types.RegistryHooks             = LogMirror{}
+	_ bondtypes.BondHooks             = LogMirror{}
+	_ auctiontypes.AuctionUsageKeeper = LogMirror{}
+)
+
+// NewLogMirror returns the registry log mirror.
+func NewLogMirror(evmKeeper EVMKeeper, recordKeeper keeper.RecordKeeper) LogMirror {
+	return LogMirror{evmKeeper: evmKeeper, recordKeeper: recordKeeper}
+}
+
+// AfterRecordSet implements types.RegistryHooks.
+func (m LogMirror) AfterRecordSet(ctx sdk.Context, signer sdk.AccAddress, record types.RecordType) error {
+	return m.emit(ctx, "RecordSet", common.BytesToAddress(signer), record.Id, record.BondId)
+}
+
+// AfterNameSet implements types.RegistryHooks.
+func (m LogMirror) AfterNameSet(ctx sdk.Context, signer sdk.AccAddress, crn string, id string) error {
+	return m.emit(ctx, "NameSet", common.BytesToAddress(signer), crn, id)
+}
+
+// AfterBondRefilled implements bondtypes.BondHooks.
+func (m LogMirror) AfterBondRefilled(ctx sdk.Context, signer sdk.AccAddress, bond bondtypes.Bond, coins sdk.Coins) error {
+	return m.emit(ctx, "BondRefilled", common.BytesToAddress(signer), bond.Id, coins.String())
+}
+
+// ModuleName implements auctiontypes.AuctionUsageKeeper.
+func (m LogMirror) ModuleName() string {
+	return types.ModuleName
+}
+
+// UsesAuction implements auctiontypes.AuctionUsageKeeper, the mirror only observes the auctions.
+func (m LogMirror) UsesAuction(ctx sdk.Context, auctionID string) bool {
+	return false
+}
+
+// OnAuction implements auctiontypes.AuctionUsageKeeper.
+func (m LogMirror) OnAuction(ctx sdk.Context, auctionID string) {}
+
+// OnAuctionBid implements auctiontypes.AuctionUsageKeeper.
+func (m LogMirror) OnAuctionBid(ctx sdk.Context, auctionID string, bidderAddress string) {}
+
+// OnAuctionWinnerSelected implements auctiontypes.AuctionUsageKeeper. It must be notified after the nameservice
+// record keeper, which activates the authority of the winner.
+func (m LogMirror) OnAuctionWinnerSelected(ctx sdk.Context, auctionID string) {
+	name := m.recordKeeper.GetAuctionToAuthorityMapping(ctx, auctionID)
+	if name == "" {
+		return
+	}
+
+	authority := m.recordKeeper.GetNameAuthority(ctx, name)
+	if authority.Status != types.AuthorityActive {
+		return
+	}
+
+	if err := m.emit(ctx, "AuthorityWon", toEthAddress(authority.OwnerAddress), name, auctionID); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("Failed to emit the authority log: %v", err))
+	}
+}
+
+func (m LogMirror) emit(ctx sdk.Context, event string, indexed common.Address, args ...interface{}) error {
+	log, err := newLog(event, indexed, args...)
+	if err != nil {
+		return err
+	}
+
+	return m.evmKeeper.EmitNativeLogs(ctx, []*ethtypes.Log{log})
+}
+
+// newLog returns a registry log of an event, with the indexed address as topic and the other arguments as data.
+func newLog(event string, indexed common.Address, args ...interface{}) (*ethtypes.Log, error) {
+	data, err := registryABI.Events[event].Inputs.NonIndexed().Pack(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethtypes.Log{
+		Address: RegistryAddress,
+		Topics:  []common.Hash{registryABI.Events[event].ID, common.BytesToHash(indexed.Bytes())},
+		Data:    data,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package precompile_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/tharsis/ethermint/app"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	"github.com/tharsis/ethermint/x/nameservice/precompile"
+)
+
+func TestLogMirror(t *testing.T) {
+	testApp := app.Setup(t, false, func(ea *app.EthermintApp, genesis simapp.GenesisState) simapp.GenesisState {
+		return genesis
+	})
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	registryABI, err := abi.JSON(strings.NewReader(precompile.RegistryABI))
+	require.NoError(t, err)
+
+	signer := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	mirror := precompile.NewLogMirror(testApp.EvmKeeper, testApp.NameServiceRecordKeeper)
+
+	require.NoError(t, mirror.AfterNameSet(ctx, sdk.AccAddress(signer.Bytes()), "crn://test/app", "record-1"))
+
+	var logs []*ethtypes.Log
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			var log evmtypes.Log
+			require.NoError(t, json.Unmarshal([]byte(attr.Value), &log))
+			logs = append(logs, log.ToEthereum())
+		}
+	}
+	require.Len(t, logs, 1)
+
+	event := registryABI.Events["NameSet"]
+	require.Equal(t, precompile.RegistryAddress, logs[0].Address)
+	require.Equal(t, []common.Hash{event.ID, common.BytesToHash(signer.Bytes())}, logs[0].Topics)
+	require.Equal(t, uint64(1), logs[0].BlockNumber)
+
+	// the logs are in the synthetic native logs transaction of the block
+	require.Equal(t, evmtypes.NativeLogsTxHash(1), logs[0].TxHash)
+	require.Equal(t, uint(evmtypes.NativeLogsTxIndex), logs[0].TxIndex)
+	require.Equal(t, uint(0), logs[0].Index)
+
+	args, err := event.Inputs.NonIndexed().Unpack(logs[0].Data)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"crn://test/app", "record-1"}, args)
+
+	// the logs are in the block bloom
+	bloom := ethtypes.BytesToBloom(testApp.EvmKeeper.GetBlockBloomTransient(ctx).Bytes())
+	require.True(t, ethtypes.BloomLookup(bloom, precompile.RegistryAddress))
+	require.True(t, ethtypes.BloomLookup(bloom, event.ID))
+	require.Equal(t, uint64(1), testApp.EvmKeeper.GetLogSizeTransient(ctx))
+
+	// the log indexes follow the logs of the block, the Ethereum transaction index isn't used
+	testApp.EvmKeeper.SetTxIndexTransient(ctx, 3)
+	require.NoError(t, mirror.AfterNameSet(ctx, sdk.AccAddress(signer.Bytes()), "crn://test/other", "record-1"))
+	events := ctx.EventManager().Events()
+	var log evmtypes.Log
+	require.NoError(t, json.Unmarshal([]byte(events[len(events)-1].Attributes[0].Value), &log))
+	require.Equal(t, uint64(1), log.Index)
+	require.Equal(t, uint64(evmtypes.NativeLogsTxIndex), log.TxIndex)
+}
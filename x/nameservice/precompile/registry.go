@@ -0,0 +1,260 @@
+// Package precompile implements the registry precompiled contract, which exposes the nameservice and
+// bond modules to the EVM, and the EVM logs of the registry changes.
+package precompile
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	bondkeeper "github.com/tharsis/ethermint/x/bond/keeper"
+	evmprecompile "github.com/tharsis/ethermint/x/evm/precompile"
+	"github.com/tharsis/ethermint/x/nameservice/helpers"
+	"github.com/tharsis/ethermint/x/nameservice/keeper"
+	"github.com/tharsis/ethermint/x/nameservice/types"
+)
+
+// RegistryAddress is the address of the registry precompile.
+var RegistryAddress = common.HexToAddress("0x0000000000000000000000000000000000000900")
+
+// Base gas costs of the registry calls, which also pay for their input size. The names set by setName
+// also cost the SDK gas consumed by MsgSetName.
+const (
+	BaseGas         uint64 = 2000
+	GasPerInputByte uint64 = 3
+)
+
+// RegistryABI is the Solidity interface of the registry precompile.
+const RegistryABI = `[
+	{"type":"function","name":"resolve","stateMutability":"view",
+		"inputs":[{"name":"crn","type":"string"}],
+		"outputs":[{"name":"id","type":"string"}]},
+	{"type":"function","name":"getRecord","stateMutability":"view",
+		"inputs":[{"name":"id","type":"string"}],
+		"outputs":[{"name":"exists","type":"bool"},{"name":"bondId","type":"string"},{"name":"createTime","type":"string"},
+			{"name":"expiryTime","type":"string"},{"name":"owners","type":"string[]"},{"name":"names","type":"string[]"},
+			{"name":"attributes","type":"string"}]},
+	{"type":"function","name":"whois","stateMutability":"view",
+		"inputs":[{"name":"name","type":"string"}],
+		"outputs":[{"name":"owner","type":"address"},{"name":"status","type":"string"},{"name":"bondId","type":"string"},
+			{"name":"expiryTime","type":"uint64"}]},
+	{"type":"function","name":"getBondBalance","stateMutability":"view",
+		"inputs":[{"name":"bondId","type":"string"},{"name":"denom","type":"string"}],
+		"outputs":[{"name":"owner","type":"address"},{"name":"balance","type":"uint256"}]},
+	{"type":"function","name":"setName","stateMutability":"nonpayable",
+		"inputs":[{"name":"crn","type":"string"},{"name":"id","type":"string"}],
+		"outputs":[]},
+	{"type":"event","name":"RecordSet",
+		"inputs":[{"name":"signer","type":"address","indexed":true},{"name":"id","type":"string"},{"name":"bondId","type":"string"}]},
+	{"type":"event","name":"NameSet",
+		"inputs":[{"name":"signer","type":"address","indexed":true},{"name":"crn","type":"string"},{"name":"id","type":"string"}]},
+	{"type":"event","name":"AuthorityWon",
+		"inputs":[{"name":"owner","type":"address","indexed":true},{"name":"name","type":"string"},{"name":"auctionId","type":"string"}]},
+	{"type":"event","name":"BondRefilled",
+		"inputs":[{"name":"signer","type":"address","indexed":true},{"name":"bondId","type":"string"},{"name":"coins","type":"string"}]}
+]`
+
+var (
+	registryABI abi.ABI
+
+	// revertSelector is the selector of the Solidity Error(string) revert reason.
+	revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+)
+
+func init() {
+	var err error
+	registryABI, err = abi.JSON(strings.NewReader(RegistryABI))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Registry is the registry precompile: it resolves names and reads records, authorities and bonds,
+// and sets names on behalf of the caller.
+//
+// Bonds can't be created by contracts, as their funds are moved by the bank module, while the balances
+// of the EVM accounts are cached by the StateDB for the whole transaction.
+type Registry struct {
+	keeper     keeper.Keeper
+	bondKeeper bondkeeper.Keeper
+}
+
+var _ evmprecompile.Contract = Registry{}
+
+// NewRegistry returns the registry precompile.
+func NewRegistry(keeper keeper.Keeper, bondKeeper bondkeeper.Keeper) Registry {
+	return Registry{keeper: keeper, bondKeeper: bondKeeper}
+}
+
+// Address implements evmprecompile.Contract.
+func (r Registry) Address() common.Address {
+	return RegistryAddress
+}
+
+// RequiredGas implements evmprecompile.Contract.
+func (r Registry) RequiredGas(input []byte) uint64 {
+	return BaseGas + uint64(len(input))*GasPerInputByte
+}
+
+// Run implements evmprecompile.Contract.
+func (r Registry) Run(ctx *evmprecompile.Context, input []byte) ([]byte, error) {
+	if len(input) < 4 {
+		return revert(errors.New("missing method selector"))
+	}
+
+	method, err := registryABI.MethodById(input[:4])
+	if err != nil {
+		return revert(err)
+	}
+
+	if ctx.Value != nil && ctx.Value.Sign() > 0 {
+		return revert(fmt.Errorf("method %s is not payable", method.Name))
+	}
+
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return revert(err)
+	}
+
+	var res []interface{}
+	switch method.Name {
+	case "resolve":
+		res = r.resolve(ctx.SDKContext(), args[0].(string))
+	case "getRecord":
+		res = r.getRecord(ctx.SDKContext(), args[0].(string))
+	case "whois":
+		res = r.whois(ctx.SDKContext(), args[0].(string))
+	case "getBondBalance":
+		res = r.getBondBalance(ctx.SDKContext(), args[0].(string), args[1].(string))
+	case "setName":
+		err = r.setName(ctx, args[0].(string), args[1].(string))
+	}
+
+	if errors.Is(err, vm.ErrOutOfGas) {
+		return nil, err
+	}
+
+	if err != nil {
+		return revert(err)
+	}
+
+	return method.Outputs.Pack(res...)
+}
+
+func (r Registry) resolve(ctx sdk.Context, crn string) []interface{} {
+	var id string
+	if record := r.keeper.ResolveCRN(ctx, crn); record != nil && !record.Deleted {
+		id = record.Id
+	}
+
+	return []interface{}{id}
+}
+
+func (r Registry) getRecord(ctx sdk.Context, id string) []interface{} {
+	if !r.keeper.HasRecord(ctx, id) {
+		return []interface{}{false, "", "", "", []string{}, []string{}, ""}
+	}
+
+	record := r.keeper.GetRecord(ctx, id)
+	if record.Deleted {
+		return []interface{}{false, "", "", "", []string{}, []string{}, ""}
+	}
+
+	return []interface{}{
+		true, record.BondId, record.CreateTime, record.ExpiryTime, nonNil(record.Owners), nonNil(record.Names),
+		string(helpers.BytesFromBase64(record.Attributes)),
+	}
+}
+
+func (r Registry) whois(ctx sdk.Context, name string) []interface{} {
+	if !r.keeper.HasNameAuthority(ctx, name) {
+		return []interface{}{common.Address{}, "", "", uint64(0)}
+	}
+
+	authority := r.keeper.GetNameAuthority(ctx, name)
+
+	return []interface{}{
+		toEthAddress(authority.OwnerAddress), authority.Status, authority.BondId, uint64(authority.ExpiryTime.Unix()),
+	}
+}
+
+func (r Registry) getBondBalance(ctx sdk.Context, bondID string, denom string) []interface{} {
+	if !r.bondKeeper.HasBond(ctx, bondID) {
+		return []interface{}{common.Address{}, big.NewInt(0)}
+	}
+
+	bond := r.bondKeeper.GetBond(ctx, bondID)
+
+	return []interface{}{toEthAddress(bond.Owner), bond.Balance.AmountOf(denom).BigInt()}
+}
+
+func (r Registry) setName(ctx *evmprecompile.Context, crn string, id string) error {
+	msg := types.NewMsgSetName(crn, id, sdk.AccAddress(ctx.Caller.Bytes()))
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	err := ctx.Execute(func(ctx sdk.Context) error {
+		if err := r.keeper.ProcessSetName(ctx, msg); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetRecord,
+				sdk.NewAttribute(types.AttributeKeySigner, msg.Signer),
+				sdk.NewAttribute(types.AttributeKeyCRN, msg.Crn),
+				sdk.NewAttribute(types.AttributeKeyCID, msg.Cid),
+			),
+		)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log, err := newLog("NameSet", ctx.Caller, crn, id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.AddLog(log.Topics, log.Data)
+}
+
+// revert returns the Solidity revert reason of an error.
+func revert(err error) ([]byte, error) {
+	reason, packErr := abi.Arguments{{Type: stringType}}.Pack(err.Error())
+	if packErr != nil {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	return append(append([]byte{}, revertSelector...), reason...), vm.ErrExecutionReverted
+}
+
+var stringType, _ = abi.NewType("string", "", nil)
+
+// toEthAddress returns the address of a bech32 account, or the zero address if it's invalid.
+func toEthAddress(bech32 string) common.Address {
+	addr, err := sdk.AccAddressFromBech32(bech32)
+	if err != nil {
+		return common.Address{}
+	}
+
+	return common.BytesToAddress(addr)
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
@@ -0,0 +1,202 @@
+package precompile_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/tharsis/ethermint/app"
+	"github.com/tharsis/ethermint/x/evm/statedb"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	"github.com/tharsis/ethermint/x/nameservice/precompile"
+	"github.com/tharsis/ethermint/x/nameservice/types"
+)
+
+// forwarder returns the code of a contract forwarding its call data to a target with a call opcode,
+// it returns or reverts with the result of the call.
+func forwarder(op vm.OpCode, target common.Address) []byte {
+	code := []byte{
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0,
+	}
+	if op == vm.CALL {
+		code = append(code, byte(vm.PUSH1), 0)
+	}
+	code = append(code, byte(vm.PUSH20))
+	code = append(code, target.Bytes()...)
+	code = append(code,
+		byte(vm.GAS), byte(op),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.RETURNDATACOPY),
+	)
+
+	returnDest := len(code) + 7
+	return append(code,
+		byte(vm.PUSH1), byte(returnDest), byte(vm.JUMPI),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.REVERT),
+		byte(vm.JUMPDEST), byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.RETURN),
+	)
+}
+
+func TestRegistry(t *testing.T) {
+	testApp := app.Setup(t, false, func(ea *app.EthermintApp, genesis simapp.GenesisState) simapp.GenesisState {
+		return genesis
+	})
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	registryABI, err := abi.JSON(strings.NewReader(precompile.RegistryABI))
+	require.NoError(t, err)
+
+	owner := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x1000000000000000000000000000000000000002")
+
+	testApp.NameServiceKeeper.PutRecord(ctx, types.Record{Id: "record-1", BondId: "bond-1", Owners: []string{"owner"}})
+	testApp.NameServiceKeeper.SetNameAuthority(ctx, "test", &types.NameAuthority{
+		OwnerAddress: sdk.AccAddress(owner.Bytes()).String(),
+		Status:       types.AuthorityActive,
+		BondId:       "bond-1",
+	})
+	testApp.NameServiceKeeper.SetNameRecord(ctx, "crn://test/app", "record-1")
+
+	gasLimit := uint64(1000000)
+	apply := func(from common.Address, to common.Address, commit bool, data []byte) *evmtypes.MsgEthereumTxResponse {
+		params := testApp.EvmKeeper.GetParams(ctx)
+		cfg := &evmtypes.EVMConfig{
+			Params:      params,
+			ChainConfig: params.ChainConfig.EthereumConfig(testApp.EvmKeeper.ChainID()),
+			BaseFee:     big.NewInt(0),
+		}
+		msg := ethtypes.NewMessage(from, &to, 0, big.NewInt(0), gasLimit, big.NewInt(0), big.NewInt(0), big.NewInt(0), data, nil, true)
+
+		res, err := testApp.EvmKeeper.ApplyMessageWithConfig(ctx, msg, nil, commit, cfg, statedb.NewEmptyTxConfig(common.Hash{}))
+		require.NoError(t, err)
+		return res
+	}
+
+	callVia := func(from common.Address, to common.Address, commit bool, method string, args ...interface{}) ([]interface{}, string) {
+		data, err := registryABI.Pack(method, args...)
+		require.NoError(t, err)
+
+		res := apply(from, to, commit, data)
+		if res.Failed() {
+			return nil, res.VmError
+		}
+
+		out, err := registryABI.Unpack(method, res.Ret)
+		require.NoError(t, err)
+		return out, ""
+	}
+
+	call := func(from common.Address, commit bool, method string, args ...interface{}) ([]interface{}, string) {
+		return callVia(from, precompile.RegistryAddress, commit, method, args...)
+	}
+
+	// the registry address isn't a go-ethereum precompile, it has no code until the precompile is active
+	require.NotContains(t, vm.PrecompiledAddressesBerlin, precompile.RegistryAddress)
+	data, err := registryABI.Pack("resolve", "crn://test/app")
+	require.NoError(t, err)
+	res := apply(other, precompile.RegistryAddress, false, data)
+	require.False(t, res.Failed())
+	require.Empty(t, res.Ret)
+
+	params := testApp.EvmKeeper.GetParams(ctx)
+	params.ActivePrecompiles = []string{precompile.RegistryAddress.Hex()}
+	testApp.EvmKeeper.SetParams(ctx, params)
+
+	out, vmErr := call(other, false, "resolve", "crn://test/app")
+	require.Empty(t, vmErr)
+	require.Equal(t, "record-1", out[0])
+
+	out, vmErr = call(other, false, "getRecord", "record-1")
+	require.Empty(t, vmErr)
+	require.Equal(t, true, out[0])
+	require.Equal(t, "bond-1", out[1])
+	require.Equal(t, []string{"owner"}, out[4])
+
+	out, vmErr = call(other, false, "whois", "test")
+	require.Empty(t, vmErr)
+	require.Equal(t, owner, out[0])
+	require.Equal(t, types.AuthorityActive, out[1])
+
+	// only the authority owner can set names
+	_, vmErr = call(other, true, "setName", "crn://test/other", "record-1")
+	require.NotEmpty(t, vmErr)
+	require.Nil(t, testApp.NameServiceKeeper.ResolveCRN(ctx, "crn://test/other"))
+
+	// names aren't set without commit, e.g. by eth_call
+	_, vmErr = call(owner, false, "setName", "crn://test/other", "record-1")
+	require.Empty(t, vmErr)
+	require.Nil(t, testApp.NameServiceKeeper.ResolveCRN(ctx, "crn://test/other"))
+
+	// setName costs the SDK gas consumed by setting the name
+	msg := types.NewMsgSetName("crn://test/other", "record-1", sdk.AccAddress(owner.Bytes()))
+	cacheCtx, _ := ctx.CacheContext()
+	gasMeter := sdk.NewInfiniteGasMeter()
+	require.NoError(t, testApp.NameServiceKeeper.ProcessSetName(cacheCtx.WithGasMeter(gasMeter), msg))
+	require.NotZero(t, gasMeter.GasConsumed())
+
+	data, err = registryABI.Pack("setName", "crn://test/other", "record-1")
+	require.NoError(t, err)
+	intrinsicGas, err := core.IntrinsicGas(data, nil, false, true, true)
+	require.NoError(t, err)
+
+	// the SDK gas is charged from the gas left after the base cost, the call runs out of gas if it isn't enough
+	gasLimit = intrinsicGas + precompile.BaseGas + uint64(len(data))*precompile.GasPerInputByte + gasMeter.GasConsumed() - 1
+	res = apply(owner, precompile.RegistryAddress, true, data)
+	require.True(t, res.Failed())
+	require.Equal(t, vm.ErrOutOfGas.Error(), res.VmError)
+	require.Equal(t, gasLimit, res.GasUsed)
+	require.Nil(t, testApp.NameServiceKeeper.ResolveCRN(ctx, "crn://test/other"))
+	gasLimit = 1000000
+
+	res = apply(owner, precompile.RegistryAddress, true, data)
+	require.False(t, res.Failed(), res.VmError)
+	require.Equal(t, intrinsicGas+precompile.BaseGas+uint64(len(data))*precompile.GasPerInputByte+gasMeter.GasConsumed(), res.GasUsed)
+	require.Equal(t, "record-1", testApp.NameServiceKeeper.ResolveCRN(ctx, "crn://test/other").Id)
+
+	// contracts set names on their own behalf, out of static calls, and not with delegate calls
+	forwarderAddress := common.HexToAddress("0x2000000000000000000000000000000000000001")
+	staticAddress := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	staticForwarderAddress := common.HexToAddress("0x2000000000000000000000000000000000000003")
+	delegateAddress := common.HexToAddress("0x2000000000000000000000000000000000000004")
+
+	db := statedb.New(ctx, testApp.EvmKeeper, statedb.NewEmptyTxConfig(common.Hash{}))
+	db.SetCode(forwarderAddress, forwarder(vm.CALL, precompile.RegistryAddress))
+	db.SetCode(staticAddress, forwarder(vm.STATICCALL, precompile.RegistryAddress))
+	db.SetCode(staticForwarderAddress, forwarder(vm.STATICCALL, forwarderAddress))
+	db.SetCode(delegateAddress, forwarder(vm.DELEGATECALL, precompile.RegistryAddress))
+	require.NoError(t, db.Commit())
+
+	testApp.NameServiceKeeper.SetNameAuthority(ctx, "contract", &types.NameAuthority{
+		OwnerAddress: sdk.AccAddress(forwarderAddress.Bytes()).String(),
+		Status:       types.AuthorityActive,
+		BondId:       "bond-1",
+	})
+
+	out, vmErr = callVia(other, staticAddress, false, "resolve", "crn://test/app")
+	require.Empty(t, vmErr)
+	require.Equal(t, "record-1", out[0])
+
+	_, vmErr = callVia(other, staticAddress, true, "setName", "crn://contract/app", "record-1")
+	require.NotEmpty(t, vmErr)
+
+	_, vmErr = callVia(other, staticForwarderAddress, true, "setName", "crn://contract/app", "record-1")
+	require.NotEmpty(t, vmErr)
+
+	_, vmErr = callVia(other, delegateAddress, true, "setName", "crn://contract/app", "record-1")
+	require.NotEmpty(t, vmErr)
+	require.Nil(t, testApp.NameServiceKeeper.ResolveCRN(ctx, "crn://contract/app"))
+
+	_, vmErr = callVia(other, forwarderAddress, true, "setName", "crn://contract/app", "record-1")
+	require.Empty(t, vmErr)
+	require.Equal(t, "record-1", testApp.NameServiceKeeper.ResolveCRN(ctx, "crn://contract/app").Id)
+}
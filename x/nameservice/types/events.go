@@ -10,16 +10,29 @@ const (
 	EventTypeDissociateBond       = "dissociate-bond"
 	EventTypeDissociateRecords    = "dissociate-record"
 	EventTypeReAssociateRecords   = "re-associate-records"
+	EventTypeBlockChange          = "block-change"
 
-	AttributeKeySigner     = "signer"
-	AttributeKeyOwner      = "owner"
-	AttributeKeyBondId     = "bond-id"
-	AttributeKeyPayload    = "payload"
-	AttributeKeyOldBondId  = "old-bond-id"
-	AttributeKeyNewBondId  = "new-bond-id"
-	AttributeKeyCID        = "cid"
-	AttributeKeyName       = "name"
-	AttributeKeyCRN        = "crn"
-	AttributeKeyRecordId   = "record-id"
-	AttributeValueCategory = ModuleName
+	AttributeKeySigner        = "signer"
+	AttributeKeyOwner         = "owner"
+	AttributeKeyBondId        = "bond-id"
+	AttributeKeyPayload       = "payload"
+	AttributeKeyOldBondId     = "old-bond-id"
+	AttributeKeyNewBondId     = "new-bond-id"
+	AttributeKeyCID           = "cid"
+	AttributeKeyName          = "name"
+	AttributeKeyCRN           = "crn"
+	AttributeKeyRecordId      = "record-id"
+	AttributeKeyChangeKind    = "kind"
+	AttributeKeyChangeId      = "id"
+	AttributeKeyBidderAddress = "bidder-address"
+	AttributeValueCategory    = ModuleName
+)
+
+// Kinds of block changes, see EventTypeBlockChange.
+const (
+	BlockChangeKindRecord     = "record"
+	BlockChangeKindName       = "name"
+	BlockChangeKindAuthority  = "authority"
+	BlockChangeKindAuction    = "auction"
+	BlockChangeKindAuctionBid = "auction-bid"
 )
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegistryHooks are notified of the registry changes made by the module messages.
+type RegistryHooks interface {
+	AfterRecordSet(ctx sdk.Context, signer sdk.AccAddress, record RecordType) error
+	AfterNameSet(ctx sdk.Context, signer sdk.AccAddress, crn string, id string) error
+}
@@ -42,6 +42,13 @@ type Params struct {
 	AuthorityAuctionCommitFee       types.Coin    `protobuf:"bytes,9,opt,name=authority_auction_commit_fee,json=authorityAuctionCommitFee,proto3" json:"authority_auction_commit_fee" json:"authority_auction_commit_fee" yaml:"authority_auction_commit_fee"`
 	AuthorityAuctionRevealFee       types.Coin    `protobuf:"bytes,10,opt,name=authority_auction_reveal_fee,json=authorityAuctionRevealFee,proto3" json:"authority_auction_reveal_fee" json:"authority_auction_reveal_fee" yaml:"authority_auction_reveal_fee"`
 	AuthorityAuctionMinimumBid      types.Coin    `protobuf:"bytes,11,opt,name=authority_auction_minimum_bid,json=authorityAuctionMinimumBid,proto3" json:"authority_auction_minimum_bid" json:"authority_auction_minimum_bid" yaml:"authority_auction_minimum_bid"`
+	// changeset_retention_blocks is the number of most recent blocks whose changesets are kept, 0 keeps all.
+	ChangesetRetentionBlocks uint64 `protobuf:"varint,12,opt,name=changeset_retention_blocks,json=changesetRetentionBlocks,proto3" json:"changeset_retention_blocks,omitempty" json:"changeset_retention_blocks" yaml:"changeset_retention_blocks"`
+	// changeset_retention_period is how long changesets are kept, 0 keeps them regardless of age.
+	ChangesetRetentionPeriod time.Duration `protobuf:"bytes,13,opt,name=changeset_retention_period,json=changesetRetentionPeriod,proto3,stdduration" json:"changeset_retention_period" json:"changeset_retention_period" yaml:"changeset_retention_period"`
+	// changeset_mode is either "store", to keep block changesets in the store, or "events",
+	// to only emit the changes as block-change events.
+	ChangesetMode string `protobuf:"bytes,14,opt,name=changeset_mode,json=changesetMode,proto3" json:"changeset_mode,omitempty" json:"changeset_mode" yaml:"changeset_mode"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -154,6 +161,27 @@ func (m *Params) GetAuthorityAuctionMinimumBid() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetChangesetRetentionBlocks() uint64 {
+	if m != nil {
+		return m.ChangesetRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetChangesetRetentionPeriod() time.Duration {
+	if m != nil {
+		return m.ChangesetRetentionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetChangesetMode() string {
+	if m != nil {
+		return m.ChangesetMode
+	}
+	return ""
+}
+
 // Params defines the nameservice module records
 type Record struct {
 	Id         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" json:"id" yaml:"id"`
@@ -624,6 +652,7 @@ type BlockChangeSet struct {
 	AuctionBids []*AuctionBidInfo `protobuf:"bytes,4,rep,name=auction_bids,json=auctionBids,proto3" json:"auction_bids,omitempty" json:"auctionBids" yaml:"auctionBids"`
 	Authorities []string          `protobuf:"bytes,5,rep,name=authorities,proto3" json:"authorities,omitempty"`
 	Names       []string          `protobuf:"bytes,6,rep,name=names,proto3" json:"names,omitempty"`
+	Time        time.Time         `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time" json:"time" yaml:"time"`
 }
 
 func (m *BlockChangeSet) Reset()         { *m = BlockChangeSet{} }
@@ -701,6 +730,13 @@ func (m *BlockChangeSet) GetNames() []string {
 	return nil
 }
 
+func (m *BlockChangeSet) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 // AuctionBidInfo
 type AuctionBidInfo struct {
 	AuctionId     string `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty" json:"auctionID" yaml:"auctionID"`
@@ -772,90 +808,98 @@ func init() {
 }
 
 var fileDescriptor_c2009c2df775dbad = []byte{
-	// 1325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdd, 0x6e, 0x1b, 0xc5,
-	0x17, 0xcf, 0xc6, 0x89, 0x13, 0x4f, 0x1a, 0xff, 0xff, 0x1a, 0xd2, 0x76, 0x13, 0xa8, 0x37, 0x18,
-	0x55, 0x6d, 0x55, 0xea, 0xa5, 0xf4, 0xa2, 0x7c, 0x08, 0xa1, 0x6c, 0xd2, 0xa6, 0x01, 0x01, 0x61,
-	0xda, 0x1b, 0xb8, 0x31, 0xb3, 0xbb, 0x53, 0x7b, 0xa8, 0x77, 0xd7, 0xda, 0x99, 0x0d, 0x35, 0x5c,
-	0xf1, 0x06, 0x95, 0xb8, 0xe9, 0x05, 0xe2, 0x05, 0x40, 0xe2, 0x31, 0xe8, 0x65, 0x2f, 0x11, 0x12,
-	0x06, 0xb5, 0x6f, 0xe0, 0x27, 0x40, 0x3b, 0x1f, 0xfb, 0x65, 0xbb, 0x8e, 0xda, 0xbb, 0x3d, 0xe7,
-	0x77, 0xce, 0x99, 0xdf, 0x9c, 0x39, 0xe7, 0xcc, 0x2c, 0xb0, 0x4f, 0x92, 0x81, 0x87, 0x43, 0xfa,
-	0x3d, 0xb1, 0x43, 0x1c, 0x10, 0x46, 0xe2, 0x13, 0xea, 0x11, 0xfb, 0xe4, 0xba, 0x4b, 0x38, 0xbe,
-	0x5e, 0xd4, 0x75, 0x86, 0x71, 0xc4, 0x23, 0x78, 0x21, 0x73, 0xe8, 0x14, 0x41, 0xe5, 0xb0, 0xd3,
-	0xea, 0x45, 0x51, 0x6f, 0x40, 0x6c, 0x61, 0xec, 0x26, 0xf7, 0x6d, 0x3f, 0x89, 0x31, 0xa7, 0x51,
-	0x28, 0xdd, 0x77, 0xac, 0x2a, 0xce, 0x69, 0x40, 0x18, 0xc7, 0xc1, 0x50, 0x19, 0x6c, 0xf5, 0xa2,
-	0x5e, 0x24, 0x3e, 0xed, 0xf4, 0x4b, 0x69, 0x5b, 0x5e, 0xc4, 0x82, 0x88, 0xd9, 0x2e, 0x66, 0x39,
-	0x39, 0x2f, 0xa2, 0x2a, 0x6c, 0xfb, 0xef, 0x4d, 0x50, 0x3f, 0xc6, 0x31, 0x0e, 0x18, 0xa4, 0x60,
-	0x23, 0x26, 0x5e, 0x14, 0xfb, 0xdd, 0x98, 0x84, 0xdc, 0x34, 0x76, 0x8d, 0xcb, 0x1b, 0xef, 0x6e,
-	0x77, 0x64, 0x80, 0x4e, 0x1a, 0x40, 0x93, 0xed, 0xec, 0x47, 0x34, 0x74, 0xae, 0x3d, 0x19, 0x5b,
-	0x4b, 0x93, 0xb1, 0x75, 0xf1, 0x5b, 0x16, 0x85, 0x1f, 0xb4, 0x0b, 0xbe, 0xed, 0xdd, 0x11, 0x0e,
-	0x06, 0x65, 0x15, 0x02, 0x52, 0x42, 0x24, 0xe4, 0xf0, 0x91, 0x01, 0xb6, 0x0a, 0x60, 0x57, 0xef,
-	0xd5, 0x5c, 0x56, 0x8b, 0xca, 0xcd, 0x76, 0xf4, 0x66, 0x3b, 0x07, 0xca, 0xc0, 0xd9, 0x57, 0x8b,
-	0xde, 0x9c, 0x5a, 0x34, 0x0b, 0x32, 0x63, 0xf5, 0x1c, 0x7b, 0xfc, 0x8f, 0x65, 0x20, 0x98, 0x53,
-	0xd1, 0x81, 0x61, 0x02, 0x9a, 0x38, 0xe1, 0xfd, 0x28, 0xa6, 0x7c, 0x24, 0x13, 0x50, 0x5b, 0x94,
-	0x80, 0x1b, 0x8a, 0xcb, 0x55, 0xc9, 0xa5, 0xec, 0xae, 0x59, 0x54, 0xb4, 0x68, 0x33, 0x53, 0x88,
-	0x4c, 0xfc, 0x6c, 0x80, 0xf3, 0x65, 0x93, 0x3c, 0x19, 0x2b, 0x8b, 0x92, 0x71, 0xa4, 0x08, 0x7c,
-	0x34, 0x8b, 0xc0, 0x54, 0x3e, 0xe6, 0xc1, 0x22, 0x25, 0x67, 0x4b, 0xb4, 0xb2, 0xac, 0x3c, 0x36,
-	0xc0, 0xb9, 0xdc, 0xaf, 0x17, 0x63, 0x8f, 0x74, 0x87, 0x24, 0xa6, 0x91, 0x6f, 0xae, 0x2e, 0x62,
-	0x77, 0xa8, 0xd8, 0x7d, 0x58, 0x65, 0x57, 0x0c, 0x33, 0x4d, 0xae, 0x84, 0x0a, 0x6e, 0x5b, 0x19,
-	0x78, 0x98, 0x62, 0xc7, 0x02, 0x82, 0x3f, 0x1a, 0x60, 0x3b, 0xf7, 0xc2, 0x89, 0x97, 0x2e, 0xda,
-	0x25, 0x21, 0x76, 0x07, 0xc4, 0x37, 0xeb, 0xbb, 0xc6, 0xe5, 0x75, 0xe7, 0xd6, 0x64, 0x6c, 0xed,
-	0x55, 0x97, 0xaf, 0x98, 0x4e, 0x33, 0xa8, 0x1a, 0xa0, 0xfc, 0x84, 0xf6, 0x24, 0x74, 0x4b, 0x22,
-	0xf0, 0x0f, 0x03, 0xcc, 0xf0, 0xf3, 0xa2, 0x20, 0xa0, 0x9c, 0xe5, 0x07, 0xb9, 0xb6, 0x28, 0x55,
-	0x5d, 0x95, 0xaa, 0xbb, 0xf3, 0xb8, 0x56, 0x43, 0xce, 0x27, 0x3d, 0x65, 0x29, 0x52, 0x68, 0x55,
-	0x77, 0xb0, 0x2f, 0xcd, 0xb2, 0x83, 0x9e, 0xbd, 0x93, 0x98, 0x9c, 0x10, 0x3c, 0x28, 0xec, 0x64,
-	0xfd, 0x95, 0x77, 0x52, 0x0d, 0x39, 0x7f, 0x27, 0x53, 0x96, 0xb3, 0x77, 0x82, 0xa4, 0x59, 0xb6,
-	0x93, 0x5f, 0x0d, 0xf0, 0xc6, 0xbc, 0xb4, 0x74, 0xef, 0x13, 0x62, 0x36, 0x16, 0xf5, 0xf5, 0x17,
-	0x6a, 0x0f, 0x87, 0x2f, 0x3e, 0x8d, 0x34, 0xd8, 0xa2, 0x73, 0x10, 0x36, 0x68, 0x7b, 0x76, 0xf6,
-	0x6f, 0x13, 0x32, 0x87, 0xad, 0xdc, 0xba, 0x60, 0x0b, 0x5e, 0x99, 0x6d, 0x1e, 0x6c, 0x51, 0xae,
-	0xe7, 0xb0, 0x95, 0x19, 0x4e, 0xd9, 0xfe, 0x6e, 0x80, 0x0b, 0xd3, 0xce, 0x01, 0x0d, 0x69, 0x90,
-	0x04, 0x5d, 0x97, 0xfa, 0xe6, 0xc6, 0x22, 0xba, 0x5f, 0x2a, 0xba, 0x47, 0xf3, 0xe8, 0x16, 0xa2,
-	0xcd, 0xe7, 0x5b, 0x34, 0x42, 0x3b, 0x55, 0xc2, 0x9f, 0x49, 0xd4, 0xa1, 0x7e, 0xfb, 0x69, 0x0d,
-	0xd4, 0x91, 0x98, 0xf6, 0xf0, 0x12, 0x58, 0xa6, 0xbe, 0xb8, 0xd6, 0x1a, 0xce, 0xf9, 0xc9, 0xd8,
-	0x7a, 0x4d, 0x32, 0xc8, 0x97, 0x49, 0x63, 0x2d, 0x53, 0x1f, 0xbe, 0x07, 0xd6, 0xdc, 0x28, 0xf4,
-	0xbb, 0xd4, 0x17, 0xf7, 0x51, 0xc3, 0xb1, 0x26, 0x63, 0xeb, 0x75, 0x69, 0x9d, 0x02, 0x47, 0x99,
-	0x87, 0x92, 0x50, 0x5d, 0x7e, 0xc0, 0x3b, 0x60, 0xc3, 0x8b, 0x09, 0xe6, 0xa4, 0x9b, 0xde, 0xce,
-	0xe2, 0x06, 0x69, 0x38, 0x97, 0x26, 0x63, 0xeb, 0x2d, 0xe9, 0x2d, 0xc1, 0x7b, 0x34, 0xc8, 0x8e,
-	0xa2, 0xa0, 0x41, 0x20, 0x17, 0xd2, 0x48, 0xe4, 0xe1, 0x90, 0xc6, 0x23, 0x19, 0x69, 0xa5, 0x1a,
-	0x49, 0x82, 0xc5, 0x48, 0x05, 0x0d, 0x02, 0xb9, 0x00, 0x4d, 0xb0, 0xe6, 0x93, 0x01, 0xe1, 0x44,
-	0x8e, 0xec, 0x75, 0xa4, 0x45, 0x78, 0x13, 0xd4, 0xa3, 0xef, 0x42, 0x12, 0x33, 0xb3, 0xbe, 0x5b,
-	0x2b, 0x6f, 0x53, 0xea, 0x75, 0x68, 0x25, 0x21, 0x65, 0x0e, 0x0f, 0x01, 0xc0, 0x9c, 0xc7, 0xd4,
-	0x4d, 0x38, 0x61, 0x62, 0xba, 0x95, 0xb8, 0xe5, 0x58, 0x76, 0x80, 0xb9, 0x06, 0x15, 0x5c, 0xe1,
-	0x0d, 0xb0, 0x2a, 0xde, 0x42, 0xe6, 0xba, 0x20, 0x70, 0x61, 0x32, 0xb6, 0xb6, 0x65, 0x0c, 0xa1,
-	0xd6, 0xee, 0x52, 0x40, 0xd2, 0xb6, 0xdd, 0x07, 0xcd, 0x3d, 0x7d, 0xe0, 0xb7, 0x42, 0x1e, 0x8f,
-	0x20, 0x04, 0x2b, 0x29, 0x24, 0xcf, 0x16, 0x89, 0x6f, 0xe8, 0x80, 0x55, 0x92, 0x82, 0xea, 0x49,
-	0xf1, 0x76, 0xe7, 0x85, 0xcf, 0xaf, 0xce, 0xe7, 0x38, 0x20, 0x59, 0x54, 0x24, 0x5d, 0xdb, 0x7f,
-	0xd5, 0xc0, 0x66, 0x09, 0x80, 0x5f, 0x81, 0xff, 0x8b, 0x1c, 0x74, 0x87, 0x89, 0x3b, 0xa0, 0x5e,
-	0xf7, 0x01, 0x19, 0xa9, 0x8a, 0xb2, 0xf3, 0x87, 0x80, 0xb0, 0x38, 0x16, 0x06, 0x9f, 0x92, 0x51,
-	0x29, 0x89, 0xb9, 0x16, 0x35, 0xcb, 0x0a, 0x78, 0x0c, 0x36, 0x65, 0x68, 0xec, 0xfb, 0x31, 0x61,
-	0x4c, 0xd5, 0xde, 0xd5, 0xc9, 0xd8, 0xba, 0x54, 0x88, 0xbb, 0x27, 0xd1, 0x52, 0x54, 0xad, 0x43,
-	0x67, 0x8a, 0x22, 0x3c, 0x07, 0xea, 0x7d, 0x42, 0x7b, 0x7d, 0xf9, 0x94, 0x59, 0x41, 0x4a, 0x4a,
-	0xf5, 0x8c, 0x63, 0x9e, 0x30, 0x59, 0x56, 0x48, 0x49, 0xf0, 0x00, 0x00, 0xdd, 0x5f, 0x54, 0x16,
-	0x4b, 0xc3, 0xb9, 0x38, 0x19, 0x5b, 0x6f, 0xea, 0x56, 0x15, 0xd8, 0xd1, 0x41, 0xde, 0x96, 0x5a,
-	0x81, 0x1a, 0xfa, 0xbb, 0xd4, 0x3d, 0xf5, 0x99, 0xdd, 0x73, 0x50, 0xea, 0x9e, 0x83, 0xbc, 0x7b,
-	0x06, 0xe5, 0x9a, 0x97, 0xb7, 0xe6, 0xce, 0xd4, 0x5d, 0x73, 0x4f, 0x3f, 0x7c, 0x1d, 0x5b, 0xcd,
-	0x92, 0xd3, 0xf4, 0xc4, 0xa3, 0xf4, 0xf2, 0x28, 0xf4, 0x45, 0xfb, 0x1b, 0xd0, 0x48, 0xcf, 0x76,
-	0x7e, 0x05, 0x7d, 0x5c, 0xae, 0xa0, 0x2b, 0xa7, 0xa8, 0x20, 0x39, 0x69, 0x74, 0xf9, 0xfc, 0x62,
-	0x00, 0x90, 0x6b, 0xe1, 0x6d, 0x50, 0x1f, 0x60, 0x4e, 0x98, 0x7e, 0x5a, 0x77, 0x4e, 0x1d, 0x50,
-	0x70, 0x44, 0xca, 0x1b, 0xde, 0x01, 0x6b, 0x7d, 0xca, 0x78, 0x24, 0x98, 0xd5, 0x5e, 0x22, 0x90,
-	0x76, 0x6f, 0xbf, 0x0f, 0xfe, 0x57, 0xc1, 0x60, 0x33, 0x1f, 0x92, 0x62, 0x16, 0xe6, 0x35, 0xb4,
-	0x5c, 0xac, 0xa1, 0x76, 0x0c, 0x1a, 0x77, 0x69, 0x2f, 0xc4, 0x3c, 0x89, 0x09, 0xbc, 0x0a, 0x6a,
-	0x8c, 0xf6, 0x54, 0x23, 0x6c, 0x4f, 0xc6, 0xd6, 0x59, 0x79, 0x20, 0x8c, 0xf6, 0xf4, 0x49, 0xa4,
-	0x9f, 0x28, 0xb5, 0x4a, 0xeb, 0x63, 0x98, 0xb8, 0xa2, 0x73, 0xa6, 0xa6, 0xeb, 0x30, 0x71, 0x0b,
-	0x1d, 0xa3, 0x24, 0x54, 0x57, 0x1f, 0x3f, 0x2d, 0x83, 0xa6, 0x33, 0x88, 0xbc, 0x07, 0xfb, 0x7d,
-	0x1c, 0xf6, 0xc8, 0x5d, 0xc2, 0x0b, 0xf4, 0xd2, 0xc5, 0x6b, 0x59, 0x89, 0x9b, 0x60, 0x4d, 0xbe,
-	0xf1, 0x99, 0xc8, 0x51, 0x03, 0x69, 0x11, 0xee, 0x80, 0x75, 0x55, 0xab, 0xcc, 0xac, 0x09, 0x28,
-	0x93, 0xe1, 0x0f, 0xe0, 0x8c, 0x6e, 0x00, 0x97, 0xfa, 0x69, 0x7b, 0xa4, 0xe9, 0xbd, 0xb6, 0x20,
-	0xbd, 0xea, 0xd2, 0x71, 0xa8, 0x7f, 0x14, 0xde, 0x8f, 0x9c, 0x2b, 0xf9, 0x2f, 0x11, 0xce, 0x10,
-	0x56, 0xe9, 0x19, 0xa1, 0x42, 0x1b, 0x05, 0x09, 0xee, 0x82, 0x0d, 0x7d, 0x8f, 0x51, 0xc2, 0xcc,
-	0x55, 0xc1, 0xad, 0xa8, 0x82, 0x5b, 0x7a, 0x5a, 0x8a, 0x71, 0xad, 0xc7, 0xe1, 0x6f, 0x46, 0x3a,
-	0x0f, 0x8b, 0x14, 0x2a, 0x8d, 0x6c, 0xbc, 0x64, 0x23, 0xdf, 0x03, 0x4d, 0x97, 0xfa, 0xfe, 0xd4,
-	0x44, 0xba, 0x36, 0x19, 0x5b, 0x57, 0x54, 0x3f, 0x0b, 0xbc, 0x32, 0x92, 0xca, 0x4a, 0xb4, 0x59,
-	0x92, 0x9d, 0x4f, 0x9e, 0x3c, 0x6b, 0x19, 0x4f, 0x9f, 0xb5, 0x8c, 0x7f, 0x9f, 0xb5, 0x8c, 0x47,
-	0xcf, 0x5b, 0x4b, 0x4f, 0x9f, 0xb7, 0x96, 0xfe, 0x7c, 0xde, 0x5a, 0xfa, 0xfa, 0x9d, 0x1e, 0xe5,
-	0xfd, 0xc4, 0xed, 0x78, 0x51, 0x60, 0xf3, 0x3e, 0x8e, 0x19, 0x65, 0x36, 0xe1, 0x7d, 0x12, 0x07,
-	0x34, 0xe4, 0xf6, 0xc3, 0xd2, 0x6f, 0x36, 0x1f, 0x0d, 0x09, 0x73, 0xeb, 0x62, 0x26, 0xdc, 0xf8,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x95, 0x34, 0xdd, 0x8c, 0x0f, 0x00, 0x00,
+	// 1442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0x89, 0x13, 0xbf, 0x34, 0xfe, 0x56, 0xf3, 0x4d, 0xdb, 0x4d, 0xa0, 0x5e, 0xe3,
+	0xaa, 0x6a, 0xaa, 0x52, 0x9b, 0xd2, 0x43, 0xf9, 0x21, 0x84, 0xb2, 0x49, 0x9b, 0x06, 0x54, 0x08,
+	0x93, 0x0a, 0x09, 0x2e, 0x66, 0xd7, 0x3b, 0xb5, 0x87, 0x7a, 0x77, 0xad, 0x9d, 0xd9, 0x50, 0xc3,
+	0x89, 0x03, 0xf7, 0x1e, 0x7b, 0x40, 0x9c, 0x91, 0x40, 0xe2, 0xcf, 0xa0, 0xc7, 0x1e, 0x11, 0x07,
+	0x83, 0xda, 0x4b, 0xcf, 0xfe, 0x0b, 0xd0, 0xce, 0xcc, 0xfe, 0xb4, 0x1d, 0x47, 0xed, 0x6d, 0xde,
+	0xfb, 0xbc, 0xf7, 0xe6, 0xf3, 0xde, 0xcc, 0x7b, 0x3b, 0x36, 0xb4, 0x8e, 0xc3, 0x7e, 0xc7, 0xf2,
+	0xe8, 0xf7, 0xa4, 0xe5, 0x59, 0x2e, 0x61, 0x24, 0x38, 0xa6, 0x1d, 0xd2, 0x3a, 0xbe, 0x61, 0x13,
+	0x6e, 0xdd, 0xc8, 0xea, 0x9a, 0x83, 0xc0, 0xe7, 0x3e, 0xba, 0x98, 0x38, 0x34, 0xb3, 0xa0, 0x72,
+	0xd8, 0xaa, 0x75, 0x7d, 0xbf, 0xdb, 0x27, 0x2d, 0x61, 0x6c, 0x87, 0x0f, 0x5a, 0x4e, 0x18, 0x58,
+	0x9c, 0xfa, 0x9e, 0x74, 0xdf, 0x32, 0x8a, 0x38, 0xa7, 0x2e, 0x61, 0xdc, 0x72, 0x07, 0xca, 0x60,
+	0xa3, 0xeb, 0x77, 0x7d, 0xb1, 0x6c, 0x45, 0x2b, 0xa5, 0xad, 0x75, 0x7c, 0xe6, 0xfa, 0xac, 0x65,
+	0x5b, 0x2c, 0x25, 0xd7, 0xf1, 0xa9, 0x0a, 0xdb, 0x78, 0x79, 0x16, 0xca, 0x87, 0x56, 0x60, 0xb9,
+	0x0c, 0x51, 0x58, 0x0b, 0x48, 0xc7, 0x0f, 0x9c, 0x76, 0x40, 0x3c, 0xae, 0x6b, 0x75, 0x6d, 0x7b,
+	0xed, 0xdd, 0xcd, 0xa6, 0x0c, 0xd0, 0x8c, 0x02, 0xc4, 0x64, 0x9b, 0xbb, 0x3e, 0xf5, 0xcc, 0xeb,
+	0x4f, 0x47, 0xc6, 0xc2, 0x78, 0x64, 0x5c, 0xfe, 0x96, 0xf9, 0xde, 0x07, 0x8d, 0x8c, 0x6f, 0xa3,
+	0x3e, 0xb4, 0xdc, 0x7e, 0x5e, 0x85, 0x41, 0x4a, 0x98, 0x78, 0x1c, 0x3d, 0xd6, 0x60, 0x23, 0x03,
+	0xb6, 0xe3, 0x5c, 0xf5, 0x45, 0xb5, 0xa9, 0x4c, 0xb6, 0x19, 0x27, 0xdb, 0xdc, 0x53, 0x06, 0xe6,
+	0xae, 0xda, 0xf4, 0xd6, 0xc4, 0xa6, 0x49, 0x90, 0x29, 0xbb, 0xa7, 0xd8, 0x93, 0x7f, 0x0c, 0x0d,
+	0xa3, 0x94, 0x4a, 0x1c, 0x18, 0x85, 0x50, 0xb5, 0x42, 0xde, 0xf3, 0x03, 0xca, 0x87, 0xb2, 0x00,
+	0xa5, 0x79, 0x05, 0xb8, 0xa9, 0xb8, 0x5c, 0x93, 0x5c, 0xf2, 0xee, 0x31, 0x8b, 0x82, 0x16, 0xaf,
+	0x27, 0x0a, 0x51, 0x89, 0x9f, 0x35, 0xb8, 0x90, 0x37, 0x49, 0x8b, 0xb1, 0x34, 0xaf, 0x18, 0x07,
+	0x8a, 0xc0, 0x47, 0xd3, 0x08, 0x4c, 0xd4, 0x63, 0x16, 0x2c, 0x4a, 0x72, 0x2e, 0x47, 0x2b, 0xa9,
+	0xca, 0x13, 0x0d, 0xce, 0xa7, 0x7e, 0xdd, 0xc0, 0xea, 0x90, 0xf6, 0x80, 0x04, 0xd4, 0x77, 0xf4,
+	0xe5, 0x79, 0xec, 0xf6, 0x15, 0xbb, 0x0f, 0x8b, 0xec, 0xb2, 0x61, 0x26, 0xc9, 0xe5, 0x50, 0xc1,
+	0x6d, 0x23, 0x01, 0xf7, 0x23, 0xec, 0x50, 0x40, 0xe8, 0x47, 0x0d, 0x36, 0x53, 0x2f, 0x2b, 0xec,
+	0x44, 0x9b, 0xb6, 0x89, 0x67, 0xd9, 0x7d, 0xe2, 0xe8, 0xe5, 0xba, 0xb6, 0xbd, 0x6a, 0xde, 0x1e,
+	0x8f, 0x8c, 0x9d, 0xe2, 0xf6, 0x05, 0xd3, 0x49, 0x06, 0x45, 0x03, 0x9c, 0x9e, 0xd0, 0x8e, 0x84,
+	0x6e, 0x4b, 0x04, 0xfd, 0xa9, 0xc1, 0x14, 0xbf, 0x8e, 0xef, 0xba, 0x94, 0xb3, 0xf4, 0x20, 0x57,
+	0xe6, 0x95, 0xaa, 0xad, 0x4a, 0x75, 0x34, 0x8b, 0x6b, 0x31, 0xe4, 0x6c, 0xd2, 0x13, 0x96, 0xa2,
+	0x84, 0x46, 0x31, 0x83, 0x5d, 0x69, 0x96, 0x1c, 0xf4, 0xf4, 0x4c, 0x02, 0x72, 0x4c, 0xac, 0x7e,
+	0x26, 0x93, 0xd5, 0xd7, 0xce, 0xa4, 0x18, 0x72, 0x76, 0x26, 0x13, 0x96, 0xd3, 0x33, 0xc1, 0xd2,
+	0x2c, 0xc9, 0xe4, 0x37, 0x0d, 0xde, 0x9c, 0x55, 0x96, 0xf6, 0x03, 0x42, 0xf4, 0xca, 0xbc, 0xbe,
+	0xfe, 0x5c, 0xe5, 0xb0, 0x7f, 0xf2, 0x69, 0x44, 0xc1, 0xe6, 0x9d, 0x83, 0xb0, 0xc1, 0x9b, 0xd3,
+	0xab, 0x7f, 0x87, 0x90, 0x19, 0x6c, 0x65, 0xea, 0x82, 0x2d, 0xbc, 0x36, 0xdb, 0x34, 0xd8, 0xbc,
+	0x5a, 0xcf, 0x60, 0x2b, 0x2b, 0x1c, 0xb1, 0xfd, 0x43, 0x83, 0x8b, 0x93, 0xce, 0x2e, 0xf5, 0xa8,
+	0x1b, 0xba, 0x6d, 0x9b, 0x3a, 0xfa, 0xda, 0x3c, 0xba, 0x5f, 0x28, 0xba, 0x07, 0xb3, 0xe8, 0x66,
+	0xa2, 0xcd, 0xe6, 0x9b, 0x35, 0xc2, 0x5b, 0x45, 0xc2, 0xf7, 0x24, 0x6a, 0x52, 0x07, 0xfd, 0xa4,
+	0xc1, 0x56, 0xa7, 0x67, 0x79, 0x5d, 0xc2, 0x08, 0x6f, 0x07, 0x84, 0x13, 0x4f, 0x04, 0xb0, 0xfb,
+	0x7e, 0xe7, 0x21, 0xd3, 0xcf, 0xd4, 0xb5, 0xed, 0x25, 0x73, 0x7f, 0x3c, 0x32, 0x76, 0x25, 0x9f,
+	0xd9, 0xb6, 0x31, 0x99, 0x13, 0x2c, 0xb0, 0x9e, 0x80, 0x38, 0xc6, 0x4c, 0x01, 0xa1, 0x5f, 0x67,
+	0xf0, 0x50, 0xc3, 0x74, 0x7d, 0x5e, 0x5f, 0xc5, 0xa7, 0x7c, 0x02, 0xcd, 0xfc, 0x40, 0x3d, 0xc1,
+	0x42, 0xf4, 0xd1, 0x14, 0xaa, 0x6a, 0xb0, 0x7e, 0x09, 0xd5, 0xd4, 0xd9, 0xf5, 0x1d, 0xa2, 0x57,
+	0xeb, 0xda, 0x76, 0xc5, 0x6c, 0xa5, 0x9f, 0xba, 0x3c, 0x3e, 0xb9, 0xa5, 0xd0, 0xe2, 0xf5, 0x44,
+	0x71, 0x2f, 0x92, 0x9f, 0x95, 0xa0, 0x8c, 0xc5, 0x87, 0x17, 0x5d, 0x81, 0x45, 0xea, 0x88, 0x17,
+	0x46, 0xc5, 0xbc, 0x30, 0x1e, 0x19, 0xff, 0x97, 0x61, 0xd3, 0x13, 0x8f, 0x8e, 0x75, 0x91, 0x3a,
+	0xe8, 0x3d, 0x58, 0xb1, 0x7d, 0xcf, 0x69, 0x53, 0x47, 0x3c, 0x0d, 0x2a, 0xa6, 0x31, 0x1e, 0x19,
+	0x6f, 0x48, 0xeb, 0x08, 0x38, 0x48, 0x3c, 0x94, 0x84, 0xcb, 0x72, 0x81, 0xee, 0xc2, 0x5a, 0x27,
+	0x20, 0x16, 0x27, 0x6d, 0x4e, 0x5d, 0x22, 0x3e, 0xe6, 0x15, 0xf3, 0xca, 0x78, 0x64, 0x5c, 0x52,
+	0x29, 0x08, 0xf0, 0x3e, 0x75, 0x53, 0xfa, 0xa9, 0x06, 0x43, 0x2a, 0x44, 0x91, 0xc8, 0xa3, 0x01,
+	0x0d, 0x86, 0x32, 0xd2, 0x52, 0x31, 0x92, 0x04, 0xb3, 0x91, 0x32, 0x1a, 0x0c, 0xa9, 0x80, 0x74,
+	0x58, 0x71, 0x48, 0x9f, 0x70, 0x22, 0xbf, 0x9e, 0xab, 0x38, 0x16, 0xd1, 0x2d, 0x28, 0xfb, 0xdf,
+	0x79, 0x24, 0x60, 0x7a, 0xb9, 0x5e, 0xca, 0xa7, 0x29, 0xf5, 0x71, 0x68, 0x25, 0x61, 0x65, 0x8e,
+	0xf6, 0x01, 0x2c, 0xce, 0x03, 0x6a, 0x87, 0x9c, 0x30, 0x7d, 0xa5, 0xc8, 0x2d, 0xc5, 0x92, 0x5e,
+	0x4a, 0x35, 0x38, 0xe3, 0x8a, 0x6e, 0xc2, 0xb2, 0x78, 0x96, 0xea, 0xab, 0x82, 0xc0, 0xc5, 0xf1,
+	0xc8, 0xd8, 0x94, 0x31, 0x84, 0x3a, 0x76, 0x97, 0x02, 0x96, 0xb6, 0x8d, 0x1e, 0x54, 0x77, 0xe2,
+	0xde, 0xbb, 0xed, 0xf1, 0x60, 0x88, 0x10, 0x2c, 0x45, 0x90, 0x3c, 0x5b, 0x2c, 0xd6, 0xc8, 0x84,
+	0x65, 0x12, 0x81, 0xea, 0x75, 0xf7, 0x76, 0xf3, 0xc4, 0x97, 0x70, 0xf3, 0x33, 0xcb, 0x25, 0x49,
+	0x54, 0x2c, 0x5d, 0x1b, 0x7f, 0x97, 0x60, 0x3d, 0x07, 0xa0, 0xaf, 0xe0, 0xac, 0xa8, 0x41, 0x7b,
+	0x10, 0xda, 0x7d, 0xda, 0x69, 0x3f, 0x24, 0x43, 0x5d, 0x2b, 0x5e, 0x54, 0x61, 0x71, 0x28, 0x0c,
+	0x3e, 0x25, 0xc3, 0x5c, 0x11, 0x53, 0x2d, 0xae, 0xe6, 0x15, 0xe8, 0x10, 0xd6, 0x65, 0x68, 0xcb,
+	0x71, 0x02, 0xc2, 0x98, 0xba, 0x7b, 0xd7, 0xc6, 0x23, 0xe3, 0x4a, 0x26, 0xee, 0x8e, 0x44, 0x73,
+	0x51, 0x63, 0x1d, 0x3e, 0x93, 0x15, 0xd1, 0x79, 0x28, 0xf7, 0x08, 0xed, 0xf6, 0xe4, 0xab, 0x72,
+	0x09, 0x2b, 0x29, 0xd2, 0x33, 0x6e, 0xf1, 0x90, 0xc9, 0x6b, 0x85, 0x95, 0x84, 0xf6, 0x00, 0xe2,
+	0x51, 0x47, 0xe5, 0x65, 0xa9, 0x98, 0x97, 0xc7, 0x23, 0xe3, 0xad, 0x78, 0x6a, 0x0a, 0xec, 0x60,
+	0x2f, 0x9d, 0x90, 0xb1, 0x02, 0x57, 0xe2, 0x75, 0xae, 0x7b, 0xca, 0x53, 0xbb, 0x67, 0x2f, 0xd7,
+	0x3d, 0x7b, 0x69, 0xf7, 0xf4, 0xf3, 0x77, 0x5e, 0x3e, 0x60, 0xb6, 0x26, 0xc6, 0xd3, 0xfd, 0xf8,
+	0x37, 0x88, 0xd9, 0x52, 0xf3, 0xe9, 0x34, 0x3d, 0xf1, 0x38, 0x9a, 0x3f, 0x99, 0xbe, 0x68, 0x7c,
+	0x03, 0x95, 0xe8, 0x6c, 0x67, 0xdf, 0xa0, 0x8f, 0xf3, 0x37, 0xe8, 0xea, 0x29, 0x6e, 0x90, 0x9c,
+	0x34, 0xf1, 0xf5, 0xf9, 0x45, 0x03, 0x48, 0xb5, 0xe8, 0x0e, 0x94, 0xfb, 0x16, 0x27, 0x2c, 0xfe,
+	0x95, 0xd3, 0x3c, 0x75, 0x40, 0xc1, 0x11, 0x2b, 0x6f, 0x74, 0x17, 0x56, 0x7a, 0x94, 0x71, 0x5f,
+	0x30, 0x2b, 0xbd, 0x42, 0xa0, 0xd8, 0xbd, 0xf1, 0x3e, 0xfc, 0xaf, 0x80, 0xa1, 0x6a, 0x3a, 0x24,
+	0xc5, 0x2c, 0x4c, 0xef, 0xd0, 0x62, 0xf6, 0x0e, 0x35, 0x02, 0xa8, 0x1c, 0xd1, 0xae, 0x67, 0xf1,
+	0x30, 0x20, 0xe8, 0x1a, 0x94, 0x18, 0xed, 0xaa, 0x46, 0xd8, 0x1c, 0x8f, 0x8c, 0x73, 0xf2, 0x40,
+	0x18, 0xed, 0xc6, 0x27, 0x11, 0x2d, 0x71, 0x64, 0x15, 0xdd, 0x8f, 0x41, 0x68, 0x8b, 0xce, 0x99,
+	0x98, 0xae, 0x83, 0xd0, 0xce, 0x74, 0x8c, 0x92, 0x70, 0x59, 0x2d, 0x5e, 0x2e, 0x42, 0x55, 0x7c,
+	0xd9, 0x76, 0xc5, 0x88, 0x3f, 0x22, 0x3c, 0x43, 0x2f, 0xda, 0xbc, 0x94, 0x5c, 0x71, 0x1d, 0x56,
+	0xe4, 0xcf, 0x2d, 0x26, 0x6a, 0x54, 0xc1, 0xb1, 0x88, 0xb6, 0x60, 0x55, 0xdd, 0x55, 0xa6, 0x97,
+	0x04, 0x94, 0xc8, 0xe8, 0x07, 0x38, 0xa3, 0xd6, 0xd1, 0x37, 0x3e, 0x6a, 0x8f, 0xa8, 0xbc, 0xd7,
+	0xe7, 0x94, 0x57, 0x7d, 0xff, 0x4d, 0xea, 0x1c, 0x78, 0x0f, 0x7c, 0xf3, 0x6a, 0xfa, 0xeb, 0xd4,
+	0x4a, 0x10, 0x56, 0xe8, 0x19, 0xa1, 0xc2, 0x6b, 0x19, 0x09, 0xd5, 0x61, 0x2d, 0x7e, 0x52, 0x50,
+	0xc2, 0xf4, 0x65, 0xc1, 0x2d, 0xab, 0x42, 0x1b, 0xf1, 0xb4, 0x14, 0xe3, 0x5a, 0x8d, 0x43, 0x74,
+	0x04, 0x4b, 0xa7, 0x6c, 0x97, 0x4b, 0xaa, 0x5d, 0x2e, 0x48, 0x76, 0x3c, 0xd3, 0x28, 0x3c, 0x69,
+	0x11, 0x11, 0xac, 0xf1, 0xbb, 0x16, 0x0d, 0xd9, 0x6c, 0x5e, 0x85, 0xe9, 0xa0, 0xbd, 0xe2, 0x74,
+	0xb8, 0x0f, 0x55, 0x9b, 0x3a, 0xce, 0xc4, 0x98, 0xbb, 0x3e, 0x1e, 0x19, 0x57, 0xd5, 0x90, 0x10,
+	0x78, 0x61, 0xce, 0xe5, 0x95, 0x78, 0x3d, 0x27, 0x9b, 0x9f, 0x3c, 0x7d, 0x5e, 0xd3, 0x9e, 0x3d,
+	0xaf, 0x69, 0xff, 0x3e, 0xaf, 0x69, 0x8f, 0x5f, 0xd4, 0x16, 0x9e, 0xbd, 0xa8, 0x2d, 0xfc, 0xf5,
+	0xa2, 0xb6, 0xf0, 0xf5, 0x3b, 0x5d, 0xca, 0x7b, 0xa1, 0xdd, 0xec, 0xf8, 0x6e, 0x8b, 0xf7, 0xac,
+	0x80, 0x51, 0xd6, 0x22, 0xbc, 0x47, 0x02, 0x97, 0x7a, 0xbc, 0xf5, 0x28, 0xf7, 0x37, 0x0a, 0x1f,
+	0x0e, 0x08, 0xb3, 0xcb, 0xa2, 0x72, 0x37, 0xff, 0x1b, 0x00, 0xa2, 0xf8, 0x82, 0x7a, 0x6c, 0x11,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -878,6 +922,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChangesetMode) > 0 {
+		i -= len(m.ChangesetMode)
+		copy(dAtA[i:], m.ChangesetMode)
+		i = encodeVarintNameservice(dAtA, i, uint64(len(m.ChangesetMode)))
+		i--
+		dAtA[i] = 0x72
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ChangesetRetentionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ChangesetRetentionPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintNameservice(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x6a
+	if m.ChangesetRetentionBlocks != 0 {
+		i = encodeVarintNameservice(dAtA, i, uint64(m.ChangesetRetentionBlocks))
+		i--
+		dAtA[i] = 0x60
+	}
 	{
 		size, err := m.AuthorityAuctionMinimumBid.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -908,21 +972,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x4a
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AuthorityAuctionRevealsDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AuthorityAuctionRevealsDuration):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintNameservice(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x42
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AuthorityAuctionCommitsDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AuthorityAuctionCommitsDuration):])
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AuthorityAuctionRevealsDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AuthorityAuctionRevealsDuration):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintNameservice(dAtA, i, uint64(n5))
 	i--
+	dAtA[i] = 0x42
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AuthorityAuctionCommitsDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AuthorityAuctionCommitsDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintNameservice(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0x3a
 	if m.AuthorityAuctionEnabled {
 		i--
@@ -934,21 +998,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AuthorityGracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AuthorityGracePeriod):])
-	if err6 != nil {
-		return 0, err6
-	}
-	i -= n6
-	i = encodeVarintNameservice(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x2a
-	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AuthorityRentDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AuthorityRentDuration):])
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AuthorityGracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AuthorityGracePeriod):])
 	if err7 != nil {
 		return 0, err7
 	}
 	i -= n7
 	i = encodeVarintNameservice(dAtA, i, uint64(n7))
 	i--
+	dAtA[i] = 0x2a
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AuthorityRentDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AuthorityRentDuration):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintNameservice(dAtA, i, uint64(n8))
+	i--
 	dAtA[i] = 0x22
 	{
 		size, err := m.AuthorityRent.MarshalToSizedBuffer(dAtA[:i])
@@ -960,12 +1024,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RecordRentDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordRentDuration):])
-	if err9 != nil {
-		return 0, err9
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RecordRentDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordRentDuration):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n9
-	i = encodeVarintNameservice(dAtA, i, uint64(n9))
+	i -= n10
+	i = encodeVarintNameservice(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -1129,12 +1193,12 @@ func (m *NameAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiryTime):])
-	if err12 != nil {
-		return 0, err12
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiryTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n12
-	i = encodeVarintNameservice(dAtA, i, uint64(n12))
+	i -= n13
+	i = encodeVarintNameservice(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x3a
 	if len(m.BondId) > 0 {
@@ -1363,6 +1427,14 @@ func (m *BlockChangeSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintNameservice(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x3a
 	if len(m.Names) > 0 {
 		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Names[iNdEx])
@@ -1498,6 +1570,15 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovNameservice(uint64(l))
 	l = m.AuthorityAuctionMinimumBid.Size()
 	n += 1 + l + sovNameservice(uint64(l))
+	if m.ChangesetRetentionBlocks != 0 {
+		n += 1 + sovNameservice(uint64(m.ChangesetRetentionBlocks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ChangesetRetentionPeriod)
+	n += 1 + l + sovNameservice(uint64(l))
+	l = len(m.ChangesetMode)
+	if l > 0 {
+		n += 1 + l + sovNameservice(uint64(l))
+	}
 	return n
 }
 
@@ -1704,6 +1785,8 @@ func (m *BlockChangeSet) Size() (n int) {
 			n += 1 + l + sovNameservice(uint64(l))
 		}
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovNameservice(uint64(l))
 	return n
 }
 
@@ -2109,6 +2192,90 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangesetRetentionBlocks", wireType)
+			}
+			m.ChangesetRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNameservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangesetRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangesetRetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNameservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNameservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNameservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ChangesetRetentionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangesetMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNameservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNameservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNameservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangesetMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNameservice(dAtA[iNdEx:])
@@ -3467,6 +3634,39 @@ func (m *BlockChangeSet) Unmarshal(dAtA []byte) error {
 			}
 			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNameservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNameservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNameservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNameservice(dAtA[iNdEx:])
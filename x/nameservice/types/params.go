@@ -26,6 +26,18 @@ var (
 	DefaultCommitFee               = sdk.NewInt(1000000)
 	DefaultRevealFee               = sdk.NewInt(1000000)
 	DefaultMinimumBid              = sdk.NewInt(5000000)
+
+	DefaultChangeSetRetentionBlocks uint64 = 100000
+	DefaultChangeSetRetentionPeriod        = time.Duration(0)
+	DefaultChangeSetMode                   = ChangeSetModeStore
+)
+
+const (
+	// ChangeSetModeStore keeps the changeset of every block in the store, subject to the retention params.
+	ChangeSetModeStore = "store"
+
+	// ChangeSetModeEvents doesn't store changesets, changes are emitted as block-change events instead.
+	ChangeSetModeEvents = "events"
 )
 
 // Keys for parameter access
@@ -43,6 +55,10 @@ var (
 	KeyCommitFee               = []byte("AuthorityAuctionCommitFee")
 	KeyRevealFee               = []byte("AuthorityAuctionRevealFee")
 	KeyMinimumBid              = []byte("AuthorityAuctionMinimumBid")
+
+	KeyChangeSetRetentionBlocks = []byte("ChangeSetRetentionBlocks")
+	KeyChangeSetRetentionPeriod = []byte("ChangeSetRetentionPeriod")
+	KeyChangeSetMode            = []byte("ChangeSetMode")
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -68,6 +84,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyCommitFee, &p.AuthorityAuctionCommitFee, validateCommitFee),
 		paramtypes.NewParamSetPair(KeyRevealFee, &p.AuthorityAuctionRevealFee, validateRevealFee),
 		paramtypes.NewParamSetPair(KeyMinimumBid, &p.AuthorityAuctionMinimumBid, validateMinimumBid),
+
+		paramtypes.NewParamSetPair(KeyChangeSetRetentionBlocks, &p.ChangesetRetentionBlocks, validateChangeSetRetentionBlocks),
+		paramtypes.NewParamSetPair(KeyChangeSetRetentionPeriod, &p.ChangesetRetentionPeriod, validateChangeSetRetentionPeriod),
+		paramtypes.NewParamSetPair(KeyChangeSetMode, &p.ChangesetMode, validateChangeSetMode),
 	}
 }
 
@@ -75,7 +95,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func NewParams(recordRent sdk.Coin, recordRentDuration time.Duration,
 	authorityRent sdk.Coin, authorityRentDuration time.Duration, authorityGracePeriod time.Duration,
 	authorityAuctionEnabled bool, commitsDuration time.Duration, revealsDuration time.Duration,
-	commitFee sdk.Coin, revealFee sdk.Coin, minimumBid sdk.Coin) Params {
+	commitFee sdk.Coin, revealFee sdk.Coin, minimumBid sdk.Coin,
+	changeSetRetentionBlocks uint64, changeSetRetentionPeriod time.Duration, changeSetMode string) Params {
 
 	return Params{
 		RecordRent:         recordRent,
@@ -91,6 +112,10 @@ func NewParams(recordRent sdk.Coin, recordRentDuration time.Duration,
 		AuthorityAuctionCommitFee:       commitFee,
 		AuthorityAuctionRevealFee:       revealFee,
 		AuthorityAuctionMinimumBid:      minimumBid,
+
+		ChangesetRetentionBlocks: changeSetRetentionBlocks,
+		ChangesetRetentionPeriod: changeSetRetentionPeriod,
+		ChangesetMode:            changeSetMode,
 	}
 }
 
@@ -111,6 +136,7 @@ func DefaultParams() Params {
 		sdk.NewCoin(sdk.DefaultBondDenom, DefaultCommitFee),
 		sdk.NewCoin(sdk.DefaultBondDenom, DefaultRevealFee),
 		sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinimumBid),
+		DefaultChangeSetRetentionBlocks, DefaultChangeSetRetentionPeriod, DefaultChangeSetMode,
 	)
 }
 
@@ -189,6 +215,42 @@ func validateMinimumBid(i interface{}) error {
 	return validateAmount("AuthorityMinimumBid", i)
 }
 
+func validateChangeSetRetentionBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("%s invalid parameter type: %T", "ChangeSetRetentionBlocks", i)
+	}
+
+	return nil
+}
+
+func validateChangeSetRetentionPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("%s invalid parameter type: %T", "ChangeSetRetentionPeriod", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("%s can't be negative", "ChangeSetRetentionPeriod")
+	}
+
+	return nil
+}
+
+func validateChangeSetMode(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("%s invalid parameter type: %T", "ChangeSetMode", i)
+	}
+
+	switch v {
+	case ChangeSetModeStore, ChangeSetModeEvents:
+		return nil
+	default:
+		return fmt.Errorf("invalid changeset mode: %s", v)
+	}
+}
+
 // Validate a set of params.
 func (p Params) Validate() error {
 	if err := validateRecordRent(p.RecordRent); err != nil {
@@ -235,5 +297,17 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateChangeSetRetentionBlocks(p.ChangesetRetentionBlocks); err != nil {
+		return err
+	}
+
+	if err := validateChangeSetRetentionPeriod(p.ChangesetRetentionPeriod); err != nil {
+		return err
+	}
+
+	if err := validateChangeSetMode(p.ChangesetMode); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -421,7 +421,7 @@ func (m *QueryListRecordsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-//QueryRecordByIdRequest is request type for nameservice records by id
+// QueryRecordByIdRequest is request type for nameservice records by id
 type QueryRecordByIdRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -1124,6 +1124,279 @@ func (m *QueryResolveCrnResponse) GetRecord() *Record {
 	return nil
 }
 
+// QueryGetRecordsByIdsRequest is request type for a batch of records by id
+type QueryGetRecordsByIdsRequest struct {
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *QueryGetRecordsByIdsRequest) Reset()         { *m = QueryGetRecordsByIdsRequest{} }
+func (m *QueryGetRecordsByIdsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecordsByIdsRequest) ProtoMessage()    {}
+func (*QueryGetRecordsByIdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73d2465766c8f876, []int{19}
+}
+func (m *QueryGetRecordsByIdsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRecordsByIdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRecordsByIdsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRecordsByIdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecordsByIdsRequest.Merge(m, src)
+}
+func (m *QueryGetRecordsByIdsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRecordsByIdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecordsByIdsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRecordsByIdsRequest proto.InternalMessageInfo
+
+func (m *QueryGetRecordsByIdsRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+// QueryGetRecordsByIdsResponse is response type for a batch of records by id
+type QueryGetRecordsByIdsResponse struct {
+	// Records in the order of the requested ids, records not found are empty (with no id)
+	Records []Record `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryGetRecordsByIdsResponse) Reset()         { *m = QueryGetRecordsByIdsResponse{} }
+func (m *QueryGetRecordsByIdsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecordsByIdsResponse) ProtoMessage()    {}
+func (*QueryGetRecordsByIdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73d2465766c8f876, []int{20}
+}
+func (m *QueryGetRecordsByIdsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRecordsByIdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRecordsByIdsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRecordsByIdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecordsByIdsResponse.Merge(m, src)
+}
+func (m *QueryGetRecordsByIdsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRecordsByIdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecordsByIdsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRecordsByIdsResponse proto.InternalMessageInfo
+
+func (m *QueryGetRecordsByIdsResponse) GetRecords() []Record {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+// QueryLookupCrnsRequest is request type for LookupCrns
+type QueryLookupCrnsRequest struct {
+	Crns []string `protobuf:"bytes,1,rep,name=crns,proto3" json:"crns,omitempty"`
+}
+
+func (m *QueryLookupCrnsRequest) Reset()         { *m = QueryLookupCrnsRequest{} }
+func (m *QueryLookupCrnsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLookupCrnsRequest) ProtoMessage()    {}
+func (*QueryLookupCrnsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73d2465766c8f876, []int{21}
+}
+func (m *QueryLookupCrnsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLookupCrnsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLookupCrnsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLookupCrnsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLookupCrnsRequest.Merge(m, src)
+}
+func (m *QueryLookupCrnsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLookupCrnsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLookupCrnsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLookupCrnsRequest proto.InternalMessageInfo
+
+func (m *QueryLookupCrnsRequest) GetCrns() []string {
+	if m != nil {
+		return m.Crns
+	}
+	return nil
+}
+
+// QueryLookupCrnsResponse is response type for LookupCrns
+type QueryLookupCrnsResponse struct {
+	// Name records in the order of the requested CRNs, names not found are empty (with no latest entry)
+	Names []NameRecord `protobuf:"bytes,1,rep,name=names,proto3" json:"names"`
+}
+
+func (m *QueryLookupCrnsResponse) Reset()         { *m = QueryLookupCrnsResponse{} }
+func (m *QueryLookupCrnsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLookupCrnsResponse) ProtoMessage()    {}
+func (*QueryLookupCrnsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73d2465766c8f876, []int{22}
+}
+func (m *QueryLookupCrnsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLookupCrnsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLookupCrnsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLookupCrnsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLookupCrnsResponse.Merge(m, src)
+}
+func (m *QueryLookupCrnsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLookupCrnsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLookupCrnsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLookupCrnsResponse proto.InternalMessageInfo
+
+func (m *QueryLookupCrnsResponse) GetNames() []NameRecord {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+// QueryResolveCrnsRequest is request type for ResolveCrns
+type QueryResolveCrnsRequest struct {
+	Crns []string `protobuf:"bytes,1,rep,name=crns,proto3" json:"crns,omitempty"`
+}
+
+func (m *QueryResolveCrnsRequest) Reset()         { *m = QueryResolveCrnsRequest{} }
+func (m *QueryResolveCrnsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveCrnsRequest) ProtoMessage()    {}
+func (*QueryResolveCrnsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73d2465766c8f876, []int{23}
+}
+func (m *QueryResolveCrnsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveCrnsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveCrnsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResolveCrnsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveCrnsRequest.Merge(m, src)
+}
+func (m *QueryResolveCrnsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveCrnsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveCrnsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolveCrnsRequest proto.InternalMessageInfo
+
+func (m *QueryResolveCrnsRequest) GetCrns() []string {
+	if m != nil {
+		return m.Crns
+	}
+	return nil
+}
+
+// QueryResolveCrnsResponse is response type for ResolveCrns
+type QueryResolveCrnsResponse struct {
+	// Records in the order of the requested CRNs, CRNs that don't resolve are empty records (with no id)
+	Records []Record `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryResolveCrnsResponse) Reset()         { *m = QueryResolveCrnsResponse{} }
+func (m *QueryResolveCrnsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveCrnsResponse) ProtoMessage()    {}
+func (*QueryResolveCrnsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73d2465766c8f876, []int{24}
+}
+func (m *QueryResolveCrnsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveCrnsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveCrnsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResolveCrnsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveCrnsResponse.Merge(m, src)
+}
+func (m *QueryResolveCrnsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveCrnsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveCrnsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolveCrnsResponse proto.InternalMessageInfo
+
+func (m *QueryResolveCrnsResponse) GetRecords() []Record {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 // QueryGetRecordExpiryQueue
 type QueryGetRecordExpiryQueue struct {
 	// pagination defines an optional pagination for the request.
@@ -1134,7 +1407,7 @@ func (m *QueryGetRecordExpiryQueue) Reset()         { *m = QueryGetRecordExpiryQ
 func (m *QueryGetRecordExpiryQueue) String() string { return proto.CompactTextString(m) }
 func (*QueryGetRecordExpiryQueue) ProtoMessage()    {}
 func (*QueryGetRecordExpiryQueue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73d2465766c8f876, []int{19}
+	return fileDescriptor_73d2465766c8f876, []int{25}
 }
 func (m *QueryGetRecordExpiryQueue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1181,7 +1454,7 @@ func (m *QueryGetRecordExpiryQueueResponse) Reset()         { *m = QueryGetRecor
 func (m *QueryGetRecordExpiryQueueResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetRecordExpiryQueueResponse) ProtoMessage()    {}
 func (*QueryGetRecordExpiryQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73d2465766c8f876, []int{20}
+	return fileDescriptor_73d2465766c8f876, []int{26}
 }
 func (m *QueryGetRecordExpiryQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1234,7 +1507,7 @@ func (m *ExpiryQueueRecord) Reset()         { *m = ExpiryQueueRecord{} }
 func (m *ExpiryQueueRecord) String() string { return proto.CompactTextString(m) }
 func (*ExpiryQueueRecord) ProtoMessage()    {}
 func (*ExpiryQueueRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73d2465766c8f876, []int{21}
+	return fileDescriptor_73d2465766c8f876, []int{27}
 }
 func (m *ExpiryQueueRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1287,7 +1560,7 @@ func (m *QueryGetAuthorityExpiryQueue) Reset()         { *m = QueryGetAuthorityE
 func (m *QueryGetAuthorityExpiryQueue) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAuthorityExpiryQueue) ProtoMessage()    {}
 func (*QueryGetAuthorityExpiryQueue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73d2465766c8f876, []int{22}
+	return fileDescriptor_73d2465766c8f876, []int{28}
 }
 func (m *QueryGetAuthorityExpiryQueue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1334,7 +1607,7 @@ func (m *QueryGetAuthorityExpiryQueueResponse) Reset()         { *m = QueryGetAu
 func (m *QueryGetAuthorityExpiryQueueResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAuthorityExpiryQueueResponse) ProtoMessage()    {}
 func (*QueryGetAuthorityExpiryQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73d2465766c8f876, []int{23}
+	return fileDescriptor_73d2465766c8f876, []int{29}
 }
 func (m *QueryGetAuthorityExpiryQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1377,6 +1650,116 @@ func (m *QueryGetAuthorityExpiryQueueResponse) GetPagination() *query.PageRespon
 	return nil
 }
 
+// QueryGetBlockChangeSetsRequest is request type for block changesets
+type QueryGetBlockChangeSetsRequest struct {
+	// First block height (inclusive)
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// Last block height (inclusive), defaults to the latest height
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *QueryGetBlockChangeSetsRequest) Reset()         { *m = QueryGetBlockChangeSetsRequest{} }
+func (m *QueryGetBlockChangeSetsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBlockChangeSetsRequest) ProtoMessage()    {}
+func (*QueryGetBlockChangeSetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73d2465766c8f876, []int{30}
+}
+func (m *QueryGetBlockChangeSetsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBlockChangeSetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBlockChangeSetsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetBlockChangeSetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBlockChangeSetsRequest.Merge(m, src)
+}
+func (m *QueryGetBlockChangeSetsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBlockChangeSetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBlockChangeSetsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetBlockChangeSetsRequest proto.InternalMessageInfo
+
+func (m *QueryGetBlockChangeSetsRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryGetBlockChangeSetsRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// QueryGetBlockChangeSetsResponse is response type for block changesets
+type QueryGetBlockChangeSetsResponse struct {
+	// Changesets of the blocks in the range that changed nameservice state, in height order
+	BlockChangeSets []*BlockChangeSet `protobuf:"bytes,1,rep,name=block_change_sets,json=blockChangeSets,proto3" json:"block_change_sets,omitempty"`
+	// Last block height covered by the response, continue from the next height to page through a range
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *QueryGetBlockChangeSetsResponse) Reset()         { *m = QueryGetBlockChangeSetsResponse{} }
+func (m *QueryGetBlockChangeSetsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBlockChangeSetsResponse) ProtoMessage()    {}
+func (*QueryGetBlockChangeSetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73d2465766c8f876, []int{31}
+}
+func (m *QueryGetBlockChangeSetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBlockChangeSetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBlockChangeSetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetBlockChangeSetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBlockChangeSetsResponse.Merge(m, src)
+}
+func (m *QueryGetBlockChangeSetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBlockChangeSetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBlockChangeSetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetBlockChangeSetsResponse proto.InternalMessageInfo
+
+func (m *QueryGetBlockChangeSetsResponse) GetBlockChangeSets() []*BlockChangeSet {
+	if m != nil {
+		return m.BlockChangeSets
+	}
+	return nil
+}
+
+func (m *QueryGetBlockChangeSetsResponse) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "vulcanize.nameservice.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "vulcanize.nameservice.v1beta1.QueryParamsResponse")
@@ -1400,11 +1783,19 @@ func init() {
 	proto.RegisterType((*QueryLookupCrnResponse)(nil), "vulcanize.nameservice.v1beta1.QueryLookupCrnResponse")
 	proto.RegisterType((*QueryResolveCrn)(nil), "vulcanize.nameservice.v1beta1.QueryResolveCrn")
 	proto.RegisterType((*QueryResolveCrnResponse)(nil), "vulcanize.nameservice.v1beta1.QueryResolveCrnResponse")
+	proto.RegisterType((*QueryGetRecordsByIdsRequest)(nil), "vulcanize.nameservice.v1beta1.QueryGetRecordsByIdsRequest")
+	proto.RegisterType((*QueryGetRecordsByIdsResponse)(nil), "vulcanize.nameservice.v1beta1.QueryGetRecordsByIdsResponse")
+	proto.RegisterType((*QueryLookupCrnsRequest)(nil), "vulcanize.nameservice.v1beta1.QueryLookupCrnsRequest")
+	proto.RegisterType((*QueryLookupCrnsResponse)(nil), "vulcanize.nameservice.v1beta1.QueryLookupCrnsResponse")
+	proto.RegisterType((*QueryResolveCrnsRequest)(nil), "vulcanize.nameservice.v1beta1.QueryResolveCrnsRequest")
+	proto.RegisterType((*QueryResolveCrnsResponse)(nil), "vulcanize.nameservice.v1beta1.QueryResolveCrnsResponse")
 	proto.RegisterType((*QueryGetRecordExpiryQueue)(nil), "vulcanize.nameservice.v1beta1.QueryGetRecordExpiryQueue")
 	proto.RegisterType((*QueryGetRecordExpiryQueueResponse)(nil), "vulcanize.nameservice.v1beta1.QueryGetRecordExpiryQueueResponse")
 	proto.RegisterType((*ExpiryQueueRecord)(nil), "vulcanize.nameservice.v1beta1.ExpiryQueueRecord")
 	proto.RegisterType((*QueryGetAuthorityExpiryQueue)(nil), "vulcanize.nameservice.v1beta1.QueryGetAuthorityExpiryQueue")
 	proto.RegisterType((*QueryGetAuthorityExpiryQueueResponse)(nil), "vulcanize.nameservice.v1beta1.QueryGetAuthorityExpiryQueueResponse")
+	proto.RegisterType((*QueryGetBlockChangeSetsRequest)(nil), "vulcanize.nameservice.v1beta1.QueryGetBlockChangeSetsRequest")
+	proto.RegisterType((*QueryGetBlockChangeSetsResponse)(nil), "vulcanize.nameservice.v1beta1.QueryGetBlockChangeSetsResponse")
 }
 
 func init() {
@@ -1412,96 +1803,113 @@ func init() {
 }
 
 var fileDescriptor_73d2465766c8f876 = []byte{
-	// 1412 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x6c, 0x1b, 0x45,
-	0x18, 0xce, 0x24, 0xb1, 0xd3, 0xfc, 0xa1, 0x29, 0x1d, 0xa2, 0xd6, 0xdd, 0xb6, 0x4e, 0xd8, 0xbe,
-	0x1c, 0xa8, 0x77, 0x9b, 0x94, 0xbe, 0x05, 0xa2, 0x4e, 0x43, 0xd4, 0x52, 0x10, 0x5d, 0x10, 0x05,
-	0x0e, 0x54, 0xeb, 0xf5, 0xd4, 0x59, 0x6a, 0xcf, 0xb8, 0xbb, 0xb3, 0xa1, 0x6e, 0xd5, 0x0b, 0x87,
-	0x9e, 0x91, 0x38, 0x83, 0x40, 0xe2, 0x54, 0x09, 0xb8, 0x70, 0xa8, 0xb8, 0x72, 0xa9, 0x38, 0x55,
-	0x82, 0x03, 0xa7, 0x82, 0x5a, 0x24, 0xee, 0x3d, 0x72, 0x42, 0x3b, 0x33, 0xbb, 0xde, 0x4d, 0x9c,
-	0x78, 0xed, 0x04, 0x89, 0x93, 0x67, 0x67, 0xff, 0xc7, 0xf7, 0xfd, 0x8f, 0xd9, 0x7f, 0x0c, 0xb3,
-	0x2b, 0x41, 0xc3, 0xb1, 0xa9, 0x7b, 0x9b, 0x98, 0xd4, 0x6e, 0x12, 0x9f, 0x78, 0x2b, 0xae, 0x43,
-	0xcc, 0x95, 0xb9, 0x2a, 0xe1, 0xf6, 0x9c, 0x79, 0x33, 0x20, 0x5e, 0xdb, 0x68, 0x79, 0x8c, 0x33,
-	0xbc, 0x3f, 0x16, 0x35, 0x12, 0xa2, 0x86, 0x12, 0xd5, 0xcc, 0x8d, 0x2d, 0x25, 0x55, 0x84, 0x3d,
-	0x6d, 0x5f, 0x9d, 0xb1, 0x7a, 0x83, 0x98, 0x76, 0xcb, 0x35, 0x6d, 0x4a, 0x19, 0xb7, 0xb9, 0xcb,
-	0xa8, 0xaf, 0xde, 0xbe, 0xe4, 0x30, 0xbf, 0xc9, 0x7c, 0xb3, 0x6a, 0xfb, 0x44, 0xc2, 0x88, 0x4d,
-	0xb5, 0xec, 0xba, 0x4b, 0x85, 0xb0, 0x92, 0x9d, 0xaa, 0xb3, 0x3a, 0x13, 0x4b, 0x33, 0x5c, 0xa9,
-	0xdd, 0x62, 0xd2, 0x42, 0xa4, 0xeb, 0x30, 0x57, 0x69, 0xe9, 0x53, 0x80, 0xaf, 0x84, 0x76, 0xdf,
-	0xb1, 0x3d, 0xbb, 0xe9, 0x5b, 0xe4, 0x66, 0x40, 0x7c, 0xae, 0xbf, 0x07, 0x2f, 0xa4, 0x76, 0xfd,
-	0x16, 0xa3, 0x3e, 0xc1, 0xaf, 0x42, 0xbe, 0x25, 0x76, 0x0a, 0x68, 0x06, 0x95, 0x26, 0xe6, 0x0f,
-	0x19, 0x1b, 0x46, 0xc3, 0x50, 0xea, 0x4a, 0x49, 0xff, 0x31, 0x07, 0xbb, 0x85, 0xd9, 0xcb, 0xae,
-	0xcf, 0x2d, 0xe2, 0x30, 0xaf, 0x16, 0x79, 0xc4, 0x35, 0x00, 0x9b, 0x73, 0xcf, 0xad, 0x06, 0x9c,
-	0x84, 0xe6, 0x47, 0x4a, 0x13, 0xf3, 0x17, 0x7a, 0x98, 0x5f, 0xc7, 0x96, 0xf1, 0x26, 0x69, 0xbf,
-	0x6f, 0x37, 0x02, 0x72, 0x91, 0xb6, 0x02, 0x6e, 0x25, 0xec, 0xe2, 0xe7, 0x61, 0xc4, 0x6e, 0x34,
-	0x0a, 0xc3, 0x33, 0xa8, 0xb4, 0xcd, 0x0a, 0x97, 0xf8, 0x0d, 0x80, 0x4e, 0x24, 0x0b, 0x23, 0x82,
-	0xd6, 0x61, 0x43, 0x06, 0xcd, 0x08, 0x83, 0x66, 0xc8, 0xec, 0x77, 0x28, 0xd5, 0x89, 0xf2, 0x63,
-	0x25, 0x34, 0xb5, 0x19, 0x98, 0xb4, 0xc8, 0x75, 0xe2, 0x11, 0xea, 0x48, 0xbf, 0x78, 0x12, 0x86,
-	0xdd, 0x9a, 0x08, 0xd4, 0xb8, 0x35, 0xec, 0xd6, 0xb4, 0x9f, 0x86, 0x01, 0x3a, 0xb0, 0x30, 0x86,
-	0x51, 0xde, 0x6e, 0x11, 0x25, 0x20, 0xd6, 0x78, 0x17, 0xe4, 0x7d, 0xee, 0xb9, 0xb4, 0x2e, 0x10,
-	0x8e, 0x5b, 0xea, 0x29, 0x84, 0xed, 0x52, 0x2e, 0xd0, 0x8d, 0x58, 0xe1, 0x12, 0x4f, 0x41, 0xee,
-	0x7a, 0x83, 0xd9, 0xbc, 0x30, 0x3a, 0x83, 0x4a, 0xc8, 0x92, 0x0f, 0xb8, 0x00, 0x63, 0x55, 0xc6,
-	0x1a, 0xc4, 0xa6, 0x85, 0x9c, 0xa0, 0x18, 0x3d, 0x62, 0x07, 0xc6, 0xbd, 0x08, 0x5e, 0x21, 0x2f,
-	0x58, 0x2e, 0x0e, 0x18, 0xdd, 0x34, 0x4d, 0xab, 0x63, 0x17, 0x7f, 0x08, 0xf9, 0x95, 0x90, 0xa0,
-	0x5f, 0x18, 0x13, 0xf9, 0x3b, 0x3f, 0xa0, 0x87, 0x44, 0xf2, 0x94, 0x41, 0xed, 0x36, 0x6c, 0x4f,
-	0x65, 0x35, 0x0c, 0xc9, 0x0d, 0xd2, 0x56, 0xd1, 0x0b, 0x97, 0xf8, 0x2a, 0xe4, 0x84, 0xb0, 0x88,
-	0xdd, 0x96, 0x38, 0x97, 0xf6, 0xf4, 0xfb, 0x08, 0x0a, 0x6b, 0xa5, 0x55, 0x4b, 0x2c, 0xc2, 0x98,
-	0x27, 0xb7, 0x54, 0xd1, 0xf6, 0xea, 0x09, 0x69, 0xa0, 0x32, 0xfa, 0xf0, 0xf1, 0xf4, 0x90, 0x15,
-	0xe9, 0xe2, 0xa5, 0x54, 0x19, 0x4a, 0x06, 0x47, 0x7a, 0x96, 0xa1, 0xc4, 0x90, 0xac, 0x43, 0xbd,
-	0x04, 0xbb, 0x04, 0x56, 0xe5, 0xa6, 0x7d, 0xb1, 0x16, 0x75, 0xd8, 0xaa, 0x7a, 0xd4, 0x3f, 0x56,
-	0xcd, 0x98, 0x94, 0x54, 0xa4, 0x16, 0x20, 0x2f, 0x81, 0x65, 0xec, 0xf3, 0x14, 0x27, 0xa5, 0xaa,
-	0x73, 0xd0, 0x52, 0xf6, 0x2b, 0x8c, 0xd6, 0xd6, 0x45, 0xb3, 0xaa, 0x0f, 0x87, 0x07, 0xed, 0x43,
-	0xfd, 0x3b, 0x04, 0x7b, 0xbb, 0xba, 0xfd, 0x9f, 0xe6, 0xeb, 0x20, 0xe8, 0x4b, 0x84, 0xbf, 0x6d,
-	0x37, 0xc9, 0xbb, 0xd2, 0xf1, 0x5b, 0xac, 0x16, 0x34, 0x48, 0xc5, 0x6e, 0xd8, 0xd4, 0x89, 0x18,
-	0xea, 0x2d, 0x38, 0xb0, 0xa1, 0x94, 0x22, 0x77, 0x11, 0xb6, 0x55, 0xe5, 0x56, 0xc4, 0xae, 0xdc,
-	0x83, 0xdd, 0x79, 0xc7, 0x61, 0x01, 0xe5, 0x91, 0xa1, 0x58, 0x5d, 0xff, 0x1b, 0xc1, 0x64, 0xfa,
-	0x25, 0xbe, 0x0c, 0xcf, 0xd9, 0x72, 0xe7, 0x5a, 0x68, 0x4a, 0x26, 0xaf, 0x32, 0xfb, 0xec, 0xf1,
-	0xf4, 0xa1, 0x4f, 0x7c, 0x46, 0xcf, 0xea, 0xea, 0x6d, 0x08, 0x53, 0x9f, 0x69, 0xdb, 0xcd, 0x46,
-	0x7a, 0xcb, 0x9a, 0x48, 0x3c, 0xe1, 0x7b, 0x08, 0xc6, 0x94, 0xb7, 0xc2, 0x88, 0xc0, 0xba, 0x27,
-	0x15, 0xbf, 0x08, 0xe1, 0x02, 0x73, 0x69, 0xe5, 0x4a, 0x18, 0xfd, 0x67, 0x8f, 0xa7, 0xf7, 0x4b,
-	0x47, 0x4a, 0x2f, 0x72, 0x12, 0x3d, 0xde, 0xff, 0x63, 0xba, 0x54, 0x77, 0xf9, 0x72, 0x50, 0x35,
-	0x1c, 0xd6, 0x34, 0xd5, 0x97, 0x4f, 0xfe, 0x94, 0xfd, 0xda, 0x0d, 0x33, 0x3c, 0x64, 0x7d, 0x61,
-	0xd1, 0xb7, 0x22, 0xe7, 0x3a, 0x51, 0x05, 0x13, 0x76, 0x77, 0x88, 0x6c, 0xd5, 0x87, 0x29, 0x5d,
-	0x98, 0x68, 0x33, 0x85, 0xb9, 0xaf, 0xbb, 0x1f, 0x95, 0xbc, 0x0b, 0x90, 0x13, 0x19, 0x52, 0x99,
-	0x2b, 0xf5, 0xc8, 0x5c, 0x68, 0x62, 0x91, 0x72, 0xaf, 0xad, 0x4a, 0x53, 0x2a, 0x6f, 0x5d, 0x61,
-	0x1e, 0x81, 0x9d, 0x02, 0xee, 0xd5, 0x65, 0xe6, 0xc6, 0xc1, 0xc0, 0x30, 0xda, 0x49, 0xbd, 0x25,
-	0xd6, 0xfa, 0x97, 0x48, 0x8d, 0x10, 0x4a, 0x52, 0xd1, 0xb9, 0x87, 0x60, 0x32, 0x7c, 0x7f, 0xcd,
-	0x0e, 0xf8, 0x32, 0xf3, 0x5c, 0xde, 0x56, 0xc1, 0x3b, 0x9a, 0x81, 0xd8, 0xf9, 0x48, 0xa7, 0x32,
-	0xa7, 0x32, 0x3f, 0x2b, 0x33, 0x4f, 0x93, 0x2f, 0xa3, 0xfc, 0xa7, 0x37, 0xad, 0xed, 0xe9, 0x67,
-	0x1d, 0x26, 0x65, 0xdc, 0x19, 0xbb, 0x11, 0xb4, 0x16, 0x3c, 0x1a, 0x7e, 0x3b, 0x1c, 0x8f, 0x46,
-	0xdf, 0x0e, 0xc7, 0xa3, 0xfa, 0x55, 0x75, 0x6a, 0xc6, 0x32, 0x89, 0x91, 0xa7, 0xc3, 0x78, 0x62,
-	0x7e, 0x36, 0x03, 0x76, 0x99, 0x57, 0x15, 0x9c, 0x03, 0xb0, 0x43, 0x9d, 0x46, 0x3e, 0x6b, 0xac,
-	0x90, 0xee, 0xde, 0x3f, 0x88, 0x4f, 0xe2, 0x48, 0x28, 0x39, 0x71, 0x0d, 0x70, 0x12, 0xc7, 0x67,
-	0xb0, 0x03, 0x7b, 0x84, 0xe5, 0x25, 0xa2, 0x3e, 0x5c, 0x8b, 0xb7, 0x5a, 0xae, 0xd7, 0xbe, 0x12,
-	0x90, 0x80, 0x6c, 0x59, 0x65, 0x3f, 0x40, 0xf0, 0xe2, 0xba, 0x5e, 0x62, 0x26, 0x97, 0x56, 0x1f,
-	0xbc, 0xc7, 0x7a, 0x50, 0x49, 0x19, 0x11, 0xac, 0xb6, 0xfe, 0xf4, 0x3d, 0x03, 0x3b, 0xd7, 0xb8,
-	0x59, 0xf3, 0x69, 0x9a, 0xea, 0x0c, 0x16, 0x23, 0xa5, 0xf1, 0x68, 0x2a, 0xb8, 0xae, 0xda, 0x79,
-	0x89, 0xf0, 0xb8, 0xd6, 0xfe, 0x8b, 0xe8, 0xfe, 0x8c, 0xe0, 0xe0, 0x46, 0x8e, 0xe2, 0x00, 0x5b,
-	0x30, 0x11, 0xb5, 0x9a, 0x4b, 0x06, 0x0f, 0x72, 0xd2, 0xc8, 0x96, 0x05, 0x7a, 0xfe, 0x9f, 0x1d,
-	0x90, 0x13, 0x2c, 0xf0, 0x57, 0x08, 0xf2, 0xf2, 0x5e, 0x80, 0xe7, 0xb2, 0x8c, 0x68, 0xa9, 0x8b,
-	0x89, 0x36, 0xdf, 0x8f, 0x8a, 0xc4, 0xa1, 0x97, 0x3f, 0xfb, 0xf5, 0xaf, 0x2f, 0x86, 0x8f, 0xe0,
-	0x43, 0x3d, 0x2e, 0x67, 0xf2, 0x96, 0x82, 0xbf, 0x47, 0x30, 0x91, 0x98, 0xf4, 0xf0, 0xc9, 0xc1,
-	0x06, 0x49, 0xed, 0x54, 0xdf, 0x7a, 0x0a, 0xaf, 0x21, 0xf0, 0x96, 0xf0, 0xe1, 0x1e, 0x78, 0xa3,
-	0x6e, 0xf8, 0x01, 0xc1, 0x78, 0xdc, 0x7a, 0xf8, 0x44, 0x16, 0xb7, 0x6b, 0xa6, 0x43, 0xed, 0x64,
-	0xbf, 0x6a, 0x0a, 0xec, 0x71, 0x01, 0xb6, 0x8c, 0x5f, 0xce, 0x06, 0xd6, 0xbc, 0xe3, 0xd6, 0xee,
-	0xe2, 0x5f, 0x10, 0xec, 0x8c, 0x11, 0x47, 0x23, 0x1a, 0x3e, 0xd3, 0x0f, 0x84, 0xd4, 0x34, 0xa9,
-	0x9d, 0x1d, 0x44, 0x55, 0x31, 0x78, 0x4d, 0x30, 0x38, 0x8d, 0x4f, 0x66, 0x63, 0x50, 0xae, 0xb6,
-	0xcb, 0x55, 0x46, 0x6b, 0x65, 0xb7, 0x26, 0xc9, 0xfc, 0x86, 0x60, 0xef, 0x06, 0xc3, 0x19, 0xee,
-	0x75, 0x11, 0xe9, 0x3d, 0xfe, 0x69, 0x95, 0xcd, 0x98, 0xe8, 0xb3, 0xaa, 0xd4, 0x58, 0x84, 0x1f,
-	0x20, 0xd8, 0xb1, 0x6a, 0x54, 0xc1, 0x67, 0xb3, 0x96, 0xf4, 0xda, 0x39, 0x4a, 0x3b, 0x37, 0x90,
-	0xae, 0x02, 0x7f, 0x54, 0x80, 0x3f, 0x8c, 0x0f, 0x66, 0xf9, 0x7f, 0x05, 0x7f, 0x83, 0x20, 0x27,
-	0x86, 0x11, 0x7c, 0x2c, 0x8b, 0xd3, 0xe4, 0x84, 0xa3, 0xcd, 0xf5, 0xa1, 0xd1, 0x67, 0x0b, 0x7c,
-	0x1a, 0x6a, 0x99, 0x77, 0xc2, 0x57, 0x77, 0xf1, 0xd7, 0x08, 0xc6, 0x3b, 0x13, 0x49, 0x39, 0x53,
-	0x70, 0x22, 0x71, 0xed, 0x44, 0x5f, 0xe2, 0x7d, 0x1f, 0x84, 0x0d, 0xa1, 0x89, 0xbf, 0x45, 0x00,
-	0x89, 0xb9, 0xc5, 0xc8, 0xd6, 0x63, 0x91, 0x7c, 0xd6, 0x13, 0x65, 0xf5, 0xc8, 0xd3, 0xc7, 0xf1,
-	0x27, 0x54, 0xf1, 0x43, 0x04, 0x53, 0x5d, 0xe7, 0x9b, 0xd3, 0x59, 0x00, 0x74, 0xd3, 0xd4, 0x5e,
-	0x1f, 0x54, 0x33, 0x26, 0xf1, 0x8a, 0x20, 0x61, 0xe0, 0xa3, 0x99, 0x0e, 0x95, 0x32, 0x11, 0x26,
-	0xc2, 0xa3, 0x64, 0xf7, 0x7a, 0xf3, 0xc4, 0xb9, 0x8c, 0x98, 0xba, 0x29, 0x6b, 0x0b, 0x9b, 0x50,
-	0x8e, 0x39, 0x9d, 0x12, 0x9c, 0xe6, 0xb0, 0xd9, 0x83, 0x53, 0x3c, 0xf0, 0x2b, 0x5a, 0x95, 0x4b,
-	0x0f, 0x9f, 0x14, 0xd1, 0xa3, 0x27, 0x45, 0xf4, 0xe7, 0x93, 0x22, 0xfa, 0xfc, 0x69, 0x71, 0xe8,
-	0xd1, 0xd3, 0xe2, 0xd0, 0xef, 0x4f, 0x8b, 0x43, 0x1f, 0x1d, 0x4b, 0x5c, 0xd7, 0xf8, 0xb2, 0xed,
-	0xf9, 0xae, 0x6f, 0x12, 0xbe, 0x4c, 0xbc, 0xa6, 0x4b, 0xb9, 0x79, 0x2b, 0x65, 0x5e, 0x5c, 0xde,
-	0xaa, 0x79, 0xf1, 0xb7, 0xe5, 0xf1, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x17, 0xf0, 0x11,
-	0xb3, 0x15, 0x00, 0x00,
+	// 1687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6c, 0x1b, 0xc5,
+	0x17, 0xcf, 0xe4, 0xb3, 0x79, 0xfe, 0x37, 0xf9, 0x67, 0xfe, 0xf9, 0x27, 0xee, 0xa6, 0x75, 0xc2,
+	0xf6, 0xcb, 0xa1, 0xb1, 0x37, 0x49, 0x69, 0xfa, 0xa5, 0x56, 0xd4, 0x69, 0x5a, 0x5a, 0x0a, 0xa2,
+	0x5b, 0x44, 0x29, 0x87, 0x86, 0xf5, 0x7a, 0x62, 0x2f, 0xb1, 0x77, 0xdc, 0xdd, 0x71, 0xa8, 0x5b,
+	0xf5, 0xc2, 0xa1, 0x5c, 0x91, 0x38, 0x71, 0x00, 0x81, 0xc4, 0xa9, 0x12, 0x1f, 0x87, 0x1e, 0x2a,
+	0x6e, 0x08, 0x09, 0x55, 0x9c, 0x2a, 0xc1, 0x81, 0x53, 0x41, 0x2d, 0x12, 0xf7, 0x5e, 0xb9, 0xa0,
+	0x9d, 0x9d, 0x5d, 0xef, 0xc6, 0x4e, 0xbc, 0xeb, 0x1a, 0x89, 0x53, 0x76, 0x67, 0xde, 0xc7, 0xef,
+	0xf7, 0xde, 0xcc, 0xdb, 0xf7, 0x1c, 0x98, 0xdd, 0xa8, 0x95, 0x75, 0xcd, 0x34, 0x6e, 0x11, 0xc5,
+	0xd4, 0x2a, 0xc4, 0x26, 0xd6, 0x86, 0xa1, 0x13, 0x65, 0x63, 0x21, 0x4f, 0x98, 0xb6, 0xa0, 0xdc,
+	0xa8, 0x11, 0xab, 0x9e, 0xad, 0x5a, 0x94, 0x51, 0xbc, 0xc7, 0x17, 0xcd, 0x06, 0x44, 0xb3, 0x42,
+	0x54, 0x52, 0xb6, 0xb7, 0x14, 0x54, 0xe1, 0xf6, 0xa4, 0xdd, 0x45, 0x4a, 0x8b, 0x65, 0xa2, 0x68,
+	0x55, 0x43, 0xd1, 0x4c, 0x93, 0x32, 0x8d, 0x19, 0xd4, 0xb4, 0xc5, 0xee, 0x8b, 0x3a, 0xb5, 0x2b,
+	0xd4, 0x56, 0xf2, 0x9a, 0x4d, 0x5c, 0x18, 0xbe, 0xa9, 0xaa, 0x56, 0x34, 0x4c, 0x2e, 0x2c, 0x64,
+	0xc7, 0x8b, 0xb4, 0x48, 0xf9, 0xa3, 0xe2, 0x3c, 0x89, 0xd5, 0x54, 0xd0, 0x82, 0xa7, 0xab, 0x53,
+	0x43, 0x68, 0xc9, 0xe3, 0x80, 0x2f, 0x3b, 0x76, 0xdf, 0xd0, 0x2c, 0xad, 0x62, 0xab, 0xe4, 0x46,
+	0x8d, 0xd8, 0x4c, 0x7e, 0x13, 0xfe, 0x17, 0x5a, 0xb5, 0xab, 0xd4, 0xb4, 0x09, 0x3e, 0x05, 0x83,
+	0x55, 0xbe, 0x92, 0x44, 0x33, 0x28, 0x9d, 0x58, 0xdc, 0x9f, 0xdd, 0x36, 0x1a, 0x59, 0xa1, 0x2e,
+	0x94, 0xe4, 0xfb, 0x03, 0x30, 0xc9, 0xcd, 0x5e, 0x32, 0x6c, 0xa6, 0x12, 0x9d, 0x5a, 0x05, 0xcf,
+	0x23, 0x2e, 0x00, 0x68, 0x8c, 0x59, 0x46, 0xbe, 0xc6, 0x88, 0x63, 0xbe, 0x2f, 0x9d, 0x58, 0x3c,
+	0xdb, 0xc6, 0xfc, 0x16, 0xb6, 0xb2, 0xaf, 0x92, 0xfa, 0x5b, 0x5a, 0xb9, 0x46, 0x2e, 0x98, 0xd5,
+	0x1a, 0x53, 0x03, 0x76, 0xf1, 0x7f, 0xa1, 0x4f, 0x2b, 0x97, 0x93, 0xbd, 0x33, 0x28, 0xbd, 0x43,
+	0x75, 0x1e, 0xf1, 0x39, 0x80, 0x46, 0x24, 0x93, 0x7d, 0x9c, 0xd6, 0x81, 0xac, 0x1b, 0xb4, 0xac,
+	0x13, 0xb4, 0xac, 0x9b, 0xfd, 0x06, 0xa5, 0x22, 0x11, 0x7e, 0xd4, 0x80, 0xa6, 0x34, 0x03, 0x23,
+	0x2a, 0x59, 0x23, 0x16, 0x31, 0x75, 0xd7, 0x2f, 0x1e, 0x81, 0x5e, 0xa3, 0xc0, 0x03, 0x35, 0xac,
+	0xf6, 0x1a, 0x05, 0xe9, 0xbb, 0x5e, 0x80, 0x06, 0x2c, 0x8c, 0xa1, 0x9f, 0xd5, 0xab, 0x44, 0x08,
+	0xf0, 0x67, 0x3c, 0x01, 0x83, 0x36, 0xb3, 0x0c, 0xb3, 0xc8, 0x11, 0x0e, 0xab, 0xe2, 0xcd, 0x81,
+	0x6d, 0x98, 0x8c, 0xa3, 0xeb, 0x53, 0x9d, 0x47, 0x3c, 0x0e, 0x03, 0x6b, 0x65, 0xaa, 0xb1, 0x64,
+	0xff, 0x0c, 0x4a, 0x23, 0xd5, 0x7d, 0xc1, 0x49, 0x18, 0xca, 0x53, 0x5a, 0x26, 0x9a, 0x99, 0x1c,
+	0xe0, 0x14, 0xbd, 0x57, 0xac, 0xc3, 0xb0, 0xe5, 0xc1, 0x4b, 0x0e, 0x72, 0x96, 0x2b, 0x1d, 0x46,
+	0x37, 0x4c, 0x53, 0x6d, 0xd8, 0xc5, 0xd7, 0x60, 0x70, 0xc3, 0x21, 0x68, 0x27, 0x87, 0x78, 0xfe,
+	0xce, 0x74, 0xe8, 0x21, 0x90, 0x3c, 0x61, 0x50, 0xba, 0x05, 0x3b, 0x43, 0x59, 0x75, 0x42, 0xb2,
+	0x4e, 0xea, 0x22, 0x7a, 0xce, 0x23, 0xbe, 0x0a, 0x03, 0x5c, 0x98, 0xc7, 0xae, 0x2b, 0xce, 0x5d,
+	0x7b, 0xf2, 0x3d, 0x04, 0xc9, 0x66, 0x69, 0x71, 0x25, 0x56, 0x60, 0xc8, 0x72, 0x97, 0xc4, 0xa1,
+	0x6d, 0x77, 0x27, 0x5c, 0x03, 0xb9, 0xfe, 0x87, 0x8f, 0xa7, 0x7b, 0x54, 0x4f, 0x17, 0x9f, 0x0f,
+	0x1d, 0x43, 0x97, 0xc1, 0xc1, 0xb6, 0xc7, 0xd0, 0xc5, 0x10, 0x3c, 0x87, 0x72, 0x1a, 0x26, 0x38,
+	0x56, 0xe1, 0xa6, 0x7e, 0xa1, 0xe0, 0xdd, 0xb0, 0x4d, 0xe7, 0x51, 0xbe, 0x0e, 0x93, 0x4d, 0x92,
+	0x82, 0xd4, 0x32, 0x0c, 0xba, 0xc0, 0x22, 0xde, 0xf3, 0x10, 0x27, 0xa1, 0x2a, 0x33, 0x90, 0x42,
+	0xf6, 0x73, 0xd4, 0x2c, 0x6c, 0x89, 0x06, 0x9f, 0x6b, 0x11, 0x80, 0x0e, 0xee, 0xa1, 0xfc, 0x15,
+	0x82, 0xa9, 0x96, 0x6e, 0xff, 0xa5, 0xf9, 0xda, 0x07, 0xf2, 0x79, 0xc2, 0x5e, 0xd7, 0x2a, 0xe4,
+	0x8a, 0xeb, 0xf8, 0x35, 0x5a, 0xa8, 0x95, 0x49, 0x4e, 0x2b, 0x6b, 0xa6, 0xee, 0x31, 0x94, 0xab,
+	0xb0, 0x77, 0x5b, 0x29, 0x41, 0xee, 0x02, 0xec, 0xc8, 0xbb, 0x4b, 0x1e, 0xbb, 0x4c, 0x1b, 0x76,
+	0x67, 0x74, 0x9d, 0xd6, 0x4c, 0xe6, 0x19, 0xf2, 0xd5, 0xe5, 0x3f, 0x11, 0x8c, 0x84, 0x37, 0xf1,
+	0x25, 0xf8, 0x8f, 0xe6, 0xae, 0xac, 0x3a, 0xa6, 0xdc, 0xe4, 0xe5, 0x66, 0x9f, 0x3d, 0x9e, 0xde,
+	0xff, 0x9e, 0x4d, 0xcd, 0x13, 0xb2, 0xd8, 0x75, 0x60, 0xca, 0x33, 0x75, 0xad, 0x52, 0x0e, 0x2f,
+	0xa9, 0x89, 0xc0, 0x1b, 0xbe, 0x8b, 0x60, 0x48, 0x78, 0x4b, 0xf6, 0x71, 0xac, 0xbb, 0x42, 0xf1,
+	0xf3, 0x10, 0x2e, 0x53, 0xc3, 0xcc, 0x5d, 0x76, 0xa2, 0xff, 0xec, 0xf1, 0xf4, 0x1e, 0xd7, 0x91,
+	0xd0, 0xf3, 0x9c, 0x78, 0xaf, 0xf7, 0x7e, 0x9b, 0x4e, 0x17, 0x0d, 0x56, 0xaa, 0xe5, 0xb3, 0x3a,
+	0xad, 0x28, 0xe2, 0xcb, 0xe7, 0xfe, 0xc9, 0xd8, 0x85, 0x75, 0xc5, 0x29, 0xb2, 0x36, 0xb7, 0x68,
+	0xab, 0x9e, 0x73, 0x99, 0xc0, 0x94, 0x7f, 0xbb, 0x1d, 0x64, 0x9b, 0x3e, 0x4c, 0xe1, 0x83, 0x89,
+	0x9e, 0xe7, 0x60, 0xee, 0x6e, 0xed, 0x47, 0x24, 0xef, 0x2c, 0x0c, 0xf0, 0x0c, 0x89, 0xcc, 0xa5,
+	0xdb, 0x64, 0xce, 0x31, 0xb1, 0x62, 0x32, 0xab, 0x2e, 0x8e, 0xa6, 0xab, 0xdc, 0xbd, 0x83, 0x79,
+	0x10, 0xc6, 0x38, 0xdc, 0xab, 0x25, 0x6a, 0xf8, 0xc1, 0xc0, 0xd0, 0xdf, 0x48, 0xbd, 0xca, 0x9f,
+	0xe5, 0x4f, 0x11, 0xe0, 0xa0, 0xa4, 0xa0, 0x73, 0x17, 0xc1, 0x88, 0xb3, 0xbf, 0xaa, 0xd5, 0x58,
+	0x89, 0x5a, 0x06, 0xab, 0x8b, 0xe0, 0xcd, 0x45, 0x20, 0x76, 0xc6, 0xd3, 0xc9, 0x2d, 0x88, 0xcc,
+	0xcf, 0xba, 0x99, 0x37, 0x83, 0x9b, 0x5e, 0xfe, 0xc3, 0x8b, 0xea, 0xce, 0xf0, 0xbb, 0x0c, 0x23,
+	0x6e, 0xdc, 0x29, 0x5d, 0xaf, 0x55, 0x97, 0x2d, 0xd3, 0xf9, 0x76, 0xe8, 0x96, 0xe9, 0x7d, 0x3b,
+	0x74, 0xcb, 0x94, 0xaf, 0xc2, 0x44, 0x58, 0x26, 0xd0, 0xf2, 0x34, 0x18, 0x27, 0x16, 0x67, 0x23,
+	0x60, 0x77, 0xf3, 0x2a, 0x82, 0xb3, 0x17, 0x46, 0x45, 0x35, 0xb2, 0x69, 0x79, 0x83, 0xb4, 0xf6,
+	0xfe, 0x36, 0x4c, 0x6e, 0x12, 0x0a, 0x76, 0x5c, 0x1d, 0x54, 0x62, 0xbf, 0x06, 0x2b, 0xe2, 0x6c,
+	0x9f, 0x27, 0xde, 0x87, 0xcb, 0xa9, 0xf3, 0x7e, 0x3a, 0x9d, 0xbe, 0x42, 0x14, 0xc2, 0x61, 0xd5,
+	0x79, 0x94, 0x09, 0xec, 0x6e, 0xad, 0xd0, 0xd5, 0xf2, 0x29, 0xcf, 0x6d, 0x8e, 0x77, 0xf0, 0x84,
+	0xe9, 0x96, 0xe9, 0x61, 0xe2, 0xcf, 0xf2, 0xbb, 0x30, 0xd9, 0x24, 0xed, 0xe3, 0x09, 0x5d, 0x9a,
+	0xe8, 0xf9, 0x09, 0xdd, 0x1a, 0x39, 0xd3, 0x94, 0x81, 0x6d, 0x01, 0x69, 0x90, 0x6c, 0x16, 0xef,
+	0x6e, 0x84, 0x74, 0xd8, 0x15, 0x4e, 0xc4, 0xca, 0xcd, 0xaa, 0x61, 0xd5, 0x2f, 0xd7, 0x48, 0x8d,
+	0x74, 0xad, 0x26, 0x3d, 0x40, 0xf0, 0xc2, 0x96, 0x5e, 0x7c, 0x46, 0x17, 0x37, 0x33, 0x9a, 0x6f,
+	0xc3, 0x28, 0x64, 0x84, 0x9f, 0xc7, 0xee, 0x7f, 0x37, 0x8f, 0xc3, 0x58, 0x93, 0x9b, 0xa6, 0xa6,
+	0x62, 0xbc, 0xd1, 0x12, 0x3a, 0xc9, 0x73, 0x5f, 0xe4, 0xb5, 0xc6, 0x19, 0xf7, 0xab, 0xc4, 0x3f,
+	0x11, 0xdd, 0x1f, 0x10, 0xec, 0xdb, 0xce, 0x91, 0x1f, 0x60, 0x15, 0x12, 0x5e, 0x91, 0x34, 0x48,
+	0xe7, 0x41, 0x0e, 0x1a, 0xe9, 0x5e, 0xa0, 0xaf, 0x43, 0xca, 0x23, 0x91, 0x2b, 0x53, 0x7d, 0x7d,
+	0xb9, 0xa4, 0x99, 0x45, 0x72, 0x85, 0x30, 0xff, 0x86, 0x4c, 0x43, 0x62, 0xcd, 0xa2, 0x95, 0xd5,
+	0x12, 0x31, 0x8a, 0x25, 0xc6, 0x03, 0xd6, 0xa7, 0x82, 0xb3, 0xf4, 0x0a, 0x5f, 0xc1, 0x53, 0x30,
+	0xcc, 0xa8, 0xb7, 0xdd, 0xcb, 0xb7, 0x77, 0x30, 0xea, 0x6e, 0xca, 0x9f, 0x20, 0x98, 0xde, 0xd2,
+	0x81, 0x08, 0xd0, 0x35, 0x18, 0xcb, 0x3b, 0x5b, 0xab, 0x3a, 0xdf, 0x5b, 0xb5, 0x09, 0x8b, 0xda,
+	0xe0, 0x84, 0x4d, 0xaa, 0xa3, 0xf9, 0xb0, 0x8b, 0x6d, 0xb1, 0x2d, 0xfe, 0x35, 0x01, 0x03, 0x1c,
+	0x1b, 0xfe, 0x0c, 0xc1, 0xa0, 0x3b, 0xcd, 0xe2, 0x85, 0x28, 0x83, 0x45, 0x68, 0x9c, 0x96, 0x16,
+	0xe3, 0xa8, 0xb8, 0x9c, 0xe5, 0xcc, 0x07, 0x3f, 0xff, 0xf1, 0x71, 0xef, 0x41, 0xbc, 0xbf, 0xcd,
+	0x4f, 0x0a, 0xee, 0x6c, 0x8d, 0xbf, 0x46, 0x90, 0x08, 0xcc, 0x27, 0x78, 0xa9, 0xb3, 0xf1, 0x47,
+	0x3a, 0x1a, 0x5b, 0x4f, 0xe0, 0xcd, 0x72, 0xbc, 0x69, 0x7c, 0xa0, 0x0d, 0x5e, 0xaf, 0x12, 0x7c,
+	0x83, 0x60, 0xd8, 0x2f, 0x3b, 0xf8, 0x48, 0x14, 0xb7, 0x4d, 0x33, 0x8d, 0xb4, 0x14, 0x57, 0x4d,
+	0x80, 0x3d, 0xcc, 0xc1, 0x66, 0xf0, 0xa1, 0x68, 0x60, 0x95, 0xdb, 0x46, 0xe1, 0x0e, 0xfe, 0x09,
+	0xc1, 0x98, 0x8f, 0xd8, 0x1b, 0x2c, 0xf0, 0xf1, 0x38, 0x10, 0x42, 0x33, 0x90, 0x74, 0xa2, 0x13,
+	0x55, 0xc1, 0xe0, 0x34, 0x67, 0x70, 0x0c, 0x2f, 0x45, 0x63, 0x90, 0xc9, 0xd7, 0x33, 0x79, 0x6a,
+	0x16, 0x32, 0x46, 0xc1, 0x25, 0xf3, 0x0b, 0x82, 0xa9, 0x6d, 0x46, 0x0a, 0xdc, 0x6e, 0x7c, 0x6e,
+	0x3f, 0xb4, 0x48, 0xb9, 0xe7, 0x31, 0x11, 0xf3, 0x54, 0x89, 0x66, 0x1e, 0x3f, 0x40, 0x30, 0xba,
+	0xa9, 0xc1, 0xc6, 0x27, 0xa2, 0x1e, 0xe9, 0xe6, 0xee, 0x5f, 0x3a, 0xd9, 0x91, 0xae, 0x00, 0x3f,
+	0xc7, 0xc1, 0x1f, 0xc0, 0xfb, 0xa2, 0xfc, 0x2a, 0x88, 0xbf, 0x40, 0x30, 0xc0, 0x5b, 0x68, 0x3c,
+	0x1f, 0xc5, 0x69, 0xb0, 0x2f, 0x97, 0x16, 0x62, 0x68, 0xc4, 0xbc, 0x02, 0xef, 0x3b, 0x5a, 0xca,
+	0x6d, 0x67, 0xeb, 0x0e, 0xfe, 0x1c, 0xc1, 0x70, 0xa3, 0x8f, 0xce, 0x44, 0x0a, 0x8e, 0x27, 0x2e,
+	0x1d, 0x89, 0x25, 0x1e, 0xbb, 0x10, 0x96, 0xb9, 0x26, 0xfe, 0x12, 0x01, 0x04, 0xba, 0xed, 0x6c,
+	0xb4, 0x3b, 0xe6, 0xc9, 0x4b, 0x4b, 0xf1, 0xe4, 0x3b, 0x28, 0x7f, 0x5c, 0x15, 0x3f, 0x44, 0x30,
+	0xde, 0xb2, 0xb7, 0x3b, 0x16, 0x05, 0x40, 0x2b, 0x4d, 0xe9, 0xe5, 0x4e, 0x35, 0x7d, 0x12, 0x2f,
+	0x71, 0x12, 0x59, 0x3c, 0x17, 0xa9, 0xa8, 0x64, 0x08, 0x37, 0xe1, 0x94, 0x92, 0xc9, 0xad, 0x7a,
+	0xa9, 0x93, 0x11, 0x31, 0xb5, 0x52, 0x96, 0x96, 0x9f, 0x43, 0xd9, 0xe7, 0x74, 0x94, 0x73, 0x5a,
+	0xc0, 0x4a, 0x1b, 0x4e, 0xfe, 0x98, 0xea, 0xd1, 0xfa, 0x1e, 0xc1, 0xe8, 0xa6, 0x31, 0x28, 0x5a,
+	0x29, 0x69, 0x3d, 0x6c, 0x49, 0x27, 0x3b, 0xd2, 0x15, 0x2c, 0x8e, 0x70, 0x16, 0x0a, 0xce, 0x44,
+	0x2f, 0xf7, 0x46, 0xc1, 0xc6, 0xdf, 0x22, 0x80, 0xc6, 0xd4, 0x84, 0xe3, 0xdd, 0x40, 0x3b, 0xd6,
+	0x57, 0xb6, 0x79, 0x38, 0x8b, 0x5c, 0x62, 0xdc, 0x9b, 0x9b, 0xc9, 0x6b, 0x4c, 0x2f, 0xe1, 0xfb,
+	0x08, 0x12, 0x81, 0xb9, 0x0a, 0xc7, 0xbc, 0x90, 0xf1, 0x1a, 0x99, 0x16, 0x03, 0x5c, 0x8c, 0x4b,
+	0xc0, 0x75, 0x05, 0xec, 0x1f, 0x11, 0xe0, 0xe6, 0x0e, 0x16, 0x9f, 0x8a, 0x98, 0xf4, 0xd6, 0xad,
+	0xb5, 0x74, 0xba, 0x53, 0x75, 0xc1, 0x65, 0x81, 0x73, 0x39, 0x84, 0x67, 0xdb, 0x70, 0x71, 0xfb,
+	0x6a, 0xdb, 0x41, 0xfc, 0x21, 0x82, 0xff, 0x5f, 0x61, 0x16, 0xd1, 0x2a, 0x5d, 0xe6, 0x12, 0xaf,
+	0x53, 0x9f, 0x47, 0xb9, 0x8b, 0x0f, 0x9f, 0xa4, 0xd0, 0xa3, 0x27, 0x29, 0xf4, 0xfb, 0x93, 0x14,
+	0xfa, 0xe8, 0x69, 0xaa, 0xe7, 0xd1, 0xd3, 0x54, 0xcf, 0xaf, 0x4f, 0x53, 0x3d, 0xef, 0xcc, 0x07,
+	0x7e, 0xe5, 0x63, 0x25, 0xcd, 0xb2, 0x0d, 0x5b, 0x21, 0xac, 0x44, 0xac, 0x8a, 0x61, 0x32, 0xe5,
+	0x66, 0x88, 0x22, 0xff, 0xcd, 0x2f, 0x3f, 0xc8, 0xff, 0xdb, 0x75, 0xf8, 0xef, 0x01, 0x00, 0x42,
+	0x75, 0x7c, 0xac, 0xea, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1538,6 +1946,17 @@ type QueryClient interface {
 	GetRecordExpiryQueue(ctx context.Context, in *QueryGetRecordExpiryQueue, opts ...grpc.CallOption) (*QueryGetRecordExpiryQueueResponse, error)
 	// GetAuthorityExpiryQueue
 	GetAuthorityExpiryQueue(ctx context.Context, in *QueryGetAuthorityExpiryQueue, opts ...grpc.CallOption) (*QueryGetAuthorityExpiryQueueResponse, error)
+	// GetRecordsByIds gets a batch of records by id
+	GetRecordsByIds(ctx context.Context, in *QueryGetRecordsByIdsRequest, opts ...grpc.CallOption) (*QueryGetRecordsByIdsResponse, error)
+	// LookupCrns looks up a batch of name records
+	LookupCrns(ctx context.Context, in *QueryLookupCrnsRequest, opts ...grpc.CallOption) (*QueryLookupCrnsResponse, error)
+	// ResolveCrns resolves a batch of CRNs to records
+	ResolveCrns(ctx context.Context, in *QueryResolveCrnsRequest, opts ...grpc.CallOption) (*QueryResolveCrnsResponse, error)
+	// GetBlockChangeSets queries the records, names, authorities and auctions changed in a range of blocks,
+	// ranges longer than 1000 blocks are cut short
+	GetBlockChangeSets(ctx context.Context, in *QueryGetBlockChangeSetsRequest, opts ...grpc.CallOption) (*QueryGetBlockChangeSetsResponse, error)
+	// StreamBlockChangeSets streams the block changesets of a range of blocks, without a limit on the range
+	StreamBlockChangeSets(ctx context.Context, in *QueryGetBlockChangeSetsRequest, opts ...grpc.CallOption) (Query_StreamBlockChangeSetsClient, error)
 }
 
 type queryClient struct {
@@ -1647,30 +2066,109 @@ func (c *queryClient) GetAuthorityExpiryQueue(ctx context.Context, in *QueryGetA
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the nameservice module params.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// List records
-	ListRecords(context.Context, *QueryListRecordsRequest) (*QueryListRecordsResponse, error)
-	// Get record by id
-	GetRecord(context.Context, *QueryRecordByIdRequest) (*QueryRecordByIdResponse, error)
-	// Get records by bond id
-	GetRecordByBondId(context.Context, *QueryRecordByBondIdRequest) (*QueryRecordByBondIdResponse, error)
-	// Get nameservice module balance
-	GetNameServiceModuleBalance(context.Context, *GetNameServiceModuleBalanceRequest) (*GetNameServiceModuleBalanceResponse, error)
-	// List name records
-	ListNameRecords(context.Context, *QueryListNameRecordsRequest) (*QueryListNameRecordsResponse, error)
-	// Whois method retrieve the name authority info
-	Whois(context.Context, *QueryWhoisRequest) (*QueryWhoisResponse, error)
-	// LookupCrn
-	LookupCrn(context.Context, *QueryLookupCrn) (*QueryLookupCrnResponse, error)
-	// ResolveCrn
-	ResolveCrn(context.Context, *QueryResolveCrn) (*QueryResolveCrnResponse, error)
-	// GetRecordExpiryQueue
-	GetRecordExpiryQueue(context.Context, *QueryGetRecordExpiryQueue) (*QueryGetRecordExpiryQueueResponse, error)
-	// GetAuthorityExpiryQueue
+func (c *queryClient) GetRecordsByIds(ctx context.Context, in *QueryGetRecordsByIdsRequest, opts ...grpc.CallOption) (*QueryGetRecordsByIdsResponse, error) {
+	out := new(QueryGetRecordsByIdsResponse)
+	err := c.cc.Invoke(ctx, "/vulcanize.nameservice.v1beta1.Query/GetRecordsByIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LookupCrns(ctx context.Context, in *QueryLookupCrnsRequest, opts ...grpc.CallOption) (*QueryLookupCrnsResponse, error) {
+	out := new(QueryLookupCrnsResponse)
+	err := c.cc.Invoke(ctx, "/vulcanize.nameservice.v1beta1.Query/LookupCrns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResolveCrns(ctx context.Context, in *QueryResolveCrnsRequest, opts ...grpc.CallOption) (*QueryResolveCrnsResponse, error) {
+	out := new(QueryResolveCrnsResponse)
+	err := c.cc.Invoke(ctx, "/vulcanize.nameservice.v1beta1.Query/ResolveCrns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetBlockChangeSets(ctx context.Context, in *QueryGetBlockChangeSetsRequest, opts ...grpc.CallOption) (*QueryGetBlockChangeSetsResponse, error) {
+	out := new(QueryGetBlockChangeSetsResponse)
+	err := c.cc.Invoke(ctx, "/vulcanize.nameservice.v1beta1.Query/GetBlockChangeSets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamBlockChangeSets(ctx context.Context, in *QueryGetBlockChangeSetsRequest, opts ...grpc.CallOption) (Query_StreamBlockChangeSetsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/vulcanize.nameservice.v1beta1.Query/StreamBlockChangeSets", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryStreamBlockChangeSetsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_StreamBlockChangeSetsClient interface {
+	Recv() (*BlockChangeSet, error)
+	grpc.ClientStream
+}
+
+type queryStreamBlockChangeSetsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryStreamBlockChangeSetsClient) Recv() (*BlockChangeSet, error) {
+	m := new(BlockChangeSet)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the nameservice module params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// List records
+	ListRecords(context.Context, *QueryListRecordsRequest) (*QueryListRecordsResponse, error)
+	// Get record by id
+	GetRecord(context.Context, *QueryRecordByIdRequest) (*QueryRecordByIdResponse, error)
+	// Get records by bond id
+	GetRecordByBondId(context.Context, *QueryRecordByBondIdRequest) (*QueryRecordByBondIdResponse, error)
+	// Get nameservice module balance
+	GetNameServiceModuleBalance(context.Context, *GetNameServiceModuleBalanceRequest) (*GetNameServiceModuleBalanceResponse, error)
+	// List name records
+	ListNameRecords(context.Context, *QueryListNameRecordsRequest) (*QueryListNameRecordsResponse, error)
+	// Whois method retrieve the name authority info
+	Whois(context.Context, *QueryWhoisRequest) (*QueryWhoisResponse, error)
+	// LookupCrn
+	LookupCrn(context.Context, *QueryLookupCrn) (*QueryLookupCrnResponse, error)
+	// ResolveCrn
+	ResolveCrn(context.Context, *QueryResolveCrn) (*QueryResolveCrnResponse, error)
+	// GetRecordExpiryQueue
+	GetRecordExpiryQueue(context.Context, *QueryGetRecordExpiryQueue) (*QueryGetRecordExpiryQueueResponse, error)
+	// GetAuthorityExpiryQueue
 	GetAuthorityExpiryQueue(context.Context, *QueryGetAuthorityExpiryQueue) (*QueryGetAuthorityExpiryQueueResponse, error)
+	// GetRecordsByIds gets a batch of records by id
+	GetRecordsByIds(context.Context, *QueryGetRecordsByIdsRequest) (*QueryGetRecordsByIdsResponse, error)
+	// LookupCrns looks up a batch of name records
+	LookupCrns(context.Context, *QueryLookupCrnsRequest) (*QueryLookupCrnsResponse, error)
+	// ResolveCrns resolves a batch of CRNs to records
+	ResolveCrns(context.Context, *QueryResolveCrnsRequest) (*QueryResolveCrnsResponse, error)
+	// GetBlockChangeSets queries the records, names, authorities and auctions changed in a range of blocks,
+	// ranges longer than 1000 blocks are cut short
+	GetBlockChangeSets(context.Context, *QueryGetBlockChangeSetsRequest) (*QueryGetBlockChangeSetsResponse, error)
+	// StreamBlockChangeSets streams the block changesets of a range of blocks, without a limit on the range
+	StreamBlockChangeSets(*QueryGetBlockChangeSetsRequest, Query_StreamBlockChangeSetsServer) error
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1710,6 +2208,21 @@ func (*UnimplementedQueryServer) GetRecordExpiryQueue(ctx context.Context, req *
 func (*UnimplementedQueryServer) GetAuthorityExpiryQueue(ctx context.Context, req *QueryGetAuthorityExpiryQueue) (*QueryGetAuthorityExpiryQueueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorityExpiryQueue not implemented")
 }
+func (*UnimplementedQueryServer) GetRecordsByIds(ctx context.Context, req *QueryGetRecordsByIdsRequest) (*QueryGetRecordsByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordsByIds not implemented")
+}
+func (*UnimplementedQueryServer) LookupCrns(ctx context.Context, req *QueryLookupCrnsRequest) (*QueryLookupCrnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupCrns not implemented")
+}
+func (*UnimplementedQueryServer) ResolveCrns(ctx context.Context, req *QueryResolveCrnsRequest) (*QueryResolveCrnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveCrns not implemented")
+}
+func (*UnimplementedQueryServer) GetBlockChangeSets(ctx context.Context, req *QueryGetBlockChangeSetsRequest) (*QueryGetBlockChangeSetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockChangeSets not implemented")
+}
+func (*UnimplementedQueryServer) StreamBlockChangeSets(req *QueryGetBlockChangeSetsRequest, srv Query_StreamBlockChangeSetsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlockChangeSets not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1913,6 +2426,99 @@ func _Query_GetAuthorityExpiryQueue_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetRecordsByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRecordsByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordsByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vulcanize.nameservice.v1beta1.Query/GetRecordsByIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordsByIds(ctx, req.(*QueryGetRecordsByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LookupCrns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLookupCrnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LookupCrns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vulcanize.nameservice.v1beta1.Query/LookupCrns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LookupCrns(ctx, req.(*QueryLookupCrnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResolveCrns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveCrnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveCrns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vulcanize.nameservice.v1beta1.Query/ResolveCrns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveCrns(ctx, req.(*QueryResolveCrnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetBlockChangeSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBlockChangeSetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBlockChangeSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vulcanize.nameservice.v1beta1.Query/GetBlockChangeSets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBlockChangeSets(ctx, req.(*QueryGetBlockChangeSetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamBlockChangeSets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryGetBlockChangeSetsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).StreamBlockChangeSets(m, &queryStreamBlockChangeSetsServer{stream})
+}
+
+type Query_StreamBlockChangeSetsServer interface {
+	Send(*BlockChangeSet) error
+	grpc.ServerStream
+}
+
+type queryStreamBlockChangeSetsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryStreamBlockChangeSetsServer) Send(m *BlockChangeSet) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "vulcanize.nameservice.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1961,8 +2567,30 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAuthorityExpiryQueue",
 			Handler:    _Query_GetAuthorityExpiryQueue_Handler,
 		},
+		{
+			MethodName: "GetRecordsByIds",
+			Handler:    _Query_GetRecordsByIds_Handler,
+		},
+		{
+			MethodName: "LookupCrns",
+			Handler:    _Query_LookupCrns_Handler,
+		},
+		{
+			MethodName: "ResolveCrns",
+			Handler:    _Query_ResolveCrns_Handler,
+		},
+		{
+			MethodName: "GetBlockChangeSets",
+			Handler:    _Query_GetBlockChangeSets_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBlockChangeSets",
+			Handler:       _Query_StreamBlockChangeSets_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "vulcanize/nameservice/v1beta1/query.proto",
 }
 
@@ -2823,7 +3451,7 @@ func (m *QueryResolveCrnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetRecordExpiryQueue) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetRecordsByIdsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2833,32 +3461,29 @@ func (m *QueryGetRecordExpiryQueue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetRecordExpiryQueue) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetRecordsByIdsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetRecordExpiryQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetRecordsByIdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetRecordExpiryQueueResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetRecordsByIdsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2868,28 +3493,16 @@ func (m *QueryGetRecordExpiryQueueResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetRecordExpiryQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetRecordsByIdsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetRecordExpiryQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetRecordsByIdsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.Records) > 0 {
 		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2907,7 +3520,7 @@ func (m *QueryGetRecordExpiryQueueResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *ExpiryQueueRecord) Marshal() (dAtA []byte, err error) {
+func (m *QueryLookupCrnsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2917,36 +3530,29 @@ func (m *ExpiryQueueRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExpiryQueueRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLookupCrnsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExpiryQueueRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLookupCrnsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		for iNdEx := len(m.Value) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Value[iNdEx])
-			copy(dAtA[i:], m.Value[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Value[iNdEx])))
+	if len(m.Crns) > 0 {
+		for iNdEx := len(m.Crns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Crns[iNdEx])
+			copy(dAtA[i:], m.Crns[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Crns[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAuthorityExpiryQueue) Marshal() (dAtA []byte, err error) {
+func (m *QueryLookupCrnsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2956,32 +3562,34 @@ func (m *QueryGetAuthorityExpiryQueue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAuthorityExpiryQueue) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLookupCrnsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAuthorityExpiryQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLookupCrnsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Names[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAuthorityExpiryQueueResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryResolveCrnsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2991,32 +3599,52 @@ func (m *QueryGetAuthorityExpiryQueueResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAuthorityExpiryQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryResolveCrnsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAuthorityExpiryQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryResolveCrnsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.Crns) > 0 {
+		for iNdEx := len(m.Crns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Crns[iNdEx])
+			copy(dAtA[i:], m.Crns[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Crns[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.Authorities) > 0 {
-		for iNdEx := len(m.Authorities) - 1; iNdEx >= 0; iNdEx-- {
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveCrnsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveCrnsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveCrnsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Authorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3030,274 +3658,336 @@ func (m *QueryGetAuthorityExpiryQueueResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetRecordExpiryQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetRecordExpiryQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetRecordExpiryQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.All {
-		n += 2
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListRecordsRequest_ReferenceInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetRecordExpiryQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListRecordsRequest_ValueInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetRecordExpiryQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecordExpiryQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.String_)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Int != 0 {
-		n += 1 + sovQuery(uint64(m.Int))
-	}
-	if m.Float != 0 {
-		n += 9
-	}
-	if m.Boolean {
-		n += 2
-	}
-	if m.Reference != nil {
-		l = m.Reference.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Values) > 0 {
-		for _, e := range m.Values {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListRecordsRequest_KeyValueInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ExpiryQueueRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ExpiryQueueRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpiryQueueRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Value) > 0 {
+		for iNdEx := len(m.Value) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Value[iNdEx])
+			copy(dAtA[i:], m.Value[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Value[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRecordByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetAuthorityExpiryQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryRecordByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Record.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetAuthorityExpiryQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRecordByBondIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetAuthorityExpiryQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRecordByBondIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QueryGetAuthorityExpiryQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAuthorityExpiryQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAuthorityExpiryQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Authorities) > 0 {
+		for iNdEx := len(m.Authorities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetNameServiceModuleBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetBlockChangeSetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlockChangeSetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlockChangeSetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetNameServiceModuleBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetBlockChangeSetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlockChangeSetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlockChangeSetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BlockChangeSets) > 0 {
+		for iNdEx := len(m.BlockChangeSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockChangeSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AccountBalance) Size() (n int) {
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.AccountName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *QueryListNameRecordsRequest) Size() (n int) {
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
+	if m.Params != nil {
+		l = m.Params.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryListNameRecordsResponse) Size() (n int) {
+func (m *QueryListRecordsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Names) > 0 {
-		for _, e := range m.Names {
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.All {
+		n += 2
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -3305,88 +3995,125 @@ func (m *QueryListNameRecordsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryWhoisRequest) Size() (n int) {
+func (m *QueryListRecordsRequest_ReferenceInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryWhoisResponse) Size() (n int) {
+func (m *QueryListRecordsRequest_ValueInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.NameAuthority.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.String_)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Int != 0 {
+		n += 1 + sovQuery(uint64(m.Int))
+	}
+	if m.Float != 0 {
+		n += 9
+	}
+	if m.Boolean {
+		n += 2
+	}
+	if m.Reference != nil {
+		l = m.Reference.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *QueryLookupCrn) Size() (n int) {
+func (m *QueryListRecordsRequest_KeyValueInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Crn)
+	l = len(m.Key)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryLookupCrnResponse) Size() (n int) {
+func (m *QueryListRecordsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = m.Name.Size()
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryResolveCrn) Size() (n int) {
+func (m *QueryRecordByIdRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Crn)
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryResolveCrnResponse) Size() (n int) {
+func (m *QueryRecordByIdResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Record != nil {
-		l = m.Record.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryGetRecordExpiryQueue) Size() (n int) {
+func (m *QueryRecordByBondIdRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -3394,7 +4121,7 @@ func (m *QueryGetRecordExpiryQueue) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetRecordExpiryQueueResponse) Size() (n int) {
+func (m *QueryRecordByBondIdResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3413,39 +4140,312 @@ func (m *QueryGetRecordExpiryQueueResponse) Size() (n int) {
 	return n
 }
 
-func (m *ExpiryQueueRecord) Size() (n int) {
+func (m *GetNameServiceModuleBalanceRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetNameServiceModuleBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.Value) > 0 {
-		for _, s := range m.Value {
-			l = len(s)
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *QueryGetAuthorityExpiryQueue) Size() (n int) {
+func (m *AccountBalance) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
+	l = len(m.AccountName)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *QueryGetAuthorityExpiryQueueResponse) Size() (n int) {
+func (m *QueryListNameRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListNameRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, e := range m.Names {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWhoisRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWhoisResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NameAuthority.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLookupCrn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Crn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLookupCrnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = m.Name.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveCrn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Crn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveCrnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRecordsByIdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetRecordsByIdsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLookupCrnsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Crns) > 0 {
+		for _, s := range m.Crns {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLookupCrnsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, e := range m.Names {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryResolveCrnsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Crns) > 0 {
+		for _, s := range m.Crns {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryResolveCrnsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetRecordExpiryQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRecordExpiryQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ExpiryQueueRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Value) > 0 {
+		for _, s := range m.Value {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetAuthorityExpiryQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAuthorityExpiryQueueResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3457,20 +4457,411 @@ func (m *QueryGetAuthorityExpiryQueueResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlockChangeSetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *QueryGetBlockChangeSetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockChangeSets) > 0 {
+		for _, e := range m.BlockChangeSets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, &QueryListRecordsRequest_KeyValueInput{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryListRecordsRequest_ReferenceInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferenceInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferenceInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListRecordsRequest_ValueInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3480,25 +4871,209 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.String_ = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int", wireType)
+			}
+			m.Int = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Int |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Float", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Float = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Boolean", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Boolean = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reference == nil {
+				m.Reference = &QueryListRecordsRequest_ReferenceInput{}
+			}
+			if err := m.Reference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &QueryListRecordsRequest_ValueInput{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3520,7 +5095,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListRecordsRequest_KeyValueInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3543,15 +5118,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyValueInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyValueInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3578,10 +5185,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			if m.Value == nil {
+				m.Value = &QueryListRecordsRequest_ValueInput{}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3606,7 +5213,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3629,15 +5236,15 @@ func (m *QueryListRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3664,32 +5271,12 @@ func (m *QueryListRecordsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, &QueryListRecordsRequest_KeyValueInput{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.All = bool(v != 0)
-		case 3:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3719,7 +5306,7 @@ func (m *QueryListRecordsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3746,7 +5333,7 @@ func (m *QueryListRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListRecordsRequest_ReferenceInput) Unmarshal(dAtA []byte) error {
+func (m *QueryRecordByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3769,10 +5356,10 @@ func (m *QueryListRecordsRequest_ReferenceInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReferenceInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecordByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReferenceInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecordByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3828,7 +5415,7 @@ func (m *QueryListRecordsRequest_ReferenceInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListRecordsRequest_ValueInput) Unmarshal(dAtA []byte) error {
+func (m *QueryRecordByIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3851,165 +5438,15 @@ func (m *QueryListRecordsRequest_ValueInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecordByIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecordByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.String_ = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Int", wireType)
-			}
-			m.Int = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Int |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Float", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Float = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Boolean", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Boolean = bool(v != 0)
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Reference == nil {
-				m.Reference = &QueryListRecordsRequest_ReferenceInput{}
-			}
-			if err := m.Reference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4036,8 +5473,7 @@ func (m *QueryListRecordsRequest_ValueInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = append(m.Values, &QueryListRecordsRequest_ValueInput{})
-			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4062,7 +5498,7 @@ func (m *QueryListRecordsRequest_ValueInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListRecordsRequest_KeyValueInput) Unmarshal(dAtA []byte) error {
+func (m *QueryRecordByBondIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4085,15 +5521,15 @@ func (m *QueryListRecordsRequest_KeyValueInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyValueInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecordByBondIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyValueInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecordByBondIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4121,11 +5557,11 @@ func (m *QueryListRecordsRequest_KeyValueInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4152,10 +5588,10 @@ func (m *QueryListRecordsRequest_KeyValueInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &QueryListRecordsRequest_ValueInput{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4180,7 +5616,7 @@ func (m *QueryListRecordsRequest_KeyValueInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecordByBondIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4203,10 +5639,10 @@ func (m *QueryListRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecordByBondIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecordByBondIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4300,7 +5736,7 @@ func (m *QueryListRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecordByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *GetNameServiceModuleBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4323,17 +5759,67 @@ func (m *QueryRecordByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecordByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetNameServiceModuleBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecordByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetNameServiceModuleBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNameServiceModuleBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNameServiceModuleBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNameServiceModuleBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4343,23 +5829,25 @@ func (m *QueryRecordByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Balances = append(m.Balances, &AccountBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4382,7 +5870,7 @@ func (m *QueryRecordByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecordByIdResponse) Unmarshal(dAtA []byte) error {
+func (m *AccountBalance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4405,15 +5893,47 @@ func (m *QueryRecordByIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecordByIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountBalance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecordByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountBalance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4440,7 +5960,8 @@ func (m *QueryRecordByIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4465,7 +5986,7 @@ func (m *QueryRecordByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecordByBondIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListNameRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4488,45 +6009,13 @@ func (m *QueryRecordByBondIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecordByBondIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListNameRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecordByBondIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListNameRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4583,7 +6072,7 @@ func (m *QueryRecordByBondIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecordByBondIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListNameRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4606,15 +6095,15 @@ func (m *QueryRecordByBondIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecordByBondIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListNameRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecordByBondIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListNameRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4641,8 +6130,8 @@ func (m *QueryRecordByBondIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, Record{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Names = append(m.Names, NameEntry{})
+			if err := m.Names[len(m.Names)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4703,7 +6192,7 @@ func (m *QueryRecordByBondIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNameServiceModuleBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWhoisRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4726,12 +6215,44 @@ func (m *GetNameServiceModuleBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNameServiceModuleBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhoisRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNameServiceModuleBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhoisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4753,7 +6274,7 @@ func (m *GetNameServiceModuleBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNameServiceModuleBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWhoisResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4776,15 +6297,15 @@ func (m *GetNameServiceModuleBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNameServiceModuleBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhoisResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNameServiceModuleBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhoisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NameAuthority", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4811,8 +6332,7 @@ func (m *GetNameServiceModuleBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, &AccountBalance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NameAuthority.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4837,7 +6357,7 @@ func (m *GetNameServiceModuleBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountBalance) Unmarshal(dAtA []byte) error {
+func (m *QueryLookupCrn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4860,15 +6380,15 @@ func (m *AccountBalance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountBalance: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLookupCrn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLookupCrn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Crn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4896,41 +6416,7 @@ func (m *AccountBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Balance = append(m.Balance, types.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Crn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4953,7 +6439,7 @@ func (m *AccountBalance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListNameRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLookupCrnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4976,15 +6462,15 @@ func (m *QueryListNameRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListNameRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLookupCrnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListNameRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLookupCrnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5011,10 +6497,10 @@ func (m *QueryListNameRecordsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Name == nil {
+				m.Name = &NameRecord{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Name.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5039,7 +6525,7 @@ func (m *QueryListNameRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListNameRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryResolveCrn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5062,17 +6548,17 @@ func (m *QueryListNameRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListNameRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResolveCrn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListNameRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResolveCrn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Crn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5082,29 +6568,77 @@ func (m *QueryListNameRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Crn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveCrnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Names = append(m.Names, NameEntry{})
-			if err := m.Names[len(m.Names)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveCrnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveCrnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5131,10 +6665,10 @@ func (m *QueryListNameRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Record == nil {
+				m.Record = &Record{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5159,7 +6693,7 @@ func (m *QueryListNameRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhoisRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRecordsByIdsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5182,15 +6716,15 @@ func (m *QueryWhoisRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhoisRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRecordsByIdsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhoisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRecordsByIdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5218,7 +6752,7 @@ func (m *QueryWhoisRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5241,7 +6775,7 @@ func (m *QueryWhoisRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhoisResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRecordsByIdsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5264,15 +6798,15 @@ func (m *QueryWhoisResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhoisResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRecordsByIdsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhoisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRecordsByIdsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NameAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5299,7 +6833,8 @@ func (m *QueryWhoisResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NameAuthority.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5324,7 +6859,7 @@ func (m *QueryWhoisResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLookupCrn) Unmarshal(dAtA []byte) error {
+func (m *QueryLookupCrnsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5347,15 +6882,15 @@ func (m *QueryLookupCrn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLookupCrn: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLookupCrnsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLookupCrn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLookupCrnsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Crn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Crns", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5383,7 +6918,7 @@ func (m *QueryLookupCrn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Crn = string(dAtA[iNdEx:postIndex])
+			m.Crns = append(m.Crns, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5406,7 +6941,7 @@ func (m *QueryLookupCrn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLookupCrnResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLookupCrnsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5429,15 +6964,15 @@ func (m *QueryLookupCrnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLookupCrnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLookupCrnsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLookupCrnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLookupCrnsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5464,10 +6999,8 @@ func (m *QueryLookupCrnResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Name == nil {
-				m.Name = &NameRecord{}
-			}
-			if err := m.Name.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Names = append(m.Names, NameRecord{})
+			if err := m.Names[len(m.Names)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5492,7 +7025,7 @@ func (m *QueryLookupCrnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveCrn) Unmarshal(dAtA []byte) error {
+func (m *QueryResolveCrnsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5515,15 +7048,15 @@ func (m *QueryResolveCrn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveCrn: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResolveCrnsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveCrn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResolveCrnsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Crn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Crns", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5551,7 +7084,7 @@ func (m *QueryResolveCrn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Crn = string(dAtA[iNdEx:postIndex])
+			m.Crns = append(m.Crns, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5574,7 +7107,7 @@ func (m *QueryResolveCrn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveCrnResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryResolveCrnsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5597,15 +7130,15 @@ func (m *QueryResolveCrnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveCrnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResolveCrnsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveCrnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResolveCrnsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5632,10 +7165,8 @@ func (m *QueryResolveCrnResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Record == nil {
-				m.Record = &Record{}
-			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6186,6 +7717,197 @@ func (m *QueryGetAuthorityExpiryQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetBlockChangeSetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlockChangeSetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlockChangeSetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBlockChangeSetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlockChangeSetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlockChangeSetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockChangeSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockChangeSets = append(m.BlockChangeSets, &BlockChangeSet{})
+			if err := m.BlockChangeSets[len(m.BlockChangeSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0